@@ -0,0 +1,91 @@
+package main
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/logutils"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/code"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
+)
+
+const initStarTemplate = `# Generated by ` + "`" + binName + ` init --from-har=%s` + "`" + `: please review!
+
+monkey.openapi3(
+    name = "my_spec",
+    # Note: inferred from recorded traffic, so likely incomplete.
+    file = %q,
+    host = %q,
+)
+
+# List here the commands to run so that the service providing "my_spec"
+# can be restored to its initial state.
+monkey.shell(
+    name = "my_resetter",
+    provides = ["my_spec"],
+    start = """
+echo TODO: start the System Under Test
+    """,
+    reset = """
+echo TODO: reset the System Under Test to a clean slate
+    """,
+    stop = """
+echo TODO: stop the System Under Test
+    """,
+)
+`
+
+// Writes a starter OpenAPIv3 spec and a fuzzymonkey.star
+// out of HTTP traffic recorded as a HAR file
+func doInit(starfile, harfile, specfile string, verbosity uint8) int {
+	log.SetOutput(&logutils.LevelFilter{
+		Levels:   []logutils.LogLevel{"DBG", "NFO", "ERR", "NOP"},
+		MinLevel: logLevel(verbosity),
+		Writer:   os.Stderr,
+	})
+
+	for _, fn := range []string{starfile, specfile} {
+		if _, err := os.Stat(fn); err == nil || !os.IsNotExist(err) {
+			as.ColorERR.Printf("Refusing to overwrite %s\n", fn)
+			return code.Failed
+		}
+	}
+
+	f, err := os.Open(harfile)
+	if err != nil {
+		as.ColorERR.Println(err)
+		return code.Failed
+	}
+	defer f.Close()
+
+	title := strings.TrimSuffix(filepath.Base(harfile), filepath.Ext(harfile))
+	spec, host, err := openapiv3.InferFromHAR(f, title)
+	if err != nil {
+		as.ColorERR.Println(err)
+		return code.Failed
+	}
+
+	// References in the spec file are resolved relative to the spec
+	specRel := specfile
+	if rel, err := filepath.Rel(filepath.Dir(starfile), specfile); err == nil {
+		specRel = rel
+	}
+	star := fmt.Sprintf(initStarTemplate, harfile, specRel, host)
+
+	if err := os.WriteFile(specfile, spec, 0644); err != nil {
+		as.ColorERR.Println(err)
+		return code.Failed
+	}
+	if err := os.WriteFile(starfile, []byte(star), 0644); err != nil {
+		as.ColorERR.Println(err)
+		return code.Failed
+	}
+	as.ColorOK.Printf("Wrote %s and %s\n", specfile, starfile)
+	as.ColorNFO.Printf("Next: fill in the resetter then run `%s lint`\n", binName)
+	return code.OK
+}
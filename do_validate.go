@@ -0,0 +1,254 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/code"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	rt "github.com/FuzzyMonkeyCo/monkey/pkg/runtime"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+// A payload to validate, and how that went
+type document struct {
+	Source string                     `json:"source"`
+	Ref    string                     `json:"ref"`
+	Valid  bool                       `json:"valid"`
+	Errors []*modeler.ValidationError `json:"errors,omitempty"`
+	Error  string                     `json:"error,omitempty"`
+
+	data []byte
+	err  error
+}
+
+type validationReport struct {
+	Documents []*document `json:"documents"`
+	Total     int         `json:"total"`
+	Invalid   int         `json:"invalid"`
+}
+
+// Validates payloads from STDIN, files or directories against schemas
+func doValidate(mrt *rt.Runtime, args *params) int {
+	if args.Format != formatText && args.Format != formatJSON {
+		as.ColorERR.Printf("Unsupported --format=%s (choose from: %s, %s)\n", args.Format, formatText, formatJSON)
+		return code.Failed
+	}
+
+	docs, err := readDocuments(args.Payloads, args.NDJSON)
+	if err != nil {
+		as.ColorERR.Println(err)
+		return code.FailedSchema
+	}
+	if len(docs) == 0 {
+		as.ColorERR.Println("No payloads to validate")
+		return code.FailedSchema
+	}
+
+	report := &validationReport{Documents: docs, Total: len(docs)}
+	for _, doc := range docs {
+		doc.Ref = args.ValidateAgainst
+		if args.RefByFilename {
+			doc.Ref = mrt.ExpandRef(refNameOfFile(doc.Source))
+		}
+
+		errs, err := mrt.ValidateAgainstSchema(doc.Ref, doc.data)
+		switch {
+		case err != nil:
+			doc.err = err
+			doc.Error = err.Error()
+		case len(errs) != 0:
+			doc.Errors = errs
+		default:
+			doc.Valid = true
+		}
+		if !doc.Valid {
+			report.Invalid++
+		}
+	}
+
+	if args.Format == formatJSON {
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(report); err != nil {
+			log.Println("[ERR]", err)
+			return code.FailedSchema
+		}
+	} else {
+		printReport(report)
+		for _, doc := range docs {
+			var errNoSuchRef *modeler.NoSuchRefError
+			if errors.As(doc.err, &errNoSuchRef) {
+				mrt.WriteAbsoluteReferences(os.Stdout)
+				break
+			}
+		}
+	}
+
+	if report.Invalid != 0 {
+		return code.FailedSchema
+	}
+	return code.OK
+}
+
+func printReport(report *validationReport) {
+	single := report.Total == 1
+	for _, doc := range report.Documents {
+		if !single {
+			if doc.Valid {
+				as.ColorOK.Printf("%s: valid\n", doc.Source)
+				continue
+			}
+			as.ColorNFO.Printf("%s: ", doc.Source)
+		}
+
+		var errNoSuchRef *modeler.NoSuchRefError
+		switch {
+		case doc.Valid:
+		case errors.As(doc.err, &errNoSuchRef):
+			as.ColorERR.Printf("No such $ref '%s'\n", doc.Ref)
+		case doc.err != nil:
+			as.ColorERR.Println(doc.err)
+		default:
+			if !single {
+				as.ColorERR.Printf("%d errors against %s\n", len(doc.Errors), doc.Ref)
+			}
+			for _, e := range doc.Errors {
+				lines := e.Lines()
+				as.ColorERR.Println(lines[0])
+				for _, line := range lines[1:] {
+					fmt.Println(line)
+				}
+			}
+		}
+	}
+
+	switch {
+	case single && report.Invalid == 0:
+		as.ColorNFO.Println("Payload is valid")
+	case single:
+	case report.Invalid == 0:
+		as.ColorOK.Printf("All %d payloads are valid\n", report.Total)
+	default:
+		as.ColorERR.Printf("%d of %d payloads are invalid\n", report.Invalid, report.Total)
+	}
+}
+
+// refNameOfFile names a schema after a file: e.g. some/dir/Pet.2.json is Pet
+func refNameOfFile(source string) string {
+	name := filepath.Base(source)
+	if i := strings.IndexByte(name, ':'); i != -1 {
+		// Drops NDJSON line numbers
+		name = name[:i]
+	}
+	if i := strings.IndexByte(name, '.'); i > 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+func isNDJSONFile(path string) bool {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".ndjson", ".jsonl":
+		return true
+	}
+	return false
+}
+
+func isPayloadFile(path string) bool {
+	return isNDJSONFile(path) || strings.ToLower(filepath.Ext(path)) == ".json"
+}
+
+// readDocuments reads STDIN when no paths are given, otherwise files
+// and JSON files found in directories.
+func readDocuments(paths []string, ndjson bool) (docs []*document, err error) {
+	if len(paths) == 0 {
+		return splitDocuments("stdin", os.Stdin, ndjson)
+	}
+
+	for _, path := range paths {
+		var fi os.FileInfo
+		if fi, err = os.Stat(path); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		if !fi.IsDir() {
+			var more []*document
+			if more, err = readDocumentsFile(path, ndjson || isNDJSONFile(path)); err != nil {
+				return
+			}
+			docs = append(docs, more...)
+			continue
+		}
+
+		if err = filepath.WalkDir(path, func(filePath string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if d.IsDir() || !isPayloadFile(filePath) {
+				return nil
+			}
+			more, err := readDocumentsFile(filePath, ndjson || isNDJSONFile(filePath))
+			docs = append(docs, more...)
+			return err
+		}); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	return
+}
+
+func readDocumentsFile(path string, ndjson bool) ([]*document, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	defer f.Close()
+	return splitDocuments(path, f, ndjson)
+}
+
+func splitDocuments(source string, r io.Reader, ndjson bool) (docs []*document, err error) {
+	if !ndjson {
+		var data []byte
+		if data, err = io.ReadAll(r); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		docs = append(docs, &document{Source: source, data: data})
+		return
+	}
+
+	s := bufio.NewScanner(r)
+	s.Buffer(nil, 64<<20)
+	for line := 1; s.Scan(); line++ {
+		data := bytes.TrimSpace(s.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+		docs = append(docs, &document{
+			Source: fmt.Sprintf("%s:%d", source, line),
+			data:   append([]byte(nil), data...),
+		})
+	}
+	if err = s.Err(); err != nil {
+		log.Println("[ERR]", err)
+	}
+	return
+}
@@ -0,0 +1,208 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/code"
+	rt "github.com/FuzzyMonkeyCo/monkey/pkg/runtime"
+)
+
+func TestRefNameOfFile(t *testing.T) {
+	for source, expected := range map[string]string{
+		"Pet.json":                "Pet",
+		"some/dir/Pet.2.json":     "Pet",
+		"some/dir/Pets.ndjson:3":  "Pets",
+		"some.dir/Error.jsonl:12": "Error",
+		"stdin":                   "stdin",
+		"stdin:1":                 "stdin",
+	} {
+		t.Run(source, func(t *testing.T) {
+			require.Equal(t, expected, refNameOfFile(source))
+		})
+	}
+}
+
+func TestSplitDocuments(t *testing.T) {
+	for name, test := range map[string]struct {
+		ndjson   bool
+		input    string
+		expected map[string]string
+	}{
+		"whole": {
+			input:    "{\"a\": 1}\n{\"b\": 2}\n",
+			expected: map[string]string{"src": "{\"a\": 1}\n{\"b\": 2}\n"},
+		},
+		"whole empty": {
+			input:    "",
+			expected: map[string]string{"src": ""},
+		},
+		"ndjson": {
+			ndjson:   true,
+			input:    "{\"a\": 1}\n\n  {\"b\": 2}  \r\n",
+			expected: map[string]string{"src:1": `{"a": 1}`, "src:3": `{"b": 2}`},
+		},
+		"ndjson without trailing newline": {
+			ndjson:   true,
+			input:    "1\n2",
+			expected: map[string]string{"src:1": "1", "src:2": "2"},
+		},
+		"ndjson empty": {
+			ndjson:   true,
+			input:    "\n \n",
+			expected: map[string]string{},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			docs, err := splitDocuments("src", strings.NewReader(test.input), test.ndjson)
+			require.NoError(t, err)
+			got := make(map[string]string, len(docs))
+			for _, doc := range docs {
+				got[doc.Source] = string(doc.data)
+			}
+			require.Equal(t, test.expected, got)
+		})
+	}
+}
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir := t.TempDir()
+	for name, data := range files {
+		path := filepath.Join(dir, name)
+		err := os.MkdirAll(filepath.Dir(path), 0755)
+		require.NoError(t, err)
+		err = os.WriteFile(path, []byte(data), 0644)
+		require.NoError(t, err)
+	}
+	return dir
+}
+
+func TestReadDocuments(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		"Pet.json":            `{"id": 1, "name": "a"}`,
+		"sub/Pet.2.JSON":      `{"id": 2, "name": "b"}`,
+		"sub/Pets.ndjson":     "[]\n[{\"id\": 3, \"name\": \"c\"}]\n",
+		"sub/deeper/Pet.yml":  "id: 4",
+		"README.md":           "# Not a payload",
+		"Error.jsonl":         `{"code": 42, "message": "!"}`,
+		"lines.txt":           "1\n2\n3\n",
+		"sub/deeper/Pet.json": "{}",
+	})
+	sources := func(docs []*document) (srcs []string) {
+		for _, doc := range docs {
+			srcs = append(srcs, strings.TrimPrefix(doc.Source, dir+string(filepath.Separator)))
+		}
+		return
+	}
+
+	docs, err := readDocuments([]string{dir}, false)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"Error.jsonl:1",
+		"Pet.json",
+		filepath.Join("sub", "Pet.2.JSON"),
+		filepath.Join("sub", "Pets.ndjson") + ":1",
+		filepath.Join("sub", "Pets.ndjson") + ":2",
+		filepath.Join("sub", "deeper", "Pet.json"),
+	}, sources(docs))
+
+	// Files given explicitly are read whatever their extension
+	docs, err = readDocuments([]string{filepath.Join(dir, "lines.txt")}, false)
+	require.NoError(t, err)
+	require.Equal(t, []string{"lines.txt"}, sources(docs))
+
+	docs, err = readDocuments([]string{filepath.Join(dir, "lines.txt")}, true)
+	require.NoError(t, err)
+	require.Equal(t, []string{"lines.txt:1", "lines.txt:2", "lines.txt:3"}, sources(docs))
+
+	_, err = readDocuments([]string{filepath.Join(dir, "nope.json")}, false)
+	require.True(t, os.IsNotExist(err))
+}
+
+func newValidatingMonkey(t *testing.T) *rt.Runtime {
+	spec, err := filepath.Abs(filepath.Join("pkg", "modeler", "openapiv3", "testdata", "specs", "openapi3", "v3.0.0_petstore.yaml"))
+	require.NoError(t, err)
+	starfile := filepath.Join(t.TempDir(), "fuzzymonkey.star")
+	err = os.WriteFile(starfile, []byte(fmt.Sprintf(`
+monkey.openapi3(
+    name = "petstore",
+    file = %q,
+    host = "http://localhost:6773",
+)
+`[1:], spec)), 0644)
+	require.NoError(t, err)
+
+	mrt, err := rt.NewMonkey(binTitle, starfile, nil)
+	require.NoError(t, err)
+	err = mrt.Lint(context.Background(), false)
+	require.NoError(t, err)
+	return mrt
+}
+
+func TestValidateExitCodes(t *testing.T) {
+	mrt := newValidatingMonkey(t)
+	dir := writeFiles(t, map[string]string{
+		"valid/Pet.json":    `{"id": 1, "name": "a"}`,
+		"valid/Error.jsonl": "{\"code\": 1, \"message\": \"!\"}\n{\"code\": 2, \"message\": \"?\"}\n",
+		"invalid/Pet.json":  `{"id": "1"}`,
+		"broken/Pet.json":   `{"id": `,
+		"unknown/Cat.json":  `{}`,
+		"empty/notes.txt":   `{}`,
+	})
+	in := func(sub string) string { return filepath.Join(dir, sub) }
+
+	for name, test := range map[string]struct {
+		args     params
+		expected int
+	}{
+		"by filename": {
+			args:     params{Payloads: []string{in("valid")}, RefByFilename: true},
+			expected: code.OK,
+		},
+		"by filename as JSON": {
+			args:     params{Payloads: []string{in("valid")}, RefByFilename: true, Format: formatJSON},
+			expected: code.OK,
+		},
+		"against a ref": {
+			args:     params{Payloads: []string{in("valid/Pet.json")}, ValidateAgainst: "#/components/schemas/Pet"},
+			expected: code.OK,
+		},
+		"invalid": {
+			args:     params{Payloads: []string{in("valid"), in("invalid")}, RefByFilename: true},
+			expected: code.FailedSchema,
+		},
+		"unparsable": {
+			args:     params{Payloads: []string{in("broken")}, RefByFilename: true},
+			expected: code.FailedSchema,
+		},
+		"no such ref": {
+			args:     params{Payloads: []string{in("unknown")}, RefByFilename: true},
+			expected: code.FailedSchema,
+		},
+		"no payloads": {
+			args:     params{Payloads: []string{in("empty")}, RefByFilename: true},
+			expected: code.FailedSchema,
+		},
+		"missing path": {
+			args:     params{Payloads: []string{in("nope")}, RefByFilename: true},
+			expected: code.FailedSchema,
+		},
+		"bad format": {
+			args:     params{Payloads: []string{in("valid")}, RefByFilename: true, Format: "yaml"},
+			expected: code.Failed,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			if test.args.Format == "" {
+				test.args.Format = formatText
+			}
+			require.Equal(t, test.expected, doValidate(mrt, &test.args))
+		})
+	}
+}
@@ -2,10 +2,12 @@ package main
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"hash/fnv"
 	"io"
-	"io/ioutil"
 	"log"
+	"math/rand"
 	"os"
 	"os/signal"
 	"regexp"
@@ -60,6 +62,10 @@ func actualMain() int {
 		return doLogs(args.File, args.LogOffset)
 	}
 
+	if args.Init {
+		return doInit(args.File, args.FromHAR, args.SpecFile, args.Verbosity)
+	}
+
 	if args.Pastseed {
 		return doPastseed(args.File)
 	}
@@ -163,34 +169,68 @@ func actualMain() int {
 		return code.OK
 	}
 
-	if args.Schema {
-		ref := args.ValidateAgainst
-		if ref == "" {
-			mrt.WriteAbsoluteReferences(os.Stdout)
-			return code.OK
-		}
-
-		data, err := ioutil.ReadAll(os.Stdin)
+	if args.Schema && args.DiffAgainst != "" {
+		changes, err := mrt.DiffAgainst(ctx, args.DiffAgainst)
 		if err != nil {
-			log.Println("[ERR]", err)
+			as.ColorERR.Println(err)
 			return code.FailedSchema
 		}
 
-		if err := mrt.ValidateAgainstSchema(ref, data); err != nil {
-			switch err {
-			case modeler.ErrUnparsablePayload:
-			case modeler.ErrNoSuchSchema:
-				as.ColorERR.Printf("No such $ref '%s'\n", ref)
-				mrt.WriteAbsoluteReferences(os.Stdout)
-			default:
-				as.ColorERR.Println(err)
+		breaking := 0
+		for _, change := range changes {
+			if change.Breaking {
+				breaking++
+				as.ColorERR.Printf("breaking      %s: %s\n", change.Where, change.What)
+			} else {
+				as.ColorNFO.Printf("non-breaking  %s: %s\n", change.Where, change.What)
 			}
+		}
+		if breaking != 0 {
+			as.ColorERR.Printf("%d breaking changes out of %d\n", breaking, len(changes))
 			return code.FailedSchema
 		}
-		as.ColorNFO.Println("Payload is valid")
+		as.ColorOK.Printf("No breaking changes out of %d\n", len(changes))
 		return code.OK
 	}
 
+	if args.Schema && args.GenerateFrom != "" {
+		seed := args.Seed
+		if len(seed) == 0 {
+			seed = []byte(fmt.Sprintf("%d", time.Now().UnixNano()))
+			as.ColorNFO.Fprintf(os.Stderr, "--seed=%s\n", seed)
+		}
+		h := fnv.New64a()
+		h.Write(seed)
+		rnd := rand.New(rand.NewSource(int64(h.Sum64())))
+
+		enc := json.NewEncoder(os.Stdout)
+		for i := uint32(0); i < args.Count; i++ {
+			value, err := mrt.GenerateFromSchema(args.GenerateFrom, rnd)
+			if err != nil {
+				if _, ok := err.(*modeler.NoSuchRefError); ok {
+					as.ColorERR.Printf("No such $ref '%s'\n", args.GenerateFrom)
+					mrt.WriteAbsoluteReferences(os.Stdout)
+				} else {
+					as.ColorERR.Println(err)
+				}
+				return code.FailedSchema
+			}
+			if err := enc.Encode(value); err != nil {
+				log.Println("[ERR]", err)
+				return code.FailedSchema
+			}
+		}
+		return code.OK
+	}
+
+	if args.Schema {
+		if args.ValidateAgainst == "" && !args.RefByFilename {
+			mrt.WriteAbsoluteReferences(os.Stdout)
+			return code.OK
+		}
+		return doValidate(mrt, args)
+	}
+
 	apiKey := os.Getenv(envAPIKey)
 	if apiKey == "" {
 		err := fmt.Errorf("$%s is unset", envAPIKey)
@@ -13,7 +13,7 @@ import (
 
 const pwdIDDigits = 20
 
-var pwdID string
+var pwdID, pwdPrefix string
 
 // LogFile points to a usable regular file after a call to MakePwdID()
 func LogFile() string { return Prefixed() + ".log" }
@@ -21,6 +21,11 @@ func LogFile() string { return Prefixed() + ".log" }
 // Prefixed points to a usable regular file prefix after a call to MakePwdID()
 func Prefixed() string { return pwdID + "_" }
 
+// Shared points to a usable regular file prefix after a call to MakePwdID()
+// that, unlike Prefixed(), is the same for every run from this directory.
+// It is for state that outlives a run, e.g. between `monkey exec start` and `stop`.
+func Shared() string { return pwdPrefix + "." }
+
 // MakePwdID looks for a usable temporary path for var run logfiles
 func MakePwdID(name, starfile string, offset uint64) (err error) {
 	var fi os.FileInfo
@@ -70,6 +75,7 @@ func MakePwdID(name, starfile string, offset uint64) (err error) {
 	}
 
 	pwdID = prefix + "_" + slot
+	pwdPrefix = prefix
 	return
 }
 
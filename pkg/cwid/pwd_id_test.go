@@ -50,6 +50,7 @@ func TestPwdID(t *testing.T) {
 		filesContain(t, ".monkeh_")
 		filesContain(t, "_12404825836092798244_")
 		filesContain(t, "_00000000000000000002")
+		require.True(t, strings.HasSuffix(Shared(), "_12404825836092798244."), "not slotted")
 	}(LogFile())
 
 	// PwdID changes with starfile
@@ -1048,7 +1048,18 @@ type EndpointJSON struct {
 	Inputs       []*ParamJSON        `protobuf:"bytes,3,rep,name=inputs,proto3" json:"inputs,omitempty"`
 	// The uint32 key replaces an enum of 1XX,...,201,204,...,5XX,XXX.
 	// The uint32 values are SID
-	Outputs map[uint32]uint32 `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Outputs     map[uint32]uint32 `protobuf:"bytes,4,rep,name=outputs,proto3" json:"outputs,omitempty" protobuf_key:"varint,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	OperationId string            `protobuf:"bytes,5,opt,name=operation_id,json=operationId,proto3" json:"operation_id,omitempty"`
+	Tags        []string          `protobuf:"bytes,6,rep,name=tags,proto3" json:"tags,omitempty"`
+	Deprecated  bool              `protobuf:"varint,7,opt,name=deprecated,proto3" json:"deprecated,omitempty"`
+	// These come from x-monkey-* extensions
+	Skip bool `protobuf:"varint,8,opt,name=skip,proto3" json:"skip,omitempty"`
+	// Relative odds of being called, 0 meaning 1.
+	Weight uint32 `protobuf:"varint,9,opt,name=weight,proto3" json:"weight,omitempty"`
+	// 0 means no limit
+	MaxLatencyMs uint32 `protobuf:"varint,10,opt,name=max_latency_ms,json=maxLatencyMs,proto3" json:"max_latency_ms,omitempty"`
+	// Unless given, inferred from the method
+	Idempotent bool `protobuf:"varint,11,opt,name=idempotent,proto3" json:"idempotent,omitempty"`
 }
 
 func (x *EndpointJSON) Reset() {
@@ -1111,6 +1122,55 @@ func (x *EndpointJSON) GetOutputs() map[uint32]uint32 {
 	return nil
 }
 
+func (x *EndpointJSON) GetOperationId() string {
+	if x != nil {
+		return x.OperationId
+	}
+	return ""
+}
+
+func (x *EndpointJSON) GetTags() []string {
+	if x != nil {
+		return x.Tags
+	}
+	return nil
+}
+
+func (x *EndpointJSON) GetDeprecated() bool {
+	if x != nil {
+		return x.Deprecated
+	}
+	return false
+}
+
+func (x *EndpointJSON) GetSkip() bool {
+	if x != nil {
+		return x.Skip
+	}
+	return false
+}
+
+func (x *EndpointJSON) GetWeight() uint32 {
+	if x != nil {
+		return x.Weight
+	}
+	return 0
+}
+
+func (x *EndpointJSON) GetMaxLatencyMs() uint32 {
+	if x != nil {
+		return x.MaxLatencyMs
+	}
+	return 0
+}
+
+func (x *EndpointJSON) GetIdempotent() bool {
+	if x != nil {
+		return x.Idempotent
+	}
+	return false
+}
+
 type ParamJSON struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1429,6 +1489,9 @@ type Clt_ResetProgress struct {
 	Status    Clt_ResetProgress_Status `protobuf:"varint,1,opt,name=status,proto3,enum=fm.Clt_ResetProgress_Status" json:"status,omitempty"`
 	ElapsedNs int64                    `protobuf:"varint,2,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
 	Reason    []string                 `protobuf:"bytes,3,rep,name=reason,proto3" json:"reason,omitempty"`
+	// How many times each retried resetter was retried
+	Retries map[string]uint32           `protobuf:"bytes,4,rep,name=retries,proto3" json:"retries,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Outputs []*Clt_ResetProgress_Output `protobuf:"bytes,5,rep,name=outputs,proto3" json:"outputs,omitempty"`
 }
 
 func (x *Clt_ResetProgress) Reset() {
@@ -1484,6 +1547,20 @@ func (x *Clt_ResetProgress) GetReason() []string {
 	return nil
 }
 
+func (x *Clt_ResetProgress) GetRetries() map[string]uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return nil
+}
+
+func (x *Clt_ResetProgress) GetOutputs() []*Clt_ResetProgress_Output {
+	if x != nil {
+		return x.Outputs
+	}
+	return nil
+}
+
 type Clt_CallRequestRaw struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1691,7 +1768,16 @@ type Clt_Fuzz_Resetter struct {
 	// Types that are assignable to Resetter:
 	//
 	//	*Clt_Fuzz_Resetter_Shell_
+	//	*Clt_Fuzz_Resetter_Http
+	//	*Clt_Fuzz_Resetter_Snapshot_
+	//	*Clt_Fuzz_Resetter_Process_
+	//	*Clt_Fuzz_Resetter_Starlark_
 	Resetter isClt_Fuzz_Resetter_Resetter `protobuf_oneof:"resetter"`
+	// Gates use of the system under test after start and reset
+	Ready *Clt_Fuzz_Resetter_Probe `protobuf:"bytes,7,opt,name=ready,proto3" json:"ready,omitempty"`
+	// Resetters that start & reset before this one, and stop after it
+	DependsOn []string                 `protobuf:"bytes,9,rep,name=depends_on,json=dependsOn,proto3" json:"depends_on,omitempty"`
+	Retry     *Clt_Fuzz_Resetter_Retry `protobuf:"bytes,10,opt,name=retry,proto3" json:"retry,omitempty"`
 }
 
 func (x *Clt_Fuzz_Resetter) Reset() {
@@ -1754,6 +1840,55 @@ func (x *Clt_Fuzz_Resetter) GetShell() *Clt_Fuzz_Resetter_Shell {
 	return nil
 }
 
+func (x *Clt_Fuzz_Resetter) GetHttp() *Clt_Fuzz_Resetter_HTTP {
+	if x, ok := x.GetResetter().(*Clt_Fuzz_Resetter_Http); ok {
+		return x.Http
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter) GetSnapshot() *Clt_Fuzz_Resetter_Snapshot {
+	if x, ok := x.GetResetter().(*Clt_Fuzz_Resetter_Snapshot_); ok {
+		return x.Snapshot
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter) GetProcess() *Clt_Fuzz_Resetter_Process {
+	if x, ok := x.GetResetter().(*Clt_Fuzz_Resetter_Process_); ok {
+		return x.Process
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter) GetStarlark() *Clt_Fuzz_Resetter_Starlark {
+	if x, ok := x.GetResetter().(*Clt_Fuzz_Resetter_Starlark_); ok {
+		return x.Starlark
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter) GetReady() *Clt_Fuzz_Resetter_Probe {
+	if x != nil {
+		return x.Ready
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter) GetDependsOn() []string {
+	if x != nil {
+		return x.DependsOn
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter) GetRetry() *Clt_Fuzz_Resetter_Retry {
+	if x != nil {
+		return x.Retry
+	}
+	return nil
+}
+
 type isClt_Fuzz_Resetter_Resetter interface {
 	isClt_Fuzz_Resetter_Resetter()
 }
@@ -1762,8 +1897,32 @@ type Clt_Fuzz_Resetter_Shell_ struct {
 	Shell *Clt_Fuzz_Resetter_Shell `protobuf:"bytes,3,opt,name=shell,proto3,oneof"`
 }
 
+type Clt_Fuzz_Resetter_Http struct {
+	Http *Clt_Fuzz_Resetter_HTTP `protobuf:"bytes,4,opt,name=http,proto3,oneof"`
+}
+
+type Clt_Fuzz_Resetter_Snapshot_ struct {
+	Snapshot *Clt_Fuzz_Resetter_Snapshot `protobuf:"bytes,5,opt,name=snapshot,proto3,oneof"`
+}
+
+type Clt_Fuzz_Resetter_Process_ struct {
+	Process *Clt_Fuzz_Resetter_Process `protobuf:"bytes,6,opt,name=process,proto3,oneof"`
+}
+
+type Clt_Fuzz_Resetter_Starlark_ struct {
+	Starlark *Clt_Fuzz_Resetter_Starlark `protobuf:"bytes,8,opt,name=starlark,proto3,oneof"`
+}
+
 func (*Clt_Fuzz_Resetter_Shell_) isClt_Fuzz_Resetter_Resetter() {}
 
+func (*Clt_Fuzz_Resetter_Http) isClt_Fuzz_Resetter_Resetter() {}
+
+func (*Clt_Fuzz_Resetter_Snapshot_) isClt_Fuzz_Resetter_Resetter() {}
+
+func (*Clt_Fuzz_Resetter_Process_) isClt_Fuzz_Resetter_Resetter() {}
+
+func (*Clt_Fuzz_Resetter_Starlark_) isClt_Fuzz_Resetter_Resetter() {}
+
 type Clt_Fuzz_Model struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1847,6 +2006,15 @@ type Clt_Fuzz_Resetter_Shell struct {
 	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
 	Rst   string `protobuf:"bytes,2,opt,name=rst,proto3" json:"rst,omitempty"`
 	Stop  string `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`
+	// Paths to executable scripts, run instead of the above
+	StartFile string `protobuf:"bytes,4,opt,name=start_file,json=startFile,proto3" json:"start_file,omitempty"`
+	RstFile   string `protobuf:"bytes,5,opt,name=rst_file,json=rstFile,proto3" json:"rst_file,omitempty"`
+	StopFile  string `protobuf:"bytes,6,opt,name=stop_file,json=stopFile,proto3" json:"stop_file,omitempty"`
+	// Bash-compatible interpreter
+	Shell          string `protobuf:"bytes,7,opt,name=shell,proto3" json:"shell,omitempty"`
+	StartTimeoutMs uint32 `protobuf:"varint,8,opt,name=start_timeout_ms,json=startTimeoutMs,proto3" json:"start_timeout_ms,omitempty"`
+	RstTimeoutMs   uint32 `protobuf:"varint,9,opt,name=rst_timeout_ms,json=rstTimeoutMs,proto3" json:"rst_timeout_ms,omitempty"`
+	StopTimeoutMs  uint32 `protobuf:"varint,10,opt,name=stop_timeout_ms,json=stopTimeoutMs,proto3" json:"stop_timeout_ms,omitempty"`
 }
 
 func (x *Clt_Fuzz_Resetter_Shell) Reset() {
@@ -1902,21 +2070,67 @@ func (x *Clt_Fuzz_Resetter_Shell) GetStop() string {
 	return ""
 }
 
-type Clt_Fuzz_Model_OpenAPIv3 struct {
+func (x *Clt_Fuzz_Resetter_Shell) GetStartFile() string {
+	if x != nil {
+		return x.StartFile
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_Shell) GetRstFile() string {
+	if x != nil {
+		return x.RstFile
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_Shell) GetStopFile() string {
+	if x != nil {
+		return x.StopFile
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_Shell) GetShell() string {
+	if x != nil {
+		return x.Shell
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_Shell) GetStartTimeoutMs() uint32 {
+	if x != nil {
+		return x.StartTimeoutMs
+	}
+	return 0
+}
+
+func (x *Clt_Fuzz_Resetter_Shell) GetRstTimeoutMs() uint32 {
+	if x != nil {
+		return x.RstTimeoutMs
+	}
+	return 0
+}
+
+func (x *Clt_Fuzz_Resetter_Shell) GetStopTimeoutMs() uint32 {
+	if x != nil {
+		return x.StopTimeoutMs
+	}
+	return 0
+}
+
+type Clt_Fuzz_Resetter_HTTP struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Spec is the spec pointed at by File
-	Spec *SpecIR `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
-	// File path within current directory pointing to a YAML/JSON spec
-	File string `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
-	// Host superseeds the spec's base URL
-	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+	Start []*Clt_Fuzz_Resetter_HTTP_Request `protobuf:"bytes,1,rep,name=start,proto3" json:"start,omitempty"`
+	Rst   []*Clt_Fuzz_Resetter_HTTP_Request `protobuf:"bytes,2,rep,name=rst,proto3" json:"rst,omitempty"`
+	Stop  []*Clt_Fuzz_Resetter_HTTP_Request `protobuf:"bytes,3,rep,name=stop,proto3" json:"stop,omitempty"`
 }
 
-func (x *Clt_Fuzz_Model_OpenAPIv3) Reset() {
-	*x = Clt_Fuzz_Model_OpenAPIv3{}
+func (x *Clt_Fuzz_Resetter_HTTP) Reset() {
+	*x = Clt_Fuzz_Resetter_HTTP{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_fuzzymonkey_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1924,13 +2138,13 @@ func (x *Clt_Fuzz_Model_OpenAPIv3) Reset() {
 	}
 }
 
-func (x *Clt_Fuzz_Model_OpenAPIv3) String() string {
+func (x *Clt_Fuzz_Resetter_HTTP) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Clt_Fuzz_Model_OpenAPIv3) ProtoMessage() {}
+func (*Clt_Fuzz_Resetter_HTTP) ProtoMessage() {}
 
-func (x *Clt_Fuzz_Model_OpenAPIv3) ProtoReflect() protoreflect.Message {
+func (x *Clt_Fuzz_Resetter_HTTP) ProtoReflect() protoreflect.Message {
 	mi := &file_fuzzymonkey_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1942,45 +2156,43 @@ func (x *Clt_Fuzz_Model_OpenAPIv3) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Clt_Fuzz_Model_OpenAPIv3.ProtoReflect.Descriptor instead.
-func (*Clt_Fuzz_Model_OpenAPIv3) Descriptor() ([]byte, []int) {
-	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 1, 0}
+// Deprecated: Use Clt_Fuzz_Resetter_HTTP.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Resetter_HTTP) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 0, 1}
 }
 
-func (x *Clt_Fuzz_Model_OpenAPIv3) GetSpec() *SpecIR {
+func (x *Clt_Fuzz_Resetter_HTTP) GetStart() []*Clt_Fuzz_Resetter_HTTP_Request {
 	if x != nil {
-		return x.Spec
+		return x.Start
 	}
 	return nil
 }
 
-func (x *Clt_Fuzz_Model_OpenAPIv3) GetFile() string {
+func (x *Clt_Fuzz_Resetter_HTTP) GetRst() []*Clt_Fuzz_Resetter_HTTP_Request {
 	if x != nil {
-		return x.File
+		return x.Rst
 	}
-	return ""
+	return nil
 }
 
-func (x *Clt_Fuzz_Model_OpenAPIv3) GetHost() string {
+func (x *Clt_Fuzz_Resetter_HTTP) GetStop() []*Clt_Fuzz_Resetter_HTTP_Request {
 	if x != nil {
-		return x.Host
+		return x.Stop
 	}
-	return ""
+	return nil
 }
 
-type Clt_CallRequestRaw_Input struct {
+type Clt_Fuzz_Resetter_Snapshot struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Input:
-	//
-	//	*Clt_CallRequestRaw_Input_HttpRequest_
-	Input isClt_CallRequestRaw_Input_Input `protobuf_oneof:"input"`
+	// Files and directories restored on each reset
+	Paths []string `protobuf:"bytes,1,rep,name=paths,proto3" json:"paths,omitempty"`
 }
 
-func (x *Clt_CallRequestRaw_Input) Reset() {
-	*x = Clt_CallRequestRaw_Input{}
+func (x *Clt_Fuzz_Resetter_Snapshot) Reset() {
+	*x = Clt_Fuzz_Resetter_Snapshot{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_fuzzymonkey_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1988,13 +2200,13 @@ func (x *Clt_CallRequestRaw_Input) Reset() {
 	}
 }
 
-func (x *Clt_CallRequestRaw_Input) String() string {
+func (x *Clt_Fuzz_Resetter_Snapshot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Clt_CallRequestRaw_Input) ProtoMessage() {}
+func (*Clt_Fuzz_Resetter_Snapshot) ProtoMessage() {}
 
-func (x *Clt_CallRequestRaw_Input) ProtoReflect() protoreflect.Message {
+func (x *Clt_Fuzz_Resetter_Snapshot) ProtoReflect() protoreflect.Message {
 	mi := &file_fuzzymonkey_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2006,49 +2218,37 @@ func (x *Clt_CallRequestRaw_Input) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Clt_CallRequestRaw_Input.ProtoReflect.Descriptor instead.
-func (*Clt_CallRequestRaw_Input) Descriptor() ([]byte, []int) {
-	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 2, 0}
-}
-
-func (m *Clt_CallRequestRaw_Input) GetInput() isClt_CallRequestRaw_Input_Input {
-	if m != nil {
-		return m.Input
-	}
-	return nil
+// Deprecated: Use Clt_Fuzz_Resetter_Snapshot.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Resetter_Snapshot) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 0, 2}
 }
 
-func (x *Clt_CallRequestRaw_Input) GetHttpRequest() *Clt_CallRequestRaw_Input_HttpRequest {
-	if x, ok := x.GetInput().(*Clt_CallRequestRaw_Input_HttpRequest_); ok {
-		return x.HttpRequest
+func (x *Clt_Fuzz_Resetter_Snapshot) GetPaths() []string {
+	if x != nil {
+		return x.Paths
 	}
 	return nil
 }
 
-type isClt_CallRequestRaw_Input_Input interface {
-	isClt_CallRequestRaw_Input_Input()
-}
-
-type Clt_CallRequestRaw_Input_HttpRequest_ struct {
-	HttpRequest *Clt_CallRequestRaw_Input_HttpRequest `protobuf:"bytes,1,opt,name=http_request,json=httpRequest,proto3,oneof"`
-}
-
-func (*Clt_CallRequestRaw_Input_HttpRequest_) isClt_CallRequestRaw_Input_Input() {}
-
-type Clt_CallRequestRaw_Input_HttpRequest struct {
+// Probe tells when a service is ready
+type Clt_Fuzz_Resetter_Probe struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Method      string          `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
-	Url         string          `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
-	Headers     []*HeaderPair   `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
-	Body        []byte          `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
-	BodyDecoded *structpb.Value `protobuf:"bytes,5,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
+	// Types that are assignable to Probe:
+	//
+	//	*Clt_Fuzz_Resetter_Probe_Tcp
+	//	*Clt_Fuzz_Resetter_Probe_Http
+	//	*Clt_Fuzz_Resetter_Probe_LogLine
+	//	*Clt_Fuzz_Resetter_Probe_Predicate
+	Probe isClt_Fuzz_Resetter_Probe_Probe `protobuf_oneof:"probe"`
+	// Deadline for the probe to succeed
+	TimeoutMs uint32 `protobuf:"varint,5,opt,name=timeout_ms,json=timeoutMs,proto3" json:"timeout_ms,omitempty"`
 }
 
-func (x *Clt_CallRequestRaw_Input_HttpRequest) Reset() {
-	*x = Clt_CallRequestRaw_Input_HttpRequest{}
+func (x *Clt_Fuzz_Resetter_Probe) Reset() {
+	*x = Clt_Fuzz_Resetter_Probe{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_fuzzymonkey_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2056,13 +2256,13 @@ func (x *Clt_CallRequestRaw_Input_HttpRequest) Reset() {
 	}
 }
 
-func (x *Clt_CallRequestRaw_Input_HttpRequest) String() string {
+func (x *Clt_Fuzz_Resetter_Probe) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Clt_CallRequestRaw_Input_HttpRequest) ProtoMessage() {}
+func (*Clt_Fuzz_Resetter_Probe) ProtoMessage() {}
 
-func (x *Clt_CallRequestRaw_Input_HttpRequest) ProtoReflect() protoreflect.Message {
+func (x *Clt_Fuzz_Resetter_Probe) ProtoReflect() protoreflect.Message {
 	mi := &file_fuzzymonkey_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2074,74 +2274,1064 @@ func (x *Clt_CallRequestRaw_Input_HttpRequest) ProtoReflect() protoreflect.Messa
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Clt_CallRequestRaw_Input_HttpRequest.ProtoReflect.Descriptor instead.
-func (*Clt_CallRequestRaw_Input_HttpRequest) Descriptor() ([]byte, []int) {
-	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 2, 0, 0}
+// Deprecated: Use Clt_Fuzz_Resetter_Probe.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Resetter_Probe) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 0, 3}
 }
 
-func (x *Clt_CallRequestRaw_Input_HttpRequest) GetMethod() string {
-	if x != nil {
-		return x.Method
+func (m *Clt_Fuzz_Resetter_Probe) GetProbe() isClt_Fuzz_Resetter_Probe_Probe {
+	if m != nil {
+		return m.Probe
 	}
-	return ""
+	return nil
 }
 
-func (x *Clt_CallRequestRaw_Input_HttpRequest) GetUrl() string {
-	if x != nil {
-		return x.Url
+func (x *Clt_Fuzz_Resetter_Probe) GetTcp() string {
+	if x, ok := x.GetProbe().(*Clt_Fuzz_Resetter_Probe_Tcp); ok {
+		return x.Tcp
 	}
 	return ""
 }
 
-func (x *Clt_CallRequestRaw_Input_HttpRequest) GetHeaders() []*HeaderPair {
-	if x != nil {
-		return x.Headers
+func (x *Clt_Fuzz_Resetter_Probe) GetHttp() *Clt_Fuzz_Resetter_Probe_HTTP {
+	if x, ok := x.GetProbe().(*Clt_Fuzz_Resetter_Probe_Http); ok {
+		return x.Http
 	}
 	return nil
 }
 
-func (x *Clt_CallRequestRaw_Input_HttpRequest) GetBody() []byte {
-	if x != nil {
-		return x.Body
+func (x *Clt_Fuzz_Resetter_Probe) GetLogLine() string {
+	if x, ok := x.GetProbe().(*Clt_Fuzz_Resetter_Probe_LogLine); ok {
+		return x.LogLine
 	}
-	return nil
+	return ""
 }
 
-func (x *Clt_CallRequestRaw_Input_HttpRequest) GetBodyDecoded() *structpb.Value {
+func (x *Clt_Fuzz_Resetter_Probe) GetPredicate() string {
+	if x, ok := x.GetProbe().(*Clt_Fuzz_Resetter_Probe_Predicate); ok {
+		return x.Predicate
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_Probe) GetTimeoutMs() uint32 {
 	if x != nil {
-		return x.BodyDecoded
+		return x.TimeoutMs
 	}
-	return nil
+	return 0
 }
 
-type Clt_CallResponseRaw_Output struct {
+type isClt_Fuzz_Resetter_Probe_Probe interface {
+	isClt_Fuzz_Resetter_Probe_Probe()
+}
+
+type Clt_Fuzz_Resetter_Probe_Tcp struct {
+	// Address that accepts TCP connections
+	Tcp string `protobuf:"bytes,1,opt,name=tcp,proto3,oneof"`
+}
+
+type Clt_Fuzz_Resetter_Probe_Http struct {
+	Http *Clt_Fuzz_Resetter_Probe_HTTP `protobuf:"bytes,2,opt,name=http,proto3,oneof"`
+}
+
+type Clt_Fuzz_Resetter_Probe_LogLine struct {
+	// Regexp matching one line of output
+	LogLine string `protobuf:"bytes,3,opt,name=log_line,json=logLine,proto3,oneof"`
+}
+
+type Clt_Fuzz_Resetter_Probe_Predicate struct {
+	// Name of a Starlark function returning True once ready
+	Predicate string `protobuf:"bytes,4,opt,name=predicate,proto3,oneof"`
+}
+
+func (*Clt_Fuzz_Resetter_Probe_Tcp) isClt_Fuzz_Resetter_Probe_Probe() {}
+
+func (*Clt_Fuzz_Resetter_Probe_Http) isClt_Fuzz_Resetter_Probe_Probe() {}
+
+func (*Clt_Fuzz_Resetter_Probe_LogLine) isClt_Fuzz_Resetter_Probe_Probe() {}
+
+func (*Clt_Fuzz_Resetter_Probe_Predicate) isClt_Fuzz_Resetter_Probe_Probe() {}
+
+type Clt_Fuzz_Resetter_Process struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Output:
-	//
-	//	*Clt_CallResponseRaw_Output_HttpResponse_
-	Output isClt_CallResponseRaw_Output_Output `protobuf_oneof:"output"`
+	Command        []string          `protobuf:"bytes,1,rep,name=command,proto3" json:"command,omitempty"`
+	Env            map[string]string `protobuf:"bytes,2,rep,name=env,proto3" json:"env,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	RestartOnReset bool              `protobuf:"varint,4,opt,name=restart_on_reset,json=restartOnReset,proto3" json:"restart_on_reset,omitempty"`
+}
+
+func (x *Clt_Fuzz_Resetter_Process) Reset() {
+	*x = Clt_Fuzz_Resetter_Process{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_Fuzz_Resetter_Process) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_Fuzz_Resetter_Process) ProtoMessage() {}
+
+func (x *Clt_Fuzz_Resetter_Process) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_Fuzz_Resetter_Process.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Resetter_Process) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 0, 4}
+}
+
+func (x *Clt_Fuzz_Resetter_Process) GetCommand() []string {
+	if x != nil {
+		return x.Command
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter_Process) GetEnv() map[string]string {
+	if x != nil {
+		return x.Env
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter_Process) GetRestartOnReset() bool {
+	if x != nil {
+		return x.RestartOnReset
+	}
+	return false
+}
+
+// Names of the Starlark functions run for each phase
+type Clt_Fuzz_Resetter_Starlark struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Start string `protobuf:"bytes,1,opt,name=start,proto3" json:"start,omitempty"`
+	Rst   string `protobuf:"bytes,2,opt,name=rst,proto3" json:"rst,omitempty"`
+	Stop  string `protobuf:"bytes,3,opt,name=stop,proto3" json:"stop,omitempty"`
+}
+
+func (x *Clt_Fuzz_Resetter_Starlark) Reset() {
+	*x = Clt_Fuzz_Resetter_Starlark{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_Fuzz_Resetter_Starlark) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_Fuzz_Resetter_Starlark) ProtoMessage() {}
+
+func (x *Clt_Fuzz_Resetter_Starlark) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_Fuzz_Resetter_Starlark.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Resetter_Starlark) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 0, 5}
+}
+
+func (x *Clt_Fuzz_Resetter_Starlark) GetStart() string {
+	if x != nil {
+		return x.Start
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_Starlark) GetRst() string {
+	if x != nil {
+		return x.Rst
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_Starlark) GetStop() string {
+	if x != nil {
+		return x.Stop
+	}
+	return ""
+}
+
+// Tries resets again instead of failing the campaign
+type Clt_Fuzz_Resetter_Retry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Retries uint32 `protobuf:"varint,1,opt,name=retries,proto3" json:"retries,omitempty"`
+	// Delay before the first retry, doubling thereafter
+	BackoffMs uint32 `protobuf:"varint,2,opt,name=backoff_ms,json=backoffMs,proto3" json:"backoff_ms,omitempty"`
+	// Stops then starts the resetter before retrying
+	Restart bool `protobuf:"varint,3,opt,name=restart,proto3" json:"restart,omitempty"`
+}
+
+func (x *Clt_Fuzz_Resetter_Retry) Reset() {
+	*x = Clt_Fuzz_Resetter_Retry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_Fuzz_Resetter_Retry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_Fuzz_Resetter_Retry) ProtoMessage() {}
+
+func (x *Clt_Fuzz_Resetter_Retry) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_Fuzz_Resetter_Retry.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Resetter_Retry) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 0, 6}
+}
+
+func (x *Clt_Fuzz_Resetter_Retry) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *Clt_Fuzz_Resetter_Retry) GetBackoffMs() uint32 {
+	if x != nil {
+		return x.BackoffMs
+	}
+	return 0
+}
+
+func (x *Clt_Fuzz_Resetter_Retry) GetRestart() bool {
+	if x != nil {
+		return x.Restart
+	}
+	return false
+}
+
+type Clt_Fuzz_Resetter_HTTP_Request struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method  string        `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url     string        `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers []*HeaderPair `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	Body    []byte        `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	// Expected status codes, any 2XX when empty
+	Expect []uint32 `protobuf:"varint,5,rep,packed,name=expect,proto3" json:"expect,omitempty"`
+}
+
+func (x *Clt_Fuzz_Resetter_HTTP_Request) Reset() {
+	*x = Clt_Fuzz_Resetter_HTTP_Request{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_Fuzz_Resetter_HTTP_Request) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_Fuzz_Resetter_HTTP_Request) ProtoMessage() {}
+
+func (x *Clt_Fuzz_Resetter_HTTP_Request) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_Fuzz_Resetter_HTTP_Request.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Resetter_HTTP_Request) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 0, 1, 0}
+}
+
+func (x *Clt_Fuzz_Resetter_HTTP_Request) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_HTTP_Request) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_HTTP_Request) GetHeaders() []*HeaderPair {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter_HTTP_Request) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Resetter_HTTP_Request) GetExpect() []uint32 {
+	if x != nil {
+		return x.Expect
+	}
+	return nil
+}
+
+type Clt_Fuzz_Resetter_Probe_HTTP struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Url    string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	Status uint32 `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+}
+
+func (x *Clt_Fuzz_Resetter_Probe_HTTP) Reset() {
+	*x = Clt_Fuzz_Resetter_Probe_HTTP{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_Fuzz_Resetter_Probe_HTTP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_Fuzz_Resetter_Probe_HTTP) ProtoMessage() {}
+
+func (x *Clt_Fuzz_Resetter_Probe_HTTP) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_Fuzz_Resetter_Probe_HTTP.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Resetter_Probe_HTTP) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 0, 3, 0}
+}
+
+func (x *Clt_Fuzz_Resetter_Probe_HTTP) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Resetter_Probe_HTTP) GetStatus() uint32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type Clt_Fuzz_Model_OpenAPIv3 struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Spec is the spec pointed at by File
+	Spec *SpecIR `protobuf:"bytes,1,opt,name=spec,proto3" json:"spec,omitempty"`
+	// File path within current directory pointing to a YAML/JSON spec
+	File string `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	// Host superseeds the spec's base URL
+	Host string `protobuf:"bytes,3,opt,name=host,proto3" json:"host,omitempty"`
+}
+
+func (x *Clt_Fuzz_Model_OpenAPIv3) Reset() {
+	*x = Clt_Fuzz_Model_OpenAPIv3{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_Fuzz_Model_OpenAPIv3) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_Fuzz_Model_OpenAPIv3) ProtoMessage() {}
+
+func (x *Clt_Fuzz_Model_OpenAPIv3) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_Fuzz_Model_OpenAPIv3.ProtoReflect.Descriptor instead.
+func (*Clt_Fuzz_Model_OpenAPIv3) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 0, 1, 0}
+}
+
+func (x *Clt_Fuzz_Model_OpenAPIv3) GetSpec() *SpecIR {
+	if x != nil {
+		return x.Spec
+	}
+	return nil
+}
+
+func (x *Clt_Fuzz_Model_OpenAPIv3) GetFile() string {
+	if x != nil {
+		return x.File
+	}
+	return ""
+}
+
+func (x *Clt_Fuzz_Model_OpenAPIv3) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+// What each resetter printed last, for counterexamples
+type Clt_ResetProgress_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Resetter string   `protobuf:"bytes,1,opt,name=resetter,proto3" json:"resetter,omitempty"`
+	Lines    []string `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	// Whether earlier lines were dropped
+	Truncated bool `protobuf:"varint,3,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	// Path to the resetter's whole transcript
+	Transcript string `protobuf:"bytes,4,opt,name=transcript,proto3" json:"transcript,omitempty"`
+}
+
+func (x *Clt_ResetProgress_Output) Reset() {
+	*x = Clt_ResetProgress_Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_ResetProgress_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_ResetProgress_Output) ProtoMessage() {}
+
+func (x *Clt_ResetProgress_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_ResetProgress_Output.ProtoReflect.Descriptor instead.
+func (*Clt_ResetProgress_Output) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 1, 1}
+}
+
+func (x *Clt_ResetProgress_Output) GetResetter() string {
+	if x != nil {
+		return x.Resetter
+	}
+	return ""
+}
+
+func (x *Clt_ResetProgress_Output) GetLines() []string {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Clt_ResetProgress_Output) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+func (x *Clt_ResetProgress_Output) GetTranscript() string {
+	if x != nil {
+		return x.Transcript
+	}
+	return ""
+}
+
+type Clt_CallRequestRaw_Input struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Input:
+	//
+	//	*Clt_CallRequestRaw_Input_HttpRequest_
+	Input isClt_CallRequestRaw_Input_Input `protobuf_oneof:"input"`
+}
+
+func (x *Clt_CallRequestRaw_Input) Reset() {
+	*x = Clt_CallRequestRaw_Input{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_CallRequestRaw_Input) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_CallRequestRaw_Input) ProtoMessage() {}
+
+func (x *Clt_CallRequestRaw_Input) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_CallRequestRaw_Input.ProtoReflect.Descriptor instead.
+func (*Clt_CallRequestRaw_Input) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 2, 0}
+}
+
+func (m *Clt_CallRequestRaw_Input) GetInput() isClt_CallRequestRaw_Input_Input {
+	if m != nil {
+		return m.Input
+	}
+	return nil
+}
+
+func (x *Clt_CallRequestRaw_Input) GetHttpRequest() *Clt_CallRequestRaw_Input_HttpRequest {
+	if x, ok := x.GetInput().(*Clt_CallRequestRaw_Input_HttpRequest_); ok {
+		return x.HttpRequest
+	}
+	return nil
+}
+
+type isClt_CallRequestRaw_Input_Input interface {
+	isClt_CallRequestRaw_Input_Input()
+}
+
+type Clt_CallRequestRaw_Input_HttpRequest_ struct {
+	HttpRequest *Clt_CallRequestRaw_Input_HttpRequest `protobuf:"bytes,1,opt,name=http_request,json=httpRequest,proto3,oneof"`
+}
+
+func (*Clt_CallRequestRaw_Input_HttpRequest_) isClt_CallRequestRaw_Input_Input() {}
+
+type Clt_CallRequestRaw_Input_HttpRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method      string          `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url         string          `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	Headers     []*HeaderPair   `protobuf:"bytes,3,rep,name=headers,proto3" json:"headers,omitempty"`
+	Body        []byte          `protobuf:"bytes,4,opt,name=body,proto3" json:"body,omitempty"`
+	BodyDecoded *structpb.Value `protobuf:"bytes,5,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
+}
+
+func (x *Clt_CallRequestRaw_Input_HttpRequest) Reset() {
+	*x = Clt_CallRequestRaw_Input_HttpRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_CallRequestRaw_Input_HttpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_CallRequestRaw_Input_HttpRequest) ProtoMessage() {}
+
+func (x *Clt_CallRequestRaw_Input_HttpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_CallRequestRaw_Input_HttpRequest.ProtoReflect.Descriptor instead.
+func (*Clt_CallRequestRaw_Input_HttpRequest) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 2, 0, 0}
+}
+
+func (x *Clt_CallRequestRaw_Input_HttpRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+func (x *Clt_CallRequestRaw_Input_HttpRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *Clt_CallRequestRaw_Input_HttpRequest) GetHeaders() []*HeaderPair {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Clt_CallRequestRaw_Input_HttpRequest) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Clt_CallRequestRaw_Input_HttpRequest) GetBodyDecoded() *structpb.Value {
+	if x != nil {
+		return x.BodyDecoded
+	}
+	return nil
+}
+
+type Clt_CallResponseRaw_Output struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Output:
+	//
+	//	*Clt_CallResponseRaw_Output_HttpResponse_
+	Output isClt_CallResponseRaw_Output_Output `protobuf_oneof:"output"`
+}
+
+func (x *Clt_CallResponseRaw_Output) Reset() {
+	*x = Clt_CallResponseRaw_Output{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_CallResponseRaw_Output) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_CallResponseRaw_Output) ProtoMessage() {}
+
+func (x *Clt_CallResponseRaw_Output) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_CallResponseRaw_Output.ProtoReflect.Descriptor instead.
+func (*Clt_CallResponseRaw_Output) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 3, 0}
+}
+
+func (m *Clt_CallResponseRaw_Output) GetOutput() isClt_CallResponseRaw_Output_Output {
+	if m != nil {
+		return m.Output
+	}
+	return nil
+}
+
+func (x *Clt_CallResponseRaw_Output) GetHttpResponse() *Clt_CallResponseRaw_Output_HttpResponse {
+	if x, ok := x.GetOutput().(*Clt_CallResponseRaw_Output_HttpResponse_); ok {
+		return x.HttpResponse
+	}
+	return nil
+}
+
+type isClt_CallResponseRaw_Output_Output interface {
+	isClt_CallResponseRaw_Output_Output()
+}
+
+type Clt_CallResponseRaw_Output_HttpResponse_ struct {
+	HttpResponse *Clt_CallResponseRaw_Output_HttpResponse `protobuf:"bytes,1,opt,name=http_response,json=httpResponse,proto3,oneof"`
+}
+
+func (*Clt_CallResponseRaw_Output_HttpResponse_) isClt_CallResponseRaw_Output_Output() {}
+
+type Clt_CallResponseRaw_Output_HttpResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Error       string                                           `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	StatusCode  uint32                                           `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Reason      string                                           `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Headers     []*HeaderPair                                    `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
+	Body        []byte                                           `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
+	BodyDecoded *structpb.Value                                  `protobuf:"bytes,6,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
+	ElapsedNs   int64                                            `protobuf:"varint,7,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
+	Timings     *Clt_CallResponseRaw_Output_HttpResponse_Timings `protobuf:"bytes,8,opt,name=timings,proto3" json:"timings,omitempty"`
+	// History lists followed redirects, in order
+	History []*Clt_CallResponseRaw_Output_HttpResponse_Redirect `protobuf:"bytes,9,rep,name=history,proto3" json:"history,omitempty"`
+	// Retries counts requests sent again after a 429 or 503 with Retry-After
+	Retries uint32 `protobuf:"varint,10,opt,name=retries,proto3" json:"retries,omitempty"`
+	// Events are read from streaming responses, within limits
+	Events []*Clt_CallResponseRaw_Output_HttpResponse_Event `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	// Set when reading events stopped before the stream ended
+	EventsTruncated bool `protobuf:"varint,12,opt,name=events_truncated,json=eventsTruncated,proto3" json:"events_truncated,omitempty"`
+	// Size of the whole body, of which at most max_body_bytes are kept in body
+	BodySize      int64  `protobuf:"varint,13,opt,name=body_size,json=bodySize,proto3" json:"body_size,omitempty"`
+	BodySha256    string `protobuf:"bytes,14,opt,name=body_sha256,json=bodySha256,proto3" json:"body_sha256,omitempty"` // Hex encoded
+	BodyTruncated bool   `protobuf:"varint,15,opt,name=body_truncated,json=bodyTruncated,proto3" json:"body_truncated,omitempty"`
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) Reset() {
+	*x = Clt_CallResponseRaw_Output_HttpResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_CallResponseRaw_Output_HttpResponse) ProtoMessage() {}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_CallResponseRaw_Output_HttpResponse.ProtoReflect.Descriptor instead.
+func (*Clt_CallResponseRaw_Output_HttpResponse) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 3, 0, 0}
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetStatusCode() uint32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetHeaders() []*HeaderPair {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetBody() []byte {
+	if x != nil {
+		return x.Body
+	}
+	return nil
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetBodyDecoded() *structpb.Value {
+	if x != nil {
+		return x.BodyDecoded
+	}
+	return nil
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetElapsedNs() int64 {
+	if x != nil {
+		return x.ElapsedNs
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetTimings() *Clt_CallResponseRaw_Output_HttpResponse_Timings {
+	if x != nil {
+		return x.Timings
+	}
+	return nil
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetHistory() []*Clt_CallResponseRaw_Output_HttpResponse_Redirect {
+	if x != nil {
+		return x.History
+	}
+	return nil
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetRetries() uint32 {
+	if x != nil {
+		return x.Retries
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetEvents() []*Clt_CallResponseRaw_Output_HttpResponse_Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetEventsTruncated() bool {
+	if x != nil {
+		return x.EventsTruncated
+	}
+	return false
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetBodySize() int64 {
+	if x != nil {
+		return x.BodySize
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetBodySha256() string {
+	if x != nil {
+		return x.BodySha256
+	}
+	return ""
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse) GetBodyTruncated() bool {
+	if x != nil {
+		return x.BodyTruncated
+	}
+	return false
+}
+
+// Timings break down elapsed_ns, see https://pkg.go.dev/net/http/httptrace#ClientTrace
+type Clt_CallResponseRaw_Output_HttpResponse_Timings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	DnsNs      int64  `protobuf:"varint,1,opt,name=dns_ns,json=dnsNs,proto3" json:"dns_ns,omitempty"`                // Name resolution
+	ConnectNs  int64  `protobuf:"varint,2,opt,name=connect_ns,json=connectNs,proto3" json:"connect_ns,omitempty"`    // TCP (or Unix socket) connection
+	TlsNs      int64  `protobuf:"varint,3,opt,name=tls_ns,json=tlsNs,proto3" json:"tls_ns,omitempty"`                // TLS handshake
+	TtfbNs     int64  `protobuf:"varint,4,opt,name=ttfb_ns,json=ttfbNs,proto3" json:"ttfb_ns,omitempty"`             // From start until first response byte
+	TransferNs int64  `protobuf:"varint,5,opt,name=transfer_ns,json=transferNs,proto3" json:"transfer_ns,omitempty"` // From first response byte until body is read
+	ReusedConn bool   `protobuf:"varint,6,opt,name=reused_conn,json=reusedConn,proto3" json:"reused_conn,omitempty"`
+	LocalAddr  string `protobuf:"bytes,7,opt,name=local_addr,json=localAddr,proto3" json:"local_addr,omitempty"`
+	RemoteAddr string `protobuf:"bytes,8,opt,name=remote_addr,json=remoteAddr,proto3" json:"remote_addr,omitempty"`
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) Reset() {
+	*x = Clt_CallResponseRaw_Output_HttpResponse_Timings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_fuzzymonkey_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Clt_CallResponseRaw_Output_HttpResponse_Timings) ProtoMessage() {}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Clt_CallResponseRaw_Output_HttpResponse_Timings.ProtoReflect.Descriptor instead.
+func (*Clt_CallResponseRaw_Output_HttpResponse_Timings) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 3, 0, 0, 0}
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetDnsNs() int64 {
+	if x != nil {
+		return x.DnsNs
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetConnectNs() int64 {
+	if x != nil {
+		return x.ConnectNs
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetTlsNs() int64 {
+	if x != nil {
+		return x.TlsNs
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetTtfbNs() int64 {
+	if x != nil {
+		return x.TtfbNs
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetTransferNs() int64 {
+	if x != nil {
+		return x.TransferNs
+	}
+	return 0
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetReusedConn() bool {
+	if x != nil {
+		return x.ReusedConn
+	}
+	return false
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetLocalAddr() string {
+	if x != nil {
+		return x.LocalAddr
+	}
+	return ""
+}
+
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Timings) GetRemoteAddr() string {
+	if x != nil {
+		return x.RemoteAddr
+	}
+	return ""
+}
+
+// Redirect is a response that was followed
+type Clt_CallResponseRaw_Output_HttpResponse_Redirect struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Method     string        `protobuf:"bytes,1,opt,name=method,proto3" json:"method,omitempty"`
+	Url        string        `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	StatusCode uint32        `protobuf:"varint,3,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
+	Reason     string        `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	Headers    []*HeaderPair `protobuf:"bytes,5,rep,name=headers,proto3" json:"headers,omitempty"`
+	ElapsedNs  int64         `protobuf:"varint,6,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"`
 }
 
-func (x *Clt_CallResponseRaw_Output) Reset() {
-	*x = Clt_CallResponseRaw_Output{}
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) Reset() {
+	*x = Clt_CallResponseRaw_Output_HttpResponse_Redirect{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[28]
+		mi := &file_fuzzymonkey_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Clt_CallResponseRaw_Output) String() string {
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Clt_CallResponseRaw_Output) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Redirect) ProtoMessage() {}
 
-func (x *Clt_CallResponseRaw_Output) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[28]
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2152,66 +3342,83 @@ func (x *Clt_CallResponseRaw_Output) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Clt_CallResponseRaw_Output.ProtoReflect.Descriptor instead.
-func (*Clt_CallResponseRaw_Output) Descriptor() ([]byte, []int) {
-	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 3, 0}
+// Deprecated: Use Clt_CallResponseRaw_Output_HttpResponse_Redirect.ProtoReflect.Descriptor instead.
+func (*Clt_CallResponseRaw_Output_HttpResponse_Redirect) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 3, 0, 0, 1}
 }
 
-func (m *Clt_CallResponseRaw_Output) GetOutput() isClt_CallResponseRaw_Output_Output {
-	if m != nil {
-		return m.Output
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetMethod() string {
+	if x != nil {
+		return x.Method
 	}
-	return nil
+	return ""
 }
 
-func (x *Clt_CallResponseRaw_Output) GetHttpResponse() *Clt_CallResponseRaw_Output_HttpResponse {
-	if x, ok := x.GetOutput().(*Clt_CallResponseRaw_Output_HttpResponse_); ok {
-		return x.HttpResponse
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetUrl() string {
+	if x != nil {
+		return x.Url
 	}
-	return nil
+	return ""
 }
 
-type isClt_CallResponseRaw_Output_Output interface {
-	isClt_CallResponseRaw_Output_Output()
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetStatusCode() uint32 {
+	if x != nil {
+		return x.StatusCode
+	}
+	return 0
 }
 
-type Clt_CallResponseRaw_Output_HttpResponse_ struct {
-	HttpResponse *Clt_CallResponseRaw_Output_HttpResponse `protobuf:"bytes,1,opt,name=http_response,json=httpResponse,proto3,oneof"`
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (*Clt_CallResponseRaw_Output_HttpResponse_) isClt_CallResponseRaw_Output_Output() {}
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetHeaders() []*HeaderPair {
+	if x != nil {
+		return x.Headers
+	}
+	return nil
+}
 
-type Clt_CallResponseRaw_Output_HttpResponse struct {
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Redirect) GetElapsedNs() int64 {
+	if x != nil {
+		return x.ElapsedNs
+	}
+	return 0
+}
+
+// Event is a Server-Sent Event or a line of a streamed NDJSON body
+type Clt_CallResponseRaw_Output_HttpResponse_Event struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error       string          `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	StatusCode  uint32          `protobuf:"varint,2,opt,name=status_code,json=statusCode,proto3" json:"status_code,omitempty"`
-	Reason      string          `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
-	Headers     []*HeaderPair   `protobuf:"bytes,4,rep,name=headers,proto3" json:"headers,omitempty"`
-	Body        []byte          `protobuf:"bytes,5,opt,name=body,proto3" json:"body,omitempty"`
-	BodyDecoded *structpb.Value `protobuf:"bytes,6,opt,name=body_decoded,json=bodyDecoded,proto3" json:"body_decoded,omitempty"`
-	ElapsedNs   int64           `protobuf:"varint,7,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"` // TODO: finer timings (name lookup, connection, ...)
+	Id          string          `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Event       string          `protobuf:"bytes,2,opt,name=event,proto3" json:"event,omitempty"`
+	Data        string          `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	DataDecoded *structpb.Value `protobuf:"bytes,4,opt,name=data_decoded,json=dataDecoded,proto3" json:"data_decoded,omitempty"`
+	ElapsedNs   int64           `protobuf:"varint,5,opt,name=elapsed_ns,json=elapsedNs,proto3" json:"elapsed_ns,omitempty"` // From start until the event was read
 }
 
-func (x *Clt_CallResponseRaw_Output_HttpResponse) Reset() {
-	*x = Clt_CallResponseRaw_Output_HttpResponse{}
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Event) Reset() {
+	*x = Clt_CallResponseRaw_Output_HttpResponse_Event{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[29]
+		mi := &file_fuzzymonkey_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Clt_CallResponseRaw_Output_HttpResponse) String() string {
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Event) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Clt_CallResponseRaw_Output_HttpResponse) ProtoMessage() {}
+func (*Clt_CallResponseRaw_Output_HttpResponse_Event) ProtoMessage() {}
 
-func (x *Clt_CallResponseRaw_Output_HttpResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[29]
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Event) ProtoReflect() protoreflect.Message {
+	mi := &file_fuzzymonkey_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2222,54 +3429,40 @@ func (x *Clt_CallResponseRaw_Output_HttpResponse) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Clt_CallResponseRaw_Output_HttpResponse.ProtoReflect.Descriptor instead.
-func (*Clt_CallResponseRaw_Output_HttpResponse) Descriptor() ([]byte, []int) {
-	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 3, 0, 0}
+// Deprecated: Use Clt_CallResponseRaw_Output_HttpResponse_Event.ProtoReflect.Descriptor instead.
+func (*Clt_CallResponseRaw_Output_HttpResponse_Event) Descriptor() ([]byte, []int) {
+	return file_fuzzymonkey_proto_rawDescGZIP(), []int{0, 3, 0, 0, 2}
 }
 
-func (x *Clt_CallResponseRaw_Output_HttpResponse) GetError() string {
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Event) GetId() string {
 	if x != nil {
-		return x.Error
+		return x.Id
 	}
 	return ""
 }
 
-func (x *Clt_CallResponseRaw_Output_HttpResponse) GetStatusCode() uint32 {
-	if x != nil {
-		return x.StatusCode
-	}
-	return 0
-}
-
-func (x *Clt_CallResponseRaw_Output_HttpResponse) GetReason() string {
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Event) GetEvent() string {
 	if x != nil {
-		return x.Reason
+		return x.Event
 	}
 	return ""
 }
 
-func (x *Clt_CallResponseRaw_Output_HttpResponse) GetHeaders() []*HeaderPair {
-	if x != nil {
-		return x.Headers
-	}
-	return nil
-}
-
-func (x *Clt_CallResponseRaw_Output_HttpResponse) GetBody() []byte {
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Event) GetData() string {
 	if x != nil {
-		return x.Body
+		return x.Data
 	}
-	return nil
+	return ""
 }
 
-func (x *Clt_CallResponseRaw_Output_HttpResponse) GetBodyDecoded() *structpb.Value {
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Event) GetDataDecoded() *structpb.Value {
 	if x != nil {
-		return x.BodyDecoded
+		return x.DataDecoded
 	}
 	return nil
 }
 
-func (x *Clt_CallResponseRaw_Output_HttpResponse) GetElapsedNs() int64 {
+func (x *Clt_CallResponseRaw_Output_HttpResponse_Event) GetElapsedNs() int64 {
 	if x != nil {
 		return x.ElapsedNs
 	}
@@ -2297,7 +3490,7 @@ type Srv_FuzzingProgress struct {
 func (x *Srv_FuzzingProgress) Reset() {
 	*x = Srv_FuzzingProgress{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[30]
+		mi := &file_fuzzymonkey_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2310,7 +3503,7 @@ func (x *Srv_FuzzingProgress) String() string {
 func (*Srv_FuzzingProgress) ProtoMessage() {}
 
 func (x *Srv_FuzzingProgress) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[30]
+	mi := &file_fuzzymonkey_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2418,7 +3611,7 @@ type Srv_FuzzRep struct {
 func (x *Srv_FuzzRep) Reset() {
 	*x = Srv_FuzzRep{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[31]
+		mi := &file_fuzzymonkey_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2431,7 +3624,7 @@ func (x *Srv_FuzzRep) String() string {
 func (*Srv_FuzzRep) ProtoMessage() {}
 
 func (x *Srv_FuzzRep) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[31]
+	mi := &file_fuzzymonkey_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2495,7 +3688,7 @@ type Srv_Call struct {
 func (x *Srv_Call) Reset() {
 	*x = Srv_Call{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[32]
+		mi := &file_fuzzymonkey_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2508,7 +3701,7 @@ func (x *Srv_Call) String() string {
 func (*Srv_Call) ProtoMessage() {}
 
 func (x *Srv_Call) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[32]
+	mi := &file_fuzzymonkey_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2554,7 +3747,7 @@ type Srv_Reset struct {
 func (x *Srv_Reset) Reset() {
 	*x = Srv_Reset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[33]
+		mi := &file_fuzzymonkey_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2567,7 +3760,7 @@ func (x *Srv_Reset) String() string {
 func (*Srv_Reset) ProtoMessage() {}
 
 func (x *Srv_Reset) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[33]
+	mi := &file_fuzzymonkey_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2599,7 +3792,7 @@ type Srv_FuzzingResult struct {
 func (x *Srv_FuzzingResult) Reset() {
 	*x = Srv_FuzzingResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[34]
+		mi := &file_fuzzymonkey_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2612,7 +3805,7 @@ func (x *Srv_FuzzingResult) String() string {
 func (*Srv_FuzzingResult) ProtoMessage() {}
 
 func (x *Srv_FuzzingResult) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[34]
+	mi := &file_fuzzymonkey_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2684,7 +3877,7 @@ type Srv_Call_Input struct {
 func (x *Srv_Call_Input) Reset() {
 	*x = Srv_Call_Input{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[35]
+		mi := &file_fuzzymonkey_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2697,7 +3890,7 @@ func (x *Srv_Call_Input) String() string {
 func (*Srv_Call_Input) ProtoMessage() {}
 
 func (x *Srv_Call_Input) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[35]
+	mi := &file_fuzzymonkey_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2751,7 +3944,7 @@ type Srv_Call_Input_HttpRequest struct {
 func (x *Srv_Call_Input_HttpRequest) Reset() {
 	*x = Srv_Call_Input_HttpRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[36]
+		mi := &file_fuzzymonkey_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2764,7 +3957,7 @@ func (x *Srv_Call_Input_HttpRequest) String() string {
 func (*Srv_Call_Input_HttpRequest) ProtoMessage() {}
 
 func (x *Srv_Call_Input_HttpRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[36]
+	mi := &file_fuzzymonkey_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2820,7 +4013,7 @@ type Srv_FuzzingResult_CounterexampleItem struct {
 func (x *Srv_FuzzingResult_CounterexampleItem) Reset() {
 	*x = Srv_FuzzingResult_CounterexampleItem{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[37]
+		mi := &file_fuzzymonkey_proto_msgTypes[51]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2833,7 +4026,7 @@ func (x *Srv_FuzzingResult_CounterexampleItem) String() string {
 func (*Srv_FuzzingResult_CounterexampleItem) ProtoMessage() {}
 
 func (x *Srv_FuzzingResult_CounterexampleItem) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[37]
+	mi := &file_fuzzymonkey_proto_msgTypes[51]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2906,7 +4099,7 @@ type Schema_JSON struct {
 func (x *Schema_JSON) Reset() {
 	*x = Schema_JSON{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[41]
+		mi := &file_fuzzymonkey_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2919,7 +4112,7 @@ func (x *Schema_JSON) String() string {
 func (*Schema_JSON) ProtoMessage() {}
 
 func (x *Schema_JSON) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[41]
+	mi := &file_fuzzymonkey_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3160,7 +4353,7 @@ type Schema_JSON_AdditionalProperties struct {
 func (x *Schema_JSON_AdditionalProperties) Reset() {
 	*x = Schema_JSON_AdditionalProperties{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_fuzzymonkey_proto_msgTypes[43]
+		mi := &file_fuzzymonkey_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -3173,7 +4366,7 @@ func (x *Schema_JSON_AdditionalProperties) String() string {
 func (*Schema_JSON_AdditionalProperties) ProtoMessage() {}
 
 func (x *Schema_JSON_AdditionalProperties) ProtoReflect() protoreflect.Message {
-	mi := &file_fuzzymonkey_proto_msgTypes[43]
+	mi := &file_fuzzymonkey_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3233,7 +4426,7 @@ var file_fuzzymonkey_proto_rawDesc = []byte{
 	0x0a, 0x11, 0x66, 0x75, 0x7a, 0x7a, 0x79, 0x6d, 0x6f, 0x6e, 0x6b, 0x65, 0x79, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x12, 0x02, 0x66, 0x6d, 0x1a, 0x1c, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbc, 0x15, 0x0a, 0x03, 0x43, 0x6c, 0x74, 0x12, 0x22, 0x0a,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xd3, 0x2b, 0x0a, 0x03, 0x43, 0x6c, 0x74, 0x12, 0x22, 0x0a,
 	0x04, 0x66, 0x75, 0x7a, 0x7a, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x6d,
 	0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x48, 0x00, 0x52, 0x04, 0x66, 0x75, 0x7a,
 	0x7a, 0x12, 0x3e, 0x0a, 0x0e, 0x72, 0x65, 0x73, 0x65, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72,
@@ -3253,7 +4446,7 @@ var file_fuzzymonkey_proto_rawDesc = []byte{
 	0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6d, 0x2e, 0x43,
 	0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x50, 0x72, 0x6f, 0x67,
 	0x72, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x11, 0x63, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0xfa, 0x07, 0x0a, 0x04, 0x46, 0x75,
+	0x66, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x1a, 0x86, 0x14, 0x0a, 0x04, 0x46, 0x75,
 	0x7a, 0x7a, 0x12, 0x33, 0x0a, 0x09, 0x72, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x73, 0x18,
 	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46,
 	0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x52, 0x09, 0x72, 0x65,
@@ -3278,295 +4471,485 @@ var file_fuzzymonkey_proto_rawDesc = []byte{
 	0x49, 0x44, 0x73, 0x12, 0x2d, 0x0a, 0x05, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03,
 	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a,
 	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x05, 0x66, 0x69, 0x6c,
-	0x65, 0x73, 0x1a, 0xc0, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12,
+	0x65, 0x73, 0x1a, 0xcc, 0x0d, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12,
 	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
 	0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x18,
 	0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x73, 0x12,
 	0x33, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
 	0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73,
 	0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x05, 0x73,
-	0x68, 0x65, 0x6c, 0x6c, 0x1a, 0x43, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a,
+	0x68, 0x65, 0x6c, 0x6c, 0x12, 0x30, 0x0a, 0x04, 0x68, 0x74, 0x74, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a,
+	0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00,
+	0x52, 0x04, 0x68, 0x74, 0x74, 0x70, 0x12, 0x3c, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68,
+	0x6f, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c,
+	0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e,
+	0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x48, 0x00, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x12, 0x39, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46,
+	0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f,
+	0x63, 0x65, 0x73, 0x73, 0x48, 0x00, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x12,
+	0x3c, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x2e,
+	0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72,
+	0x6b, 0x48, 0x00, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x12, 0x31, 0x0a,
+	0x05, 0x72, 0x65, 0x61, 0x64, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x66,
+	0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x52, 0x05, 0x72, 0x65, 0x61, 0x64, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x6f, 0x6e, 0x18, 0x09,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x4f, 0x6e, 0x12,
+	0x31, 0x0a, 0x05, 0x72, 0x65, 0x74, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x79, 0x52, 0x05, 0x72, 0x65, 0x74,
+	0x72, 0x79, 0x1a, 0xa8, 0x02, 0x0a, 0x05, 0x53, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x61, 0x72,
+	0x74, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x74,
+	0x61, 0x72, 0x74, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x73, 0x74, 0x5f, 0x66,
+	0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x73, 0x74, 0x46, 0x69,
+	0x6c, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x6f, 0x70, 0x46, 0x69, 0x6c, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
+	0x73, 0x68, 0x65, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52,
+	0x0e, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12,
+	0x24, 0x0a, 0x0e, 0x72, 0x73, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d,
+	0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x72, 0x73, 0x74, 0x54, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x4d, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d,
+	0x73, 0x74, 0x6f, 0x70, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x1a, 0xba, 0x02,
+	0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46,
+	0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54,
+	0x50, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x12, 0x34, 0x0a, 0x03, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x03, 0x72, 0x73, 0x74, 0x12, 0x36, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75,
+	0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e, 0x48, 0x54, 0x54, 0x50,
+	0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x1a, 0x89,
+	0x01, 0x0a, 0x07, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68,
+	0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0d, 0x52, 0x06, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x1a, 0x20, 0x0a, 0x08, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x70, 0x61, 0x74, 0x68, 0x73, 0x1a, 0xea, 0x01, 0x0a,
+	0x05, 0x50, 0x72, 0x6f, 0x62, 0x65, 0x12, 0x12, 0x0a, 0x03, 0x74, 0x63, 0x70, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x03, 0x74, 0x63, 0x70, 0x12, 0x36, 0x0a, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c,
+	0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2e,
+	0x50, 0x72, 0x6f, 0x62, 0x65, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x48, 0x00, 0x52, 0x04, 0x68, 0x74,
+	0x74, 0x70, 0x12, 0x1b, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x07, 0x6c, 0x6f, 0x67, 0x4c, 0x69, 0x6e, 0x65, 0x12,
+	0x1e, 0x0a, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x70, 0x72, 0x65, 0x64, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x6d, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x4d, 0x73, 0x1a, 0x30,
+	0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x42, 0x07, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x62, 0x65, 0x1a, 0xc5, 0x01, 0x0a, 0x07, 0x50, 0x72,
+	0x6f, 0x63, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12,
+	0x38, 0x0a, 0x03, 0x65, 0x6e, 0x76, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x66,
+	0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x2e, 0x50, 0x72, 0x6f, 0x63, 0x65, 0x73, 0x73, 0x2e, 0x45, 0x6e, 0x76, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x03, 0x65, 0x6e, 0x76, 0x12, 0x28, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x4f, 0x6e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x1a, 0x36, 0x0a, 0x08, 0x45, 0x6e, 0x76, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
+	0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65,
+	0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x4a, 0x04, 0x08, 0x03, 0x10,
+	0x04, 0x1a, 0x46, 0x0a, 0x08, 0x53, 0x74, 0x61, 0x72, 0x6c, 0x61, 0x72, 0x6b, 0x12, 0x14, 0x0a,
 	0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74,
 	0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
 	0x52, 0x03, 0x72, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73,
-	0x65, 0x74, 0x74, 0x65, 0x72, 0x1a, 0xb7, 0x01, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e,
-	0x46, 0x75, 0x7a, 0x7a, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41,
-	0x50, 0x49, 0x76, 0x33, 0x48, 0x00, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76,
-	0x33, 0x1a, 0x53, 0x0a, 0x09, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x50, 0x49, 0x76, 0x33, 0x12, 0x1e,
-	0x0a, 0x04, 0x73, 0x70, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66,
-	0x6d, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x49, 0x52, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x12,
-	0x0a, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69,
-	0x6c, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x1a,
-	0x44, 0x0a, 0x09, 0x45, 0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
-	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21,
-	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e,
-	0x66, 0x6d, 0x2e, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
-	0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x1a, 0x3a, 0x0a, 0x0c, 0x45, 0x6e, 0x76, 0x52, 0x65, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x74, 0x6f, 0x70, 0x1a, 0x5a, 0x0a, 0x05, 0x52, 0x65, 0x74,
+	0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
+	0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x5f, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x6f, 0x66, 0x66, 0x4d, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x72,
+	0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x0a, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x1a, 0xb7, 0x01, 0x0a, 0x05, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x3c, 0x0a, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x46, 0x75, 0x7a, 0x7a,
+	0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x6c, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x50, 0x49, 0x76, 0x33,
+	0x48, 0x00, 0x52, 0x09, 0x6f, 0x70, 0x65, 0x6e, 0x61, 0x70, 0x69, 0x76, 0x33, 0x1a, 0x53, 0x0a,
+	0x09, 0x4f, 0x70, 0x65, 0x6e, 0x41, 0x50, 0x49, 0x76, 0x33, 0x12, 0x1e, 0x0a, 0x04, 0x73, 0x70,
+	0x65, 0x63, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x70,
+	0x65, 0x63, 0x49, 0x52, 0x52, 0x04, 0x73, 0x70, 0x65, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x69,
+	0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x1a, 0x44, 0x0a, 0x09, 0x45,
+	0x49, 0x44, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x21, 0x0a, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x66, 0x6d, 0x2e, 0x55,
+	0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38,
+	0x01, 0x1a, 0x39, 0x0a, 0x0b, 0x4c, 0x61, 0x62, 0x65, 0x6c, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
 	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
 	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a,
-	0x46, 0x69, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
-	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0xb4, 0x01, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c,
-	0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d,
-	0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x09, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x73, 0x12, 0x16, 0x0a,
-	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72,
-	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x36, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x74, 0x61,
-	0x72, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x10,
-	0x02, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x1a, 0xf1, 0x02,
-	0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x77,
-	0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1c, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x77, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x92, 0x02, 0x0a,
-	0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x4d, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66,
-	0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x52, 0x61, 0x77, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0xb0, 0x01, 0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a,
-	0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12,
-	0x28, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
-	0x32, 0x0e, 0x2e, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72,
-	0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64,
-	0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x39, 0x0a,
-	0x0c, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20,
+	0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x3a, 0x0a, 0x0c,
+	0x45, 0x6e, 0x76, 0x52, 0x65, 0x61, 0x64, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x1a, 0x38, 0x0a, 0x0a, 0x46, 0x69, 0x6c, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02,
+	0x38, 0x01, 0x1a, 0xe0, 0x03, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x52, 0x65,
+	0x73, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c,
+	0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65,
+	0x74, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x52, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x36, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1c, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x07,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a, 0x3a, 0x0a, 0x0c, 0x52, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x1a, 0x78, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x72, 0x65, 0x73, 0x65, 0x74, 0x74, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6e,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x69, 0x6e, 0x65, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1e, 0x0a,
+	0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x22, 0x36, 0x0a,
+	0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x08, 0x0a, 0x04, 0x4e, 0x4f, 0x4f, 0x50, 0x10,
+	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x74, 0x61, 0x72, 0x74, 0x65, 0x64, 0x10, 0x01, 0x12, 0x09,
+	0x0a, 0x05, 0x65, 0x6e, 0x64, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x61, 0x69,
+	0x6c, 0x65, 0x64, 0x10, 0x03, 0x1a, 0xf1, 0x02, 0x0a, 0x0e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x77, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74,
+	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x77, 0x2e,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65,
+	0x61, 0x73, 0x6f, 0x6e, 0x1a, 0x92, 0x02, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x4d,
+	0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x77, 0x2e, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00,
+	0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0xb0, 0x01,
+	0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
+	0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6d, 0x2e, 0x48, 0x65,
+	0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x64, 0x65,
+	0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61,
+	0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64,
+	0x42, 0x07, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0xa5, 0x0b, 0x0a, 0x0f, 0x43, 0x61,
+	0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x77, 0x12, 0x36, 0x0a,
+	0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
+	0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x52, 0x61, 0x77, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f,
+	0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49,
+	0x64, 0x1a, 0xbd, 0x0a, 0x0a, 0x06, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x52, 0x0a, 0x0d,
+	0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x77, 0x2e, 0x4f, 0x75, 0x74,
+	0x70, 0x75, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x48, 0x00, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x1a, 0xd4, 0x09, 0x0a, 0x0c, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f,
+	0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x39,
+	0x0a, 0x0c, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6f,
+	0x64, 0x79, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65,
+	0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x73, 0x12, 0x4d, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x33, 0x2e, 0x66, 0x6d, 0x2e, 0x43,
+	0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52,
+	0x61, 0x77, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x07,
+	0x74, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x4e, 0x0a, 0x07, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x34, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c,
+	0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61,
+	0x77, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x07,
+	0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x49, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x31, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x77, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x0a, 0x10,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x74, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x54, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x62, 0x6f, 0x64, 0x79, 0x5f,
+	0x73, 0x69, 0x7a, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x62, 0x6f, 0x64, 0x79,
+	0x53, 0x69, 0x7a, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x73, 0x68, 0x61,
+	0x32, 0x35, 0x36, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x53,
+	0x68, 0x61, 0x32, 0x35, 0x36, 0x12, 0x25, 0x0a, 0x0e, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x74, 0x72,
+	0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x62,
+	0x6f, 0x64, 0x79, 0x54, 0x72, 0x75, 0x6e, 0x63, 0x61, 0x74, 0x65, 0x64, 0x1a, 0xf1, 0x01, 0x0a,
+	0x07, 0x54, 0x69, 0x6d, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x15, 0x0a, 0x06, 0x64, 0x6e, 0x73, 0x5f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x64, 0x6e, 0x73, 0x4e, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x5f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x73, 0x12, 0x15,
+	0x0a, 0x06, 0x74, 0x6c, 0x73, 0x5f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05,
+	0x74, 0x6c, 0x73, 0x4e, 0x73, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x74, 0x66, 0x62, 0x5f, 0x6e, 0x73,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x74, 0x74, 0x66, 0x62, 0x4e, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x5f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x0a, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x4e, 0x73, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x72, 0x65, 0x75, 0x73, 0x65, 0x64, 0x43, 0x6f, 0x6e, 0x6e,
+	0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x12,
+	0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72,
+	0x1a, 0xb6, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x28, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69,
+	0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c,
+	0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x73, 0x1a, 0x9b, 0x01, 0x0a, 0x05, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x64, 0x61, 0x74, 0x61, 0x12, 0x39, 0x0a,
+	0x0c, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x04, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6f, 0x64,
-	0x79, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
-	0x74, 0x1a, 0xc6, 0x03, 0x0a, 0x0f, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x52, 0x61, 0x77, 0x12, 0x36, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x77, 0x2e, 0x4f,
-	0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x12, 0x1a, 0x0a,
-	0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x08, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x64, 0x1a, 0xde, 0x02, 0x0a, 0x06, 0x4f, 0x75,
-	0x74, 0x70, 0x75, 0x74, 0x12, 0x52, 0x0a, 0x0d, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x66, 0x6d,
-	0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x52, 0x61, 0x77, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0c, 0x68, 0x74, 0x74, 0x70,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x1a, 0xf5, 0x01, 0x0a, 0x0c, 0x48, 0x74, 0x74,
-	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72,
-	0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12,
-	0x1f, 0x0a, 0x0b, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65,
-	0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x28, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64,
-	0x65, 0x72, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6d, 0x2e, 0x48,
-	0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65,
-	0x72, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c,
-	0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x12, 0x39, 0x0a, 0x0c, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x64,
-	0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56,
-	0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x62, 0x6f, 0x64, 0x79, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-	0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18,
-	0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x73,
-	0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x1a, 0x80, 0x03, 0x0a, 0x11, 0x43,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x0b, 0x64, 0x61, 0x74,
+	0x61, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x70,
+	0x73, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c,
+	0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x73, 0x42, 0x08, 0x0a, 0x06, 0x6f, 0x75, 0x74, 0x70, 0x75,
+	0x74, 0x1a, 0x80, 0x03, 0x0a, 0x11, 0x43, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x66, 0x6d,
+	0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38, 0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43,
 	0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61,
-	0x6c, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x38,
-	0x0a, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20,
-	0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x56, 0x65, 0x72, 0x69,
-	0x66, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
-	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
-	0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c, 0x61, 0x70, 0x73, 0x65, 0x64, 0x4e, 0x73, 0x12,
-	0x27, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x65,
-	0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x73, 0x22, 0x48, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10,
-	0x00, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x10, 0x01, 0x12, 0x0b,
-	0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x66,
-	0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65,
-	0x10, 0x04, 0x22, 0x39, 0x0a, 0x06, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12, 0x0d, 0x0a, 0x09,
-	0x4e, 0x4f, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x62,
-	0x75, 0x69, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x10, 0x01, 0x12, 0x12, 0x0a, 0x0e, 0x61, 0x66, 0x74,
-	0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x10, 0x02, 0x42, 0x05, 0x0a,
-	0x03, 0x6d, 0x73, 0x67, 0x22, 0xc5, 0x0d, 0x0a, 0x03, 0x53, 0x72, 0x76, 0x12, 0x42, 0x0a, 0x10,
-	0x66, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e,
-	0x46, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x0f, 0x66, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73,
-	0x12, 0x2c, 0x0a, 0x08, 0x66, 0x75, 0x7a, 0x7a, 0x5f, 0x72, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x46, 0x75, 0x7a, 0x7a,
-	0x52, 0x65, 0x70, 0x48, 0x00, 0x52, 0x07, 0x66, 0x75, 0x7a, 0x7a, 0x52, 0x65, 0x70, 0x12, 0x22,
-	0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66,
-	0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61,
-	0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74,
-	0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74, 0x12, 0x3e, 0x0a, 0x0e, 0x66, 0x75, 0x7a,
-	0x7a, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48, 0x00, 0x52, 0x0d, 0x66, 0x75, 0x7a, 0x7a,
-	0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x1a, 0xd9, 0x03, 0x0a, 0x0f, 0x46, 0x75,
-	0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a,
-	0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
-	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65,
-	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x73,
-	0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x61,
-	0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
-	0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c,
-	0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
-	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65,
-	0x63, 0x6b, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63,
-	0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74,
-	0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x28, 0x0a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x43,
-	0x61, 0x6c, 0x6c, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x61, 0x6c,
-	0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x61, 0x6c, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x73, 0x5f, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x11, 0x63, 0x61, 0x6c, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x53, 0x6b,
-	0x69, 0x70, 0x70, 0x65, 0x64, 0x1a, 0xd9, 0x01, 0x0a, 0x07, 0x46, 0x75, 0x7a, 0x7a, 0x52, 0x65,
-	0x70, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x54,
-	0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04, 0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x40, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63,
-	0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x73, 0x50, 0x65, 0x72,
-	0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x3a, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68,
-	0x65, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x45, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63,
-	0x6b, 0x1a, 0xc9, 0x02, 0x0a, 0x04, 0x43, 0x61, 0x6c, 0x6c, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x66, 0x6d, 0x2e, 0x53,
-	0x72, 0x76, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x45, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x03, 0x45, 0x49, 0x44, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65,
-	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xe5, 0x01, 0x0a, 0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
-	0x43, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x43,
-	0x61, 0x6c, 0x6c, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x8d, 0x01, 0x0a, 0x0b, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03,
-	0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28,
-	0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0e, 0x2e, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52,
-	0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12, 0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04,
-	0x62, 0x6f, 0x64, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x1a, 0x07, 0x0a,
-	0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x1a, 0xac, 0x03, 0x0a, 0x0d, 0x46, 0x75, 0x7a, 0x7a, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64,
-	0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x73, 0x65, 0x65,
-	0x64, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x73, 0x5f, 0x73, 0x68, 0x72,
-	0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x61,
-	0x73, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65,
-	0x78, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e,
-	0x65, 0x78, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x26, 0x0a, 0x0f, 0x77, 0x69, 0x6c, 0x6c, 0x5f,
-	0x6e, 0x6f, 0x77, 0x5f, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0d, 0x77, 0x69, 0x6c, 0x6c, 0x4e, 0x6f, 0x77, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x12,
-	0x25, 0x0a, 0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x65,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74,
-	0x65, 0x64, 0x53, 0x65, 0x65, 0x64, 0x12, 0x50, 0x0a, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28,
-	0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x52,
-	0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x78, 0x61,
-	0x6d, 0x70, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65,
-	0x72, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a, 0x9a, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x65, 0x72, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12,
-	0x3f, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43,
-	0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x52, 0x61, 0x77, 0x2e, 0x49, 0x6e,
-	0x70, 0x75, 0x74, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x43, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74,
-	0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x77,
-	0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x21, 0x0a, 0x07,
-	0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22,
-	0x36, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x12, 0x10, 0x0a,
-	0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12,
-	0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
-	0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xb4, 0x01, 0x0a, 0x06, 0x53, 0x70, 0x65, 0x63,
-	0x49, 0x52, 0x12, 0x25, 0x0a, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73,
-	0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x37, 0x0a, 0x09, 0x65, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66,
-	0x6d, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x49, 0x52, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-	0x74, 0x73, 0x1a, 0x4a, 0x0a, 0x0e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x6d, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x82,
-	0x01, 0x0a, 0x07, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x12, 0x29, 0x0a, 0x04, 0x6a, 0x73,
-	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x73, 0x2e, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
-	0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x1a, 0x4c, 0x0a, 0x09, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6d, 0x2e, 0x52, 0x65, 0x66, 0x4f, 0x72, 0x53, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
-	0x02, 0x38, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x52, 0x65, 0x66, 0x4f, 0x72, 0x53, 0x63, 0x68, 0x65,
-	0x6d, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x21, 0x0a, 0x03, 0x70, 0x74, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x50,
-	0x74, 0x72, 0x48, 0x00, 0x52, 0x03, 0x70, 0x74, 0x72, 0x12, 0x29, 0x0a, 0x06, 0x73, 0x63, 0x68,
-	0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x6d, 0x2e, 0x53,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4a, 0x53, 0x4f, 0x4e, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63,
-	0x68, 0x65, 0x6d, 0x61, 0x42, 0x0f, 0x0a, 0x0d, 0x70, 0x74, 0x72, 0x5f, 0x6f, 0x72, 0x5f, 0x73,
-	0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x2f, 0x0a, 0x09, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x50,
-	0x74, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x03, 0x53, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x3e, 0x0a, 0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-	0x6e, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x10, 0x2e, 0x66, 0x6d, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4a, 0x53,
-	0x4f, 0x4e, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x65, 0x6e,
-	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x8a, 0x03, 0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-	0x69, 0x6e, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x2f, 0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f,
-	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x66, 0x6d, 0x2e, 0x45, 0x6e, 0x64,
-	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x34, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x68,
-	0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x0f, 0x2e, 0x66, 0x6d, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c,
-	0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x25,
-	0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d,
-	0x2e, 0x66, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x4a, 0x53, 0x4f, 0x4e, 0x52, 0x06, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
-	0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x6d, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-	0x6f, 0x69, 0x6e, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x1a, 0x3a,
+	0x2e, 0x4f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x52, 0x06, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x12,
+	0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x6c, 0x61, 0x70, 0x73,
+	0x65, 0x64, 0x5f, 0x6e, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x6c, 0x61,
+	0x70, 0x73, 0x65, 0x64, 0x4e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x0e, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x65, 0x70, 0x73, 0x22,
+	0x48, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64,
+	0x10, 0x02, 0x12, 0x0b, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x10, 0x03, 0x12,
+	0x08, 0x0a, 0x04, 0x64, 0x6f, 0x6e, 0x65, 0x10, 0x04, 0x22, 0x39, 0x0a, 0x06, 0x4f, 0x72, 0x69,
+	0x67, 0x69, 0x6e, 0x12, 0x0d, 0x0a, 0x09, 0x4e, 0x4f, 0x5f, 0x4f, 0x52, 0x49, 0x47, 0x49, 0x4e,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x5f, 0x69, 0x6e, 0x10, 0x01,
+	0x12, 0x12, 0x0a, 0x0e, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x10, 0x02, 0x42, 0x05, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x22, 0xc5, 0x0d, 0x0a, 0x03,
+	0x53, 0x72, 0x76, 0x12, 0x42, 0x0a, 0x10, 0x66, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5f, 0x70,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x50, 0x72,
+	0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x52, 0x0f, 0x66, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x50,
+	0x72, 0x6f, 0x67, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x08, 0x66, 0x75, 0x7a, 0x7a, 0x5f,
+	0x72, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x6d, 0x2e, 0x53,
+	0x72, 0x76, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x52, 0x65, 0x70, 0x48, 0x00, 0x52, 0x07, 0x66, 0x75,
+	0x7a, 0x7a, 0x52, 0x65, 0x70, 0x12, 0x22, 0x0a, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x43, 0x61, 0x6c,
+	0x6c, 0x48, 0x00, 0x52, 0x04, 0x63, 0x61, 0x6c, 0x6c, 0x12, 0x25, 0x0a, 0x05, 0x72, 0x65, 0x73,
+	0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72,
+	0x76, 0x2e, 0x52, 0x65, 0x73, 0x65, 0x74, 0x48, 0x00, 0x52, 0x05, 0x72, 0x65, 0x73, 0x65, 0x74,
+	0x12, 0x3e, 0x0a, 0x0e, 0x66, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x65, 0x73, 0x75,
+	0x6c, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72,
+	0x76, 0x2e, 0x46, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x48,
+	0x00, 0x52, 0x0d, 0x66, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x1a, 0xd9, 0x03, 0x0a, 0x0f, 0x46, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x50, 0x72, 0x6f, 0x67,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x53, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65,
+	0x63, 0x6b, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x46, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x12, 0x2c, 0x0a, 0x12, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x6c, 0x61, 0x73, 0x74, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73,
+	0x12, 0x2a, 0x0a, 0x11, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x65, 0x73, 0x74, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x54, 0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x63, 0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x61,
+	0x6c, 0x6c, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61,
+	0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x10, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x28, 0x0a, 0x10, 0x74, 0x65, 0x73, 0x74, 0x5f, 0x63,
+	0x61, 0x6c, 0x6c, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x74, 0x65, 0x73, 0x74, 0x43, 0x61, 0x6c, 0x6c, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x2a, 0x0a, 0x11, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x61, 0x6c,
+	0x6c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x13,
+	0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x5f, 0x73, 0x6b, 0x69, 0x70,
+	0x70, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x61, 0x6c, 0x6c, 0x43,
+	0x68, 0x65, 0x63, 0x6b, 0x73, 0x53, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x1a, 0xd9, 0x01, 0x0a,
+	0x07, 0x46, 0x75, 0x7a, 0x7a, 0x52, 0x65, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x61, 0x78, 0x5f,
+	0x74, 0x65, 0x73, 0x74, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x0d, 0x6d, 0x61, 0x78, 0x54, 0x65, 0x73, 0x74, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x73, 0x65, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x04,
+	0x73, 0x65, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x40, 0x0a, 0x1d, 0x6d, 0x61,
+	0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x74, 0x65, 0x70,
+	0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x65, 0x70, 0x73, 0x50, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x12, 0x3a, 0x0a, 0x1a,
+	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x5f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x16, 0x6d, 0x61, 0x78, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x73,
+	0x50, 0x65, 0x72, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x1a, 0xc9, 0x02, 0x0a, 0x04, 0x43, 0x61, 0x6c,
+	0x6c, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x12, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x45,
+	0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x45, 0x49, 0x44, 0x12, 0x1d, 0x0a,
+	0x0a, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x6d, 0x6f, 0x64, 0x65, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x1a, 0xe5, 0x01, 0x0a,
+	0x05, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x43, 0x0a, 0x0c, 0x68, 0x74, 0x74, 0x70, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66,
+	0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x2e, 0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x48, 0x00, 0x52, 0x0b,
+	0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x8d, 0x01, 0x0a, 0x0b,
+	0x48, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x68, 0x6f, 0x64, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x03, 0x75, 0x72, 0x6c, 0x12, 0x28, 0x0a, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0e, 0x2e, 0x66, 0x6d, 0x2e, 0x48, 0x65, 0x61, 0x64,
+	0x65, 0x72, 0x50, 0x61, 0x69, 0x72, 0x52, 0x07, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x12,
+	0x2a, 0x0a, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x56, 0x61, 0x6c, 0x75, 0x65, 0x52, 0x04, 0x62, 0x6f, 0x64, 0x79, 0x42, 0x07, 0x0a, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x1a, 0x07, 0x0a, 0x05, 0x52, 0x65, 0x73, 0x65, 0x74, 0x1a, 0xac, 0x03,
+	0x0a, 0x0d, 0x46, 0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x1b, 0x0a, 0x09, 0x73, 0x65, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x73, 0x65, 0x65, 0x64, 0x55, 0x73, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x77, 0x61, 0x73, 0x5f, 0x73, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x77, 0x61, 0x73, 0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x69, 0x6e,
+	0x67, 0x12, 0x1b, 0x0a, 0x09, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x6e, 0x65, 0x78, 0x74, 0x53, 0x65, 0x65, 0x64, 0x12, 0x26,
+	0x0a, 0x0f, 0x77, 0x69, 0x6c, 0x6c, 0x5f, 0x6e, 0x6f, 0x77, 0x5f, 0x73, 0x68, 0x72, 0x69, 0x6e,
+	0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x77, 0x69, 0x6c, 0x6c, 0x4e, 0x6f, 0x77,
+	0x53, 0x68, 0x72, 0x69, 0x6e, 0x6b, 0x12, 0x25, 0x0a, 0x0e, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x65, 0x64, 0x5f, 0x73, 0x65, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0d,
+	0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x65, 0x64, 0x53, 0x65, 0x65, 0x64, 0x12, 0x50, 0x0a,
+	0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x72, 0x76, 0x2e, 0x46,
+	0x75, 0x7a, 0x7a, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x2e, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x65, 0x72, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x0e, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x78, 0x61, 0x6d, 0x70, 0x6c, 0x65, 0x1a,
+	0x9a, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x65, 0x72, 0x65, 0x78, 0x61, 0x6d, 0x70,
+	0x6c, 0x65, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x61, 0x6c, 0x6c, 0x5f, 0x72,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x66,
+	0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x52, 0x61, 0x77, 0x2e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0d, 0x63, 0x61, 0x6c, 0x6c, 0x5f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x66, 0x6d, 0x2e, 0x43, 0x6c, 0x74, 0x2e, 0x43, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x52, 0x61, 0x77, 0x2e, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x52, 0x0c,
+	0x63, 0x61, 0x6c, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x05, 0x0a, 0x03,
+	0x6d, 0x73, 0x67, 0x22, 0x21, 0x0a, 0x07, 0x55, 0x69, 0x6e, 0x74, 0x33, 0x32, 0x73, 0x12, 0x16,
+	0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0x36, 0x0a, 0x0a, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72,
+	0x50, 0x61, 0x69, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x22, 0xb4,
+	0x01, 0x0a, 0x06, 0x53, 0x70, 0x65, 0x63, 0x49, 0x52, 0x12, 0x25, 0x0a, 0x07, 0x73, 0x63, 0x68,
+	0x65, 0x6d, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x66, 0x6d, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x52, 0x07, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73,
+	0x12, 0x37, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x70, 0x65, 0x63, 0x49, 0x52, 0x2e,
+	0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x09,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x1a, 0x4a, 0x0a, 0x0e, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b,
+	0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x22, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0c, 0x2e, 0x66,
+	0x6d, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x82, 0x01, 0x0a, 0x07, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61,
+	0x73, 0x12, 0x29, 0x0a, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x73, 0x2e, 0x4a, 0x73, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x04, 0x6a, 0x73, 0x6f, 0x6e, 0x1a, 0x4c, 0x0a, 0x09,
+	0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x29, 0x0a, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x66, 0x6d, 0x2e,
+	0x52, 0x65, 0x66, 0x4f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x52,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x70, 0x0a, 0x0f, 0x52, 0x65,
+	0x66, 0x4f, 0x72, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x21, 0x0a,
+	0x03, 0x70, 0x74, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x6d, 0x2e,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x50, 0x74, 0x72, 0x48, 0x00, 0x52, 0x03, 0x70, 0x74, 0x72,
+	0x12, 0x29, 0x0a, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x0f, 0x2e, 0x66, 0x6d, 0x2e, 0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x2e, 0x4a, 0x53, 0x4f,
+	0x4e, 0x48, 0x00, 0x52, 0x06, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x42, 0x0f, 0x0a, 0x0d, 0x70,
+	0x74, 0x72, 0x5f, 0x6f, 0x72, 0x5f, 0x73, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x22, 0x2f, 0x0a, 0x09,
+	0x53, 0x63, 0x68, 0x65, 0x6d, 0x61, 0x50, 0x74, 0x72, 0x12, 0x10, 0x0a, 0x03, 0x53, 0x49, 0x44,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x53, 0x49, 0x44, 0x12, 0x10, 0x0a, 0x03, 0x72,
+	0x65, 0x66, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x72, 0x65, 0x66, 0x22, 0x3e, 0x0a,
+	0x08, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x10, 0x2e, 0x66, 0x6d, 0x2e, 0x45, 0x6e, 0x64,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x48, 0x00, 0x52, 0x04, 0x6a, 0x73, 0x6f,
+	0x6e, 0x42, 0x0a, 0x0a, 0x08, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0xd3, 0x04,
+	0x0a, 0x0c, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x12, 0x2f,
+	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17,
+	0x2e, 0x66, 0x6d, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4a, 0x53, 0x4f, 0x4e,
+	0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x06, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
+	0x34, 0x0a, 0x0d, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x66, 0x6d, 0x2e, 0x50, 0x61, 0x74, 0x68,
+	0x50, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x52, 0x0c, 0x70, 0x61, 0x74, 0x68, 0x50, 0x61, 0x72,
+	0x74, 0x69, 0x61, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x66, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x4a, 0x53, 0x4f, 0x4e, 0x52, 0x06, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x73, 0x12, 0x37, 0x0a, 0x07,
+	0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x66, 0x6d, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4a, 0x53, 0x4f, 0x4e, 0x2e,
+	0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6f, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x61, 0x67, 0x73,
+	0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x74, 0x61, 0x67, 0x73, 0x12, 0x1e, 0x0a, 0x0a,
+	0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x64, 0x65, 0x70, 0x72, 0x65, 0x63, 0x61, 0x74, 0x65, 0x64, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6b, 0x69, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x04, 0x73, 0x6b, 0x69, 0x70,
+	0x12, 0x16, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x6d, 0x61, 0x78, 0x5f,
+	0x6c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x5f, 0x6d, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0c, 0x6d, 0x61, 0x78, 0x4c, 0x61, 0x74, 0x65, 0x6e, 0x63, 0x79, 0x4d, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x18, 0x0b, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x64, 0x65, 0x6d, 0x70, 0x6f, 0x74, 0x65, 0x6e, 0x74, 0x1a, 0x3a,
 	0x0a, 0x0c, 0x4f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10,
 	0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x03, 0x6b, 0x65, 0x79,
 	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52,
@@ -3704,59 +5087,73 @@ func file_fuzzymonkey_proto_rawDescGZIP() []byte {
 }
 
 var file_fuzzymonkey_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_fuzzymonkey_proto_msgTypes = make([]protoimpl.MessageInfo, 44)
+var file_fuzzymonkey_proto_msgTypes = make([]protoimpl.MessageInfo, 58)
 var file_fuzzymonkey_proto_goTypes = []interface{}{
-	(Clt_ResetProgress_Status)(0),     // 0: fm.Clt.ResetProgress.Status
-	(Clt_CallVerifProgress_Status)(0), // 1: fm.Clt.CallVerifProgress.Status
-	(Clt_CallVerifProgress_Origin)(0), // 2: fm.Clt.CallVerifProgress.Origin
-	(EndpointJSON_Method)(0),          // 3: fm.EndpointJSON.Method
-	(ParamJSON_Kind)(0),               // 4: fm.ParamJSON.Kind
-	(Schema_JSON_Type)(0),             // 5: fm.Schema.JSON.Type
-	(*Clt)(nil),                       // 6: fm.Clt
-	(*Srv)(nil),                       // 7: fm.Srv
-	(*Uint32S)(nil),                   // 8: fm.Uint32s
-	(*HeaderPair)(nil),                // 9: fm.HeaderPair
-	(*SpecIR)(nil),                    // 10: fm.SpecIR
-	(*Schemas)(nil),                   // 11: fm.Schemas
-	(*RefOrSchemaJSON)(nil),           // 12: fm.RefOrSchemaJSON
-	(*SchemaPtr)(nil),                 // 13: fm.SchemaPtr
-	(*Endpoint)(nil),                  // 14: fm.Endpoint
-	(*EndpointJSON)(nil),              // 15: fm.EndpointJSON
-	(*ParamJSON)(nil),                 // 16: fm.ParamJSON
-	(*PathPartial)(nil),               // 17: fm.PathPartial
-	(*Schema)(nil),                    // 18: fm.Schema
-	(*Clt_Fuzz)(nil),                  // 19: fm.Clt.Fuzz
-	(*Clt_ResetProgress)(nil),         // 20: fm.Clt.ResetProgress
-	(*Clt_CallRequestRaw)(nil),        // 21: fm.Clt.CallRequestRaw
-	(*Clt_CallResponseRaw)(nil),       // 22: fm.Clt.CallResponseRaw
-	(*Clt_CallVerifProgress)(nil),     // 23: fm.Clt.CallVerifProgress
-	(*Clt_Fuzz_Resetter)(nil),         // 24: fm.Clt.Fuzz.Resetter
-	(*Clt_Fuzz_Model)(nil),            // 25: fm.Clt.Fuzz.Model
-	nil,                               // 26: fm.Clt.Fuzz.EIDsEntry
-	nil,                               // 27: fm.Clt.Fuzz.LabelsEntry
-	nil,                               // 28: fm.Clt.Fuzz.EnvReadEntry
-	nil,                               // 29: fm.Clt.Fuzz.FilesEntry
-	(*Clt_Fuzz_Resetter_Shell)(nil),   // 30: fm.Clt.Fuzz.Resetter.Shell
-	(*Clt_Fuzz_Model_OpenAPIv3)(nil),  // 31: fm.Clt.Fuzz.Model.OpenAPIv3
-	(*Clt_CallRequestRaw_Input)(nil),  // 32: fm.Clt.CallRequestRaw.Input
-	(*Clt_CallRequestRaw_Input_HttpRequest)(nil),    // 33: fm.Clt.CallRequestRaw.Input.HttpRequest
-	(*Clt_CallResponseRaw_Output)(nil),              // 34: fm.Clt.CallResponseRaw.Output
-	(*Clt_CallResponseRaw_Output_HttpResponse)(nil), // 35: fm.Clt.CallResponseRaw.Output.HttpResponse
-	(*Srv_FuzzingProgress)(nil),                     // 36: fm.Srv.FuzzingProgress
-	(*Srv_FuzzRep)(nil),                             // 37: fm.Srv.FuzzRep
-	(*Srv_Call)(nil),                                // 38: fm.Srv.Call
-	(*Srv_Reset)(nil),                               // 39: fm.Srv.Reset
-	(*Srv_FuzzingResult)(nil),                       // 40: fm.Srv.FuzzingResult
-	(*Srv_Call_Input)(nil),                          // 41: fm.Srv.Call.Input
-	(*Srv_Call_Input_HttpRequest)(nil),              // 42: fm.Srv.Call.Input.HttpRequest
-	(*Srv_FuzzingResult_CounterexampleItem)(nil),    // 43: fm.Srv.FuzzingResult.CounterexampleItem
-	nil,                                      // 44: fm.SpecIR.EndpointsEntry
-	nil,                                      // 45: fm.Schemas.JsonEntry
-	nil,                                      // 46: fm.EndpointJSON.OutputsEntry
-	(*Schema_JSON)(nil),                      // 47: fm.Schema.JSON
-	nil,                                      // 48: fm.Schema.JSON.PropertiesEntry
-	(*Schema_JSON_AdditionalProperties)(nil), // 49: fm.Schema.JSON.AdditionalProperties
-	(*structpb.Value)(nil),                   // 50: google.protobuf.Value
+	(Clt_ResetProgress_Status)(0),          // 0: fm.Clt.ResetProgress.Status
+	(Clt_CallVerifProgress_Status)(0),      // 1: fm.Clt.CallVerifProgress.Status
+	(Clt_CallVerifProgress_Origin)(0),      // 2: fm.Clt.CallVerifProgress.Origin
+	(EndpointJSON_Method)(0),               // 3: fm.EndpointJSON.Method
+	(ParamJSON_Kind)(0),                    // 4: fm.ParamJSON.Kind
+	(Schema_JSON_Type)(0),                  // 5: fm.Schema.JSON.Type
+	(*Clt)(nil),                            // 6: fm.Clt
+	(*Srv)(nil),                            // 7: fm.Srv
+	(*Uint32S)(nil),                        // 8: fm.Uint32s
+	(*HeaderPair)(nil),                     // 9: fm.HeaderPair
+	(*SpecIR)(nil),                         // 10: fm.SpecIR
+	(*Schemas)(nil),                        // 11: fm.Schemas
+	(*RefOrSchemaJSON)(nil),                // 12: fm.RefOrSchemaJSON
+	(*SchemaPtr)(nil),                      // 13: fm.SchemaPtr
+	(*Endpoint)(nil),                       // 14: fm.Endpoint
+	(*EndpointJSON)(nil),                   // 15: fm.EndpointJSON
+	(*ParamJSON)(nil),                      // 16: fm.ParamJSON
+	(*PathPartial)(nil),                    // 17: fm.PathPartial
+	(*Schema)(nil),                         // 18: fm.Schema
+	(*Clt_Fuzz)(nil),                       // 19: fm.Clt.Fuzz
+	(*Clt_ResetProgress)(nil),              // 20: fm.Clt.ResetProgress
+	(*Clt_CallRequestRaw)(nil),             // 21: fm.Clt.CallRequestRaw
+	(*Clt_CallResponseRaw)(nil),            // 22: fm.Clt.CallResponseRaw
+	(*Clt_CallVerifProgress)(nil),          // 23: fm.Clt.CallVerifProgress
+	(*Clt_Fuzz_Resetter)(nil),              // 24: fm.Clt.Fuzz.Resetter
+	(*Clt_Fuzz_Model)(nil),                 // 25: fm.Clt.Fuzz.Model
+	nil,                                    // 26: fm.Clt.Fuzz.EIDsEntry
+	nil,                                    // 27: fm.Clt.Fuzz.LabelsEntry
+	nil,                                    // 28: fm.Clt.Fuzz.EnvReadEntry
+	nil,                                    // 29: fm.Clt.Fuzz.FilesEntry
+	(*Clt_Fuzz_Resetter_Shell)(nil),        // 30: fm.Clt.Fuzz.Resetter.Shell
+	(*Clt_Fuzz_Resetter_HTTP)(nil),         // 31: fm.Clt.Fuzz.Resetter.HTTP
+	(*Clt_Fuzz_Resetter_Snapshot)(nil),     // 32: fm.Clt.Fuzz.Resetter.Snapshot
+	(*Clt_Fuzz_Resetter_Probe)(nil),        // 33: fm.Clt.Fuzz.Resetter.Probe
+	(*Clt_Fuzz_Resetter_Process)(nil),      // 34: fm.Clt.Fuzz.Resetter.Process
+	(*Clt_Fuzz_Resetter_Starlark)(nil),     // 35: fm.Clt.Fuzz.Resetter.Starlark
+	(*Clt_Fuzz_Resetter_Retry)(nil),        // 36: fm.Clt.Fuzz.Resetter.Retry
+	(*Clt_Fuzz_Resetter_HTTP_Request)(nil), // 37: fm.Clt.Fuzz.Resetter.HTTP.Request
+	(*Clt_Fuzz_Resetter_Probe_HTTP)(nil),   // 38: fm.Clt.Fuzz.Resetter.Probe.HTTP
+	nil,                                    // 39: fm.Clt.Fuzz.Resetter.Process.EnvEntry
+	(*Clt_Fuzz_Model_OpenAPIv3)(nil),       // 40: fm.Clt.Fuzz.Model.OpenAPIv3
+	nil,                                    // 41: fm.Clt.ResetProgress.RetriesEntry
+	(*Clt_ResetProgress_Output)(nil),       // 42: fm.Clt.ResetProgress.Output
+	(*Clt_CallRequestRaw_Input)(nil),       // 43: fm.Clt.CallRequestRaw.Input
+	(*Clt_CallRequestRaw_Input_HttpRequest)(nil),             // 44: fm.Clt.CallRequestRaw.Input.HttpRequest
+	(*Clt_CallResponseRaw_Output)(nil),                       // 45: fm.Clt.CallResponseRaw.Output
+	(*Clt_CallResponseRaw_Output_HttpResponse)(nil),          // 46: fm.Clt.CallResponseRaw.Output.HttpResponse
+	(*Clt_CallResponseRaw_Output_HttpResponse_Timings)(nil),  // 47: fm.Clt.CallResponseRaw.Output.HttpResponse.Timings
+	(*Clt_CallResponseRaw_Output_HttpResponse_Redirect)(nil), // 48: fm.Clt.CallResponseRaw.Output.HttpResponse.Redirect
+	(*Clt_CallResponseRaw_Output_HttpResponse_Event)(nil),    // 49: fm.Clt.CallResponseRaw.Output.HttpResponse.Event
+	(*Srv_FuzzingProgress)(nil),                              // 50: fm.Srv.FuzzingProgress
+	(*Srv_FuzzRep)(nil),                                      // 51: fm.Srv.FuzzRep
+	(*Srv_Call)(nil),                                         // 52: fm.Srv.Call
+	(*Srv_Reset)(nil),                                        // 53: fm.Srv.Reset
+	(*Srv_FuzzingResult)(nil),                                // 54: fm.Srv.FuzzingResult
+	(*Srv_Call_Input)(nil),                                   // 55: fm.Srv.Call.Input
+	(*Srv_Call_Input_HttpRequest)(nil),                       // 56: fm.Srv.Call.Input.HttpRequest
+	(*Srv_FuzzingResult_CounterexampleItem)(nil),             // 57: fm.Srv.FuzzingResult.CounterexampleItem
+	nil,                                      // 58: fm.SpecIR.EndpointsEntry
+	nil,                                      // 59: fm.Schemas.JsonEntry
+	nil,                                      // 60: fm.EndpointJSON.OutputsEntry
+	(*Schema_JSON)(nil),                      // 61: fm.Schema.JSON
+	nil,                                      // 62: fm.Schema.JSON.PropertiesEntry
+	(*Schema_JSON_AdditionalProperties)(nil), // 63: fm.Schema.JSON.AdditionalProperties
+	(*structpb.Value)(nil),                   // 64: google.protobuf.Value
 }
 var file_fuzzymonkey_proto_depIdxs = []int32{
 	19, // 0: fm.Clt.fuzz:type_name -> fm.Clt.Fuzz
@@ -3764,21 +5161,21 @@ var file_fuzzymonkey_proto_depIdxs = []int32{
 	21, // 2: fm.Clt.call_request_raw:type_name -> fm.Clt.CallRequestRaw
 	22, // 3: fm.Clt.call_response_raw:type_name -> fm.Clt.CallResponseRaw
 	23, // 4: fm.Clt.call_verif_progress:type_name -> fm.Clt.CallVerifProgress
-	36, // 5: fm.Srv.fuzzing_progress:type_name -> fm.Srv.FuzzingProgress
-	37, // 6: fm.Srv.fuzz_rep:type_name -> fm.Srv.FuzzRep
-	38, // 7: fm.Srv.call:type_name -> fm.Srv.Call
-	39, // 8: fm.Srv.reset:type_name -> fm.Srv.Reset
-	40, // 9: fm.Srv.fuzzing_result:type_name -> fm.Srv.FuzzingResult
+	50, // 5: fm.Srv.fuzzing_progress:type_name -> fm.Srv.FuzzingProgress
+	51, // 6: fm.Srv.fuzz_rep:type_name -> fm.Srv.FuzzRep
+	52, // 7: fm.Srv.call:type_name -> fm.Srv.Call
+	53, // 8: fm.Srv.reset:type_name -> fm.Srv.Reset
+	54, // 9: fm.Srv.fuzzing_result:type_name -> fm.Srv.FuzzingResult
 	11, // 10: fm.SpecIR.schemas:type_name -> fm.Schemas
-	44, // 11: fm.SpecIR.endpoints:type_name -> fm.SpecIR.EndpointsEntry
-	45, // 12: fm.Schemas.json:type_name -> fm.Schemas.JsonEntry
+	58, // 11: fm.SpecIR.endpoints:type_name -> fm.SpecIR.EndpointsEntry
+	59, // 12: fm.Schemas.json:type_name -> fm.Schemas.JsonEntry
 	13, // 13: fm.RefOrSchemaJSON.ptr:type_name -> fm.SchemaPtr
-	47, // 14: fm.RefOrSchemaJSON.schema:type_name -> fm.Schema.JSON
+	61, // 14: fm.RefOrSchemaJSON.schema:type_name -> fm.Schema.JSON
 	15, // 15: fm.Endpoint.json:type_name -> fm.EndpointJSON
 	3,  // 16: fm.EndpointJSON.method:type_name -> fm.EndpointJSON.Method
 	17, // 17: fm.EndpointJSON.path_partials:type_name -> fm.PathPartial
 	16, // 18: fm.EndpointJSON.inputs:type_name -> fm.ParamJSON
-	46, // 19: fm.EndpointJSON.outputs:type_name -> fm.EndpointJSON.OutputsEntry
+	60, // 19: fm.EndpointJSON.outputs:type_name -> fm.EndpointJSON.OutputsEntry
 	4,  // 20: fm.ParamJSON.kind:type_name -> fm.ParamJSON.Kind
 	24, // 21: fm.Clt.Fuzz.resetters:type_name -> fm.Clt.Fuzz.Resetter
 	25, // 22: fm.Clt.Fuzz.models:type_name -> fm.Clt.Fuzz.Model
@@ -3787,40 +5184,59 @@ var file_fuzzymonkey_proto_depIdxs = []int32{
 	28, // 25: fm.Clt.Fuzz.env_read:type_name -> fm.Clt.Fuzz.EnvReadEntry
 	29, // 26: fm.Clt.Fuzz.files:type_name -> fm.Clt.Fuzz.FilesEntry
 	0,  // 27: fm.Clt.ResetProgress.status:type_name -> fm.Clt.ResetProgress.Status
-	32, // 28: fm.Clt.CallRequestRaw.input:type_name -> fm.Clt.CallRequestRaw.Input
-	34, // 29: fm.Clt.CallResponseRaw.output:type_name -> fm.Clt.CallResponseRaw.Output
-	1,  // 30: fm.Clt.CallVerifProgress.status:type_name -> fm.Clt.CallVerifProgress.Status
-	2,  // 31: fm.Clt.CallVerifProgress.origin:type_name -> fm.Clt.CallVerifProgress.Origin
-	30, // 32: fm.Clt.Fuzz.Resetter.shell:type_name -> fm.Clt.Fuzz.Resetter.Shell
-	31, // 33: fm.Clt.Fuzz.Model.openapiv3:type_name -> fm.Clt.Fuzz.Model.OpenAPIv3
-	8,  // 34: fm.Clt.Fuzz.EIDsEntry.value:type_name -> fm.Uint32s
-	10, // 35: fm.Clt.Fuzz.Model.OpenAPIv3.spec:type_name -> fm.SpecIR
-	33, // 36: fm.Clt.CallRequestRaw.Input.http_request:type_name -> fm.Clt.CallRequestRaw.Input.HttpRequest
-	9,  // 37: fm.Clt.CallRequestRaw.Input.HttpRequest.headers:type_name -> fm.HeaderPair
-	50, // 38: fm.Clt.CallRequestRaw.Input.HttpRequest.body_decoded:type_name -> google.protobuf.Value
-	35, // 39: fm.Clt.CallResponseRaw.Output.http_response:type_name -> fm.Clt.CallResponseRaw.Output.HttpResponse
-	9,  // 40: fm.Clt.CallResponseRaw.Output.HttpResponse.headers:type_name -> fm.HeaderPair
-	50, // 41: fm.Clt.CallResponseRaw.Output.HttpResponse.body_decoded:type_name -> google.protobuf.Value
-	41, // 42: fm.Srv.Call.input:type_name -> fm.Srv.Call.Input
-	43, // 43: fm.Srv.FuzzingResult.counterexample:type_name -> fm.Srv.FuzzingResult.CounterexampleItem
-	42, // 44: fm.Srv.Call.Input.http_request:type_name -> fm.Srv.Call.Input.HttpRequest
-	9,  // 45: fm.Srv.Call.Input.HttpRequest.headers:type_name -> fm.HeaderPair
-	50, // 46: fm.Srv.Call.Input.HttpRequest.body:type_name -> google.protobuf.Value
-	32, // 47: fm.Srv.FuzzingResult.CounterexampleItem.call_request:type_name -> fm.Clt.CallRequestRaw.Input
-	34, // 48: fm.Srv.FuzzingResult.CounterexampleItem.call_response:type_name -> fm.Clt.CallResponseRaw.Output
-	14, // 49: fm.SpecIR.EndpointsEntry.value:type_name -> fm.Endpoint
-	12, // 50: fm.Schemas.JsonEntry.value:type_name -> fm.RefOrSchemaJSON
-	5,  // 51: fm.Schema.JSON.types:type_name -> fm.Schema.JSON.Type
-	50, // 52: fm.Schema.JSON.enum:type_name -> google.protobuf.Value
-	48, // 53: fm.Schema.JSON.properties:type_name -> fm.Schema.JSON.PropertiesEntry
-	49, // 54: fm.Schema.JSON.additional_properties:type_name -> fm.Schema.JSON.AdditionalProperties
-	6,  // 55: fm.FuzzyMonkey.Do:input_type -> fm.Clt
-	7,  // 56: fm.FuzzyMonkey.Do:output_type -> fm.Srv
-	56, // [56:57] is the sub-list for method output_type
-	55, // [55:56] is the sub-list for method input_type
-	55, // [55:55] is the sub-list for extension type_name
-	55, // [55:55] is the sub-list for extension extendee
-	0,  // [0:55] is the sub-list for field type_name
+	41, // 28: fm.Clt.ResetProgress.retries:type_name -> fm.Clt.ResetProgress.RetriesEntry
+	42, // 29: fm.Clt.ResetProgress.outputs:type_name -> fm.Clt.ResetProgress.Output
+	43, // 30: fm.Clt.CallRequestRaw.input:type_name -> fm.Clt.CallRequestRaw.Input
+	45, // 31: fm.Clt.CallResponseRaw.output:type_name -> fm.Clt.CallResponseRaw.Output
+	1,  // 32: fm.Clt.CallVerifProgress.status:type_name -> fm.Clt.CallVerifProgress.Status
+	2,  // 33: fm.Clt.CallVerifProgress.origin:type_name -> fm.Clt.CallVerifProgress.Origin
+	30, // 34: fm.Clt.Fuzz.Resetter.shell:type_name -> fm.Clt.Fuzz.Resetter.Shell
+	31, // 35: fm.Clt.Fuzz.Resetter.http:type_name -> fm.Clt.Fuzz.Resetter.HTTP
+	32, // 36: fm.Clt.Fuzz.Resetter.snapshot:type_name -> fm.Clt.Fuzz.Resetter.Snapshot
+	34, // 37: fm.Clt.Fuzz.Resetter.process:type_name -> fm.Clt.Fuzz.Resetter.Process
+	35, // 38: fm.Clt.Fuzz.Resetter.starlark:type_name -> fm.Clt.Fuzz.Resetter.Starlark
+	33, // 39: fm.Clt.Fuzz.Resetter.ready:type_name -> fm.Clt.Fuzz.Resetter.Probe
+	36, // 40: fm.Clt.Fuzz.Resetter.retry:type_name -> fm.Clt.Fuzz.Resetter.Retry
+	40, // 41: fm.Clt.Fuzz.Model.openapiv3:type_name -> fm.Clt.Fuzz.Model.OpenAPIv3
+	8,  // 42: fm.Clt.Fuzz.EIDsEntry.value:type_name -> fm.Uint32s
+	37, // 43: fm.Clt.Fuzz.Resetter.HTTP.start:type_name -> fm.Clt.Fuzz.Resetter.HTTP.Request
+	37, // 44: fm.Clt.Fuzz.Resetter.HTTP.rst:type_name -> fm.Clt.Fuzz.Resetter.HTTP.Request
+	37, // 45: fm.Clt.Fuzz.Resetter.HTTP.stop:type_name -> fm.Clt.Fuzz.Resetter.HTTP.Request
+	38, // 46: fm.Clt.Fuzz.Resetter.Probe.http:type_name -> fm.Clt.Fuzz.Resetter.Probe.HTTP
+	39, // 47: fm.Clt.Fuzz.Resetter.Process.env:type_name -> fm.Clt.Fuzz.Resetter.Process.EnvEntry
+	9,  // 48: fm.Clt.Fuzz.Resetter.HTTP.Request.headers:type_name -> fm.HeaderPair
+	10, // 49: fm.Clt.Fuzz.Model.OpenAPIv3.spec:type_name -> fm.SpecIR
+	44, // 50: fm.Clt.CallRequestRaw.Input.http_request:type_name -> fm.Clt.CallRequestRaw.Input.HttpRequest
+	9,  // 51: fm.Clt.CallRequestRaw.Input.HttpRequest.headers:type_name -> fm.HeaderPair
+	64, // 52: fm.Clt.CallRequestRaw.Input.HttpRequest.body_decoded:type_name -> google.protobuf.Value
+	46, // 53: fm.Clt.CallResponseRaw.Output.http_response:type_name -> fm.Clt.CallResponseRaw.Output.HttpResponse
+	9,  // 54: fm.Clt.CallResponseRaw.Output.HttpResponse.headers:type_name -> fm.HeaderPair
+	64, // 55: fm.Clt.CallResponseRaw.Output.HttpResponse.body_decoded:type_name -> google.protobuf.Value
+	47, // 56: fm.Clt.CallResponseRaw.Output.HttpResponse.timings:type_name -> fm.Clt.CallResponseRaw.Output.HttpResponse.Timings
+	48, // 57: fm.Clt.CallResponseRaw.Output.HttpResponse.history:type_name -> fm.Clt.CallResponseRaw.Output.HttpResponse.Redirect
+	49, // 58: fm.Clt.CallResponseRaw.Output.HttpResponse.events:type_name -> fm.Clt.CallResponseRaw.Output.HttpResponse.Event
+	9,  // 59: fm.Clt.CallResponseRaw.Output.HttpResponse.Redirect.headers:type_name -> fm.HeaderPair
+	64, // 60: fm.Clt.CallResponseRaw.Output.HttpResponse.Event.data_decoded:type_name -> google.protobuf.Value
+	55, // 61: fm.Srv.Call.input:type_name -> fm.Srv.Call.Input
+	57, // 62: fm.Srv.FuzzingResult.counterexample:type_name -> fm.Srv.FuzzingResult.CounterexampleItem
+	56, // 63: fm.Srv.Call.Input.http_request:type_name -> fm.Srv.Call.Input.HttpRequest
+	9,  // 64: fm.Srv.Call.Input.HttpRequest.headers:type_name -> fm.HeaderPair
+	64, // 65: fm.Srv.Call.Input.HttpRequest.body:type_name -> google.protobuf.Value
+	43, // 66: fm.Srv.FuzzingResult.CounterexampleItem.call_request:type_name -> fm.Clt.CallRequestRaw.Input
+	45, // 67: fm.Srv.FuzzingResult.CounterexampleItem.call_response:type_name -> fm.Clt.CallResponseRaw.Output
+	14, // 68: fm.SpecIR.EndpointsEntry.value:type_name -> fm.Endpoint
+	12, // 69: fm.Schemas.JsonEntry.value:type_name -> fm.RefOrSchemaJSON
+	5,  // 70: fm.Schema.JSON.types:type_name -> fm.Schema.JSON.Type
+	64, // 71: fm.Schema.JSON.enum:type_name -> google.protobuf.Value
+	62, // 72: fm.Schema.JSON.properties:type_name -> fm.Schema.JSON.PropertiesEntry
+	63, // 73: fm.Schema.JSON.additional_properties:type_name -> fm.Schema.JSON.AdditionalProperties
+	6,  // 74: fm.FuzzyMonkey.Do:input_type -> fm.Clt
+	7,  // 75: fm.FuzzyMonkey.Do:output_type -> fm.Srv
+	75, // [75:76] is the sub-list for method output_type
+	74, // [74:75] is the sub-list for method input_type
+	74, // [74:74] is the sub-list for extension type_name
+	74, // [74:74] is the sub-list for extension extendee
+	0,  // [0:74] is the sub-list for field type_name
 }
 
 func init() { file_fuzzymonkey_proto_init() }
@@ -4082,7 +5498,7 @@ func file_fuzzymonkey_proto_init() {
 			}
 		}
 		file_fuzzymonkey_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Clt_Fuzz_Model_OpenAPIv3); i {
+			switch v := v.(*Clt_Fuzz_Resetter_HTTP); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4094,7 +5510,7 @@ func file_fuzzymonkey_proto_init() {
 			}
 		}
 		file_fuzzymonkey_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Clt_CallRequestRaw_Input); i {
+			switch v := v.(*Clt_Fuzz_Resetter_Snapshot); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4106,7 +5522,7 @@ func file_fuzzymonkey_proto_init() {
 			}
 		}
 		file_fuzzymonkey_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Clt_CallRequestRaw_Input_HttpRequest); i {
+			switch v := v.(*Clt_Fuzz_Resetter_Probe); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4118,7 +5534,7 @@ func file_fuzzymonkey_proto_init() {
 			}
 		}
 		file_fuzzymonkey_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Clt_CallResponseRaw_Output); i {
+			switch v := v.(*Clt_Fuzz_Resetter_Process); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4130,7 +5546,7 @@ func file_fuzzymonkey_proto_init() {
 			}
 		}
 		file_fuzzymonkey_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Clt_CallResponseRaw_Output_HttpResponse); i {
+			switch v := v.(*Clt_Fuzz_Resetter_Starlark); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4142,7 +5558,7 @@ func file_fuzzymonkey_proto_init() {
 			}
 		}
 		file_fuzzymonkey_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Srv_FuzzingProgress); i {
+			switch v := v.(*Clt_Fuzz_Resetter_Retry); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4154,7 +5570,7 @@ func file_fuzzymonkey_proto_init() {
 			}
 		}
 		file_fuzzymonkey_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Srv_FuzzRep); i {
+			switch v := v.(*Clt_Fuzz_Resetter_HTTP_Request); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4166,6 +5582,150 @@ func file_fuzzymonkey_proto_init() {
 			}
 		}
 		file_fuzzymonkey_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_Fuzz_Resetter_Probe_HTTP); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_Fuzz_Model_OpenAPIv3); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_ResetProgress_Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_CallRequestRaw_Input); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_CallRequestRaw_Input_HttpRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_CallResponseRaw_Output); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_CallResponseRaw_Output_HttpResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_CallResponseRaw_Output_HttpResponse_Timings); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_CallResponseRaw_Output_HttpResponse_Redirect); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Clt_CallResponseRaw_Output_HttpResponse_Event); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Srv_FuzzingProgress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Srv_FuzzRep); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_fuzzymonkey_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Srv_Call); i {
 			case 0:
 				return &v.state
@@ -4177,7 +5737,7 @@ func file_fuzzymonkey_proto_init() {
 				return nil
 			}
 		}
-		file_fuzzymonkey_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+		file_fuzzymonkey_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Srv_Reset); i {
 			case 0:
 				return &v.state
@@ -4189,7 +5749,7 @@ func file_fuzzymonkey_proto_init() {
 				return nil
 			}
 		}
-		file_fuzzymonkey_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+		file_fuzzymonkey_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Srv_FuzzingResult); i {
 			case 0:
 				return &v.state
@@ -4201,7 +5761,7 @@ func file_fuzzymonkey_proto_init() {
 				return nil
 			}
 		}
-		file_fuzzymonkey_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+		file_fuzzymonkey_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Srv_Call_Input); i {
 			case 0:
 				return &v.state
@@ -4213,7 +5773,7 @@ func file_fuzzymonkey_proto_init() {
 				return nil
 			}
 		}
-		file_fuzzymonkey_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+		file_fuzzymonkey_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Srv_Call_Input_HttpRequest); i {
 			case 0:
 				return &v.state
@@ -4225,7 +5785,7 @@ func file_fuzzymonkey_proto_init() {
 				return nil
 			}
 		}
-		file_fuzzymonkey_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_fuzzymonkey_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Srv_FuzzingResult_CounterexampleItem); i {
 			case 0:
 				return &v.state
@@ -4237,7 +5797,7 @@ func file_fuzzymonkey_proto_init() {
 				return nil
 			}
 		}
-		file_fuzzymonkey_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_fuzzymonkey_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Schema_JSON); i {
 			case 0:
 				return &v.state
@@ -4249,7 +5809,7 @@ func file_fuzzymonkey_proto_init() {
 				return nil
 			}
 		}
-		file_fuzzymonkey_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+		file_fuzzymonkey_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Schema_JSON_AdditionalProperties); i {
 			case 0:
 				return &v.state
@@ -4288,20 +5848,30 @@ func file_fuzzymonkey_proto_init() {
 	}
 	file_fuzzymonkey_proto_msgTypes[18].OneofWrappers = []interface{}{
 		(*Clt_Fuzz_Resetter_Shell_)(nil),
+		(*Clt_Fuzz_Resetter_Http)(nil),
+		(*Clt_Fuzz_Resetter_Snapshot_)(nil),
+		(*Clt_Fuzz_Resetter_Process_)(nil),
+		(*Clt_Fuzz_Resetter_Starlark_)(nil),
 	}
 	file_fuzzymonkey_proto_msgTypes[19].OneofWrappers = []interface{}{
 		(*Clt_Fuzz_Model_Openapiv3)(nil),
 	}
-	file_fuzzymonkey_proto_msgTypes[26].OneofWrappers = []interface{}{
+	file_fuzzymonkey_proto_msgTypes[27].OneofWrappers = []interface{}{
+		(*Clt_Fuzz_Resetter_Probe_Tcp)(nil),
+		(*Clt_Fuzz_Resetter_Probe_Http)(nil),
+		(*Clt_Fuzz_Resetter_Probe_LogLine)(nil),
+		(*Clt_Fuzz_Resetter_Probe_Predicate)(nil),
+	}
+	file_fuzzymonkey_proto_msgTypes[37].OneofWrappers = []interface{}{
 		(*Clt_CallRequestRaw_Input_HttpRequest_)(nil),
 	}
-	file_fuzzymonkey_proto_msgTypes[28].OneofWrappers = []interface{}{
+	file_fuzzymonkey_proto_msgTypes[39].OneofWrappers = []interface{}{
 		(*Clt_CallResponseRaw_Output_HttpResponse_)(nil),
 	}
-	file_fuzzymonkey_proto_msgTypes[35].OneofWrappers = []interface{}{
+	file_fuzzymonkey_proto_msgTypes[49].OneofWrappers = []interface{}{
 		(*Srv_Call_Input_HttpRequest_)(nil),
 	}
-	file_fuzzymonkey_proto_msgTypes[43].OneofWrappers = []interface{}{
+	file_fuzzymonkey_proto_msgTypes[57].OneofWrappers = []interface{}{
 		(*Schema_JSON_AdditionalProperties_AlwaysSucceed)(nil),
 		(*Schema_JSON_AdditionalProperties_SID)(nil),
 	}
@@ -4311,7 +5881,7 @@ func file_fuzzymonkey_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_fuzzymonkey_proto_rawDesc,
 			NumEnums:      6,
-			NumMessages:   44,
+			NumMessages:   58,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -37,6 +37,27 @@ func (this *Clt_Fuzz_Resetter_Shell) EqualVT(that *Clt_Fuzz_Resetter_Shell) bool
 	if this.Stop != that.Stop {
 		return false
 	}
+	if this.StartFile != that.StartFile {
+		return false
+	}
+	if this.RstFile != that.RstFile {
+		return false
+	}
+	if this.StopFile != that.StopFile {
+		return false
+	}
+	if this.Shell != that.Shell {
+		return false
+	}
+	if this.StartTimeoutMs != that.StartTimeoutMs {
+		return false
+	}
+	if this.RstTimeoutMs != that.RstTimeoutMs {
+		return false
+	}
+	if this.StopTimeoutMs != that.StopTimeoutMs {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
@@ -47,32 +68,43 @@ func (this *Clt_Fuzz_Resetter_Shell) EqualMessageVT(thatMsg proto.Message) bool
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_Fuzz_Resetter) EqualVT(that *Clt_Fuzz_Resetter) bool {
+func (this *Clt_Fuzz_Resetter_HTTP_Request) EqualVT(that *Clt_Fuzz_Resetter_HTTP_Request) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Resetter == nil && that.Resetter != nil {
+	if this.Method != that.Method {
 		return false
-	} else if this.Resetter != nil {
-		if that.Resetter == nil {
-			return false
-		}
-		if !this.Resetter.(interface {
-			EqualVT(isClt_Fuzz_Resetter_Resetter) bool
-		}).EqualVT(that.Resetter) {
-			return false
+	}
+	if this.Url != that.Url {
+		return false
+	}
+	if len(this.Headers) != len(that.Headers) {
+		return false
+	}
+	for i, vx := range this.Headers {
+		vy := that.Headers[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &HeaderPair{}
+			}
+			if q == nil {
+				q = &HeaderPair{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
 	}
-	if this.Name != that.Name {
+	if string(this.Body) != string(that.Body) {
 		return false
 	}
-	if len(this.Provides) != len(that.Provides) {
+	if len(this.Expect) != len(that.Expect) {
 		return false
 	}
-	for i, vx := range this.Provides {
-		vy := that.Provides[i]
+	for i, vx := range this.Expect {
+		vy := that.Expect[i]
 		if vx != vy {
 			return false
 		}
@@ -80,96 +112,160 @@ func (this *Clt_Fuzz_Resetter) EqualVT(that *Clt_Fuzz_Resetter) bool {
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_Fuzz_Resetter) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_Fuzz_Resetter)
+func (this *Clt_Fuzz_Resetter_HTTP_Request) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter_HTTP_Request)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_Fuzz_Resetter_Shell_) EqualVT(thatIface isClt_Fuzz_Resetter_Resetter) bool {
-	that, ok := thatIface.(*Clt_Fuzz_Resetter_Shell_)
-	if !ok {
-		return false
-	}
+func (this *Clt_Fuzz_Resetter_HTTP) EqualVT(that *Clt_Fuzz_Resetter_HTTP) bool {
 	if this == that {
 		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if this == nil && that != nil || this != nil && that == nil {
+	if len(this.Start) != len(that.Start) {
 		return false
 	}
-	if p, q := this.Shell, that.Shell; p != q {
-		if p == nil {
-			p = &Clt_Fuzz_Resetter_Shell{}
+	for i, vx := range this.Start {
+		vy := that.Start[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Clt_Fuzz_Resetter_HTTP_Request{}
+			}
+			if q == nil {
+				q = &Clt_Fuzz_Resetter_HTTP_Request{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
-		if q == nil {
-			q = &Clt_Fuzz_Resetter_Shell{}
+	}
+	if len(this.Rst) != len(that.Rst) {
+		return false
+	}
+	for i, vx := range this.Rst {
+		vy := that.Rst[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Clt_Fuzz_Resetter_HTTP_Request{}
+			}
+			if q == nil {
+				q = &Clt_Fuzz_Resetter_HTTP_Request{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
-		if !p.EqualVT(q) {
-			return false
+	}
+	if len(this.Stop) != len(that.Stop) {
+		return false
+	}
+	for i, vx := range this.Stop {
+		vy := that.Stop[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Clt_Fuzz_Resetter_HTTP_Request{}
+			}
+			if q == nil {
+				q = &Clt_Fuzz_Resetter_HTTP_Request{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
 	}
-	return true
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_Fuzz_Model_OpenAPIv3) EqualVT(that *Clt_Fuzz_Model_OpenAPIv3) bool {
+func (this *Clt_Fuzz_Resetter_HTTP) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter_HTTP)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *Clt_Fuzz_Resetter_Snapshot) EqualVT(that *Clt_Fuzz_Resetter_Snapshot) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.Spec.EqualVT(that.Spec) {
+	if len(this.Paths) != len(that.Paths) {
 		return false
 	}
-	if this.File != that.File {
+	for i, vx := range this.Paths {
+		vy := that.Paths[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Clt_Fuzz_Resetter_Snapshot) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter_Snapshot)
+	if !ok {
 		return false
 	}
-	if this.Host != that.Host {
+	return this.EqualVT(that)
+}
+func (this *Clt_Fuzz_Resetter_Probe_HTTP) EqualVT(that *Clt_Fuzz_Resetter_Probe_HTTP) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Url != that.Url {
+		return false
+	}
+	if this.Status != that.Status {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_Fuzz_Model_OpenAPIv3) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_Fuzz_Model_OpenAPIv3)
+func (this *Clt_Fuzz_Resetter_Probe_HTTP) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter_Probe_HTTP)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_Fuzz_Model) EqualVT(that *Clt_Fuzz_Model) bool {
+func (this *Clt_Fuzz_Resetter_Probe) EqualVT(that *Clt_Fuzz_Resetter_Probe) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Model == nil && that.Model != nil {
+	if this.Probe == nil && that.Probe != nil {
 		return false
-	} else if this.Model != nil {
-		if that.Model == nil {
+	} else if this.Probe != nil {
+		if that.Probe == nil {
 			return false
 		}
-		if !this.Model.(interface {
-			EqualVT(isClt_Fuzz_Model_Model) bool
-		}).EqualVT(that.Model) {
+		if !this.Probe.(interface {
+			EqualVT(isClt_Fuzz_Resetter_Probe_Probe) bool
+		}).EqualVT(that.Probe) {
 			return false
 		}
 	}
-	if this.Name != that.Name {
+	if this.TimeoutMs != that.TimeoutMs {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_Fuzz_Model) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_Fuzz_Model)
+func (this *Clt_Fuzz_Resetter_Probe) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter_Probe)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_Fuzz_Model_Openapiv3) EqualVT(thatIface isClt_Fuzz_Model_Model) bool {
-	that, ok := thatIface.(*Clt_Fuzz_Model_Openapiv3)
+func (this *Clt_Fuzz_Resetter_Probe_Tcp) EqualVT(thatIface isClt_Fuzz_Resetter_Probe_Probe) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Probe_Tcp)
 	if !ok {
 		return false
 	}
@@ -179,12 +275,29 @@ func (this *Clt_Fuzz_Model_Openapiv3) EqualVT(thatIface isClt_Fuzz_Model_Model)
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.Openapiv3, that.Openapiv3; p != q {
+	if this.Tcp != that.Tcp {
+		return false
+	}
+	return true
+}
+
+func (this *Clt_Fuzz_Resetter_Probe_Http) EqualVT(thatIface isClt_Fuzz_Resetter_Probe_Probe) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Probe_Http)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.Http, that.Http; p != q {
 		if p == nil {
-			p = &Clt_Fuzz_Model_OpenAPIv3{}
+			p = &Clt_Fuzz_Resetter_Probe_HTTP{}
 		}
 		if q == nil {
-			q = &Clt_Fuzz_Model_OpenAPIv3{}
+			q = &Clt_Fuzz_Resetter_Probe_HTTP{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -193,98 +306,60 @@ func (this *Clt_Fuzz_Model_Openapiv3) EqualVT(thatIface isClt_Fuzz_Model_Model)
 	return true
 }
 
-func (this *Clt_Fuzz) EqualVT(that *Clt_Fuzz) bool {
+func (this *Clt_Fuzz_Resetter_Probe_LogLine) EqualVT(thatIface isClt_Fuzz_Resetter_Probe_Probe) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Probe_LogLine)
+	if !ok {
+		return false
+	}
 	if this == that {
 		return true
-	} else if this == nil || that == nil {
-		return false
 	}
-	if len(this.Resetters) != len(that.Resetters) {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	for i, vx := range this.Resetters {
-		vy := that.Resetters[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &Clt_Fuzz_Resetter{}
-			}
-			if q == nil {
-				q = &Clt_Fuzz_Resetter{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
-	}
-	if len(this.Models) != len(that.Models) {
+	if this.LogLine != that.LogLine {
 		return false
 	}
-	for i, vx := range this.Models {
-		vy := that.Models[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &Clt_Fuzz_Model{}
-			}
-			if q == nil {
-				q = &Clt_Fuzz_Model{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
-	}
-	if len(this.Usage) != len(that.Usage) {
+	return true
+}
+
+func (this *Clt_Fuzz_Resetter_Probe_Predicate) EqualVT(thatIface isClt_Fuzz_Resetter_Probe_Probe) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Probe_Predicate)
+	if !ok {
 		return false
 	}
-	for i, vx := range this.Usage {
-		vy := that.Usage[i]
-		if vx != vy {
-			return false
-		}
+	if this == that {
+		return true
 	}
-	if string(this.Seed) != string(that.Seed) {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if this.Ntensity != that.Ntensity {
+	if this.Predicate != that.Predicate {
 		return false
 	}
-	if len(this.EIDs) != len(that.EIDs) {
+	return true
+}
+
+func (this *Clt_Fuzz_Resetter_Process) EqualVT(that *Clt_Fuzz_Resetter_Process) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
 		return false
 	}
-	for i, vx := range this.EIDs {
-		vy, ok := that.EIDs[i]
-		if !ok {
-			return false
-		}
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &Uint32S{}
-			}
-			if q == nil {
-				q = &Uint32S{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
-	}
-	if len(this.Labels) != len(that.Labels) {
+	if len(this.Command) != len(that.Command) {
 		return false
 	}
-	for i, vx := range this.Labels {
-		vy, ok := that.Labels[i]
-		if !ok {
-			return false
-		}
+	for i, vx := range this.Command {
+		vy := that.Command[i]
 		if vx != vy {
 			return false
 		}
 	}
-	if len(this.EnvRead) != len(that.EnvRead) {
+	if len(this.Env) != len(that.Env) {
 		return false
 	}
-	for i, vx := range this.EnvRead {
-		vy, ok := that.EnvRead[i]
+	for i, vx := range this.Env {
+		vy, ok := that.Env[i]
 		if !ok {
 			return false
 		}
@@ -292,147 +367,151 @@ func (this *Clt_Fuzz) EqualVT(that *Clt_Fuzz) bool {
 			return false
 		}
 	}
-	if len(this.UUIDs) != len(that.UUIDs) {
+	if this.RestartOnReset != that.RestartOnReset {
 		return false
 	}
-	for i, vx := range this.UUIDs {
-		vy := that.UUIDs[i]
-		if vx != vy {
-			return false
-		}
-	}
-	if len(this.Files) != len(that.Files) {
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Clt_Fuzz_Resetter_Process) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter_Process)
+	if !ok {
 		return false
 	}
-	for i, vx := range this.Files {
-		vy, ok := that.Files[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
+	return this.EqualVT(that)
+}
+func (this *Clt_Fuzz_Resetter_Starlark) EqualVT(that *Clt_Fuzz_Resetter_Starlark) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.Start != that.Start {
+		return false
+	}
+	if this.Rst != that.Rst {
+		return false
+	}
+	if this.Stop != that.Stop {
+		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_Fuzz) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_Fuzz)
+func (this *Clt_Fuzz_Resetter_Starlark) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter_Starlark)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_ResetProgress) EqualVT(that *Clt_ResetProgress) bool {
+func (this *Clt_Fuzz_Resetter_Retry) EqualVT(that *Clt_Fuzz_Resetter_Retry) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Status != that.Status {
+	if this.Retries != that.Retries {
 		return false
 	}
-	if this.ElapsedNs != that.ElapsedNs {
+	if this.BackoffMs != that.BackoffMs {
 		return false
 	}
-	if len(this.Reason) != len(that.Reason) {
+	if this.Restart != that.Restart {
 		return false
 	}
-	for i, vx := range this.Reason {
-		vy := that.Reason[i]
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_ResetProgress) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_ResetProgress)
+func (this *Clt_Fuzz_Resetter_Retry) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter_Retry)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_CallRequestRaw_Input_HttpRequest) EqualVT(that *Clt_CallRequestRaw_Input_HttpRequest) bool {
+func (this *Clt_Fuzz_Resetter) EqualVT(that *Clt_Fuzz_Resetter) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Method != that.Method {
+	if this.Resetter == nil && that.Resetter != nil {
 		return false
+	} else if this.Resetter != nil {
+		if that.Resetter == nil {
+			return false
+		}
+		if !this.Resetter.(interface {
+			EqualVT(isClt_Fuzz_Resetter_Resetter) bool
+		}).EqualVT(that.Resetter) {
+			return false
+		}
 	}
-	if this.Url != that.Url {
+	if this.Name != that.Name {
 		return false
 	}
-	if len(this.Headers) != len(that.Headers) {
+	if len(this.Provides) != len(that.Provides) {
 		return false
 	}
-	for i, vx := range this.Headers {
-		vy := that.Headers[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &HeaderPair{}
-			}
-			if q == nil {
-				q = &HeaderPair{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
+	for i, vx := range this.Provides {
+		vy := that.Provides[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if string(this.Body) != string(that.Body) {
+	if !this.Ready.EqualVT(that.Ready) {
 		return false
 	}
-	if equal, ok := interface{}(this.BodyDecoded).(interface{ EqualVT(*structpb.Value) bool }); ok {
-		if !equal.EqualVT(that.BodyDecoded) {
+	if len(this.DependsOn) != len(that.DependsOn) {
+		return false
+	}
+	for i, vx := range this.DependsOn {
+		vy := that.DependsOn[i]
+		if vx != vy {
 			return false
 		}
-	} else if !proto.Equal(this.BodyDecoded, that.BodyDecoded) {
+	}
+	if !this.Retry.EqualVT(that.Retry) {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_CallRequestRaw_Input_HttpRequest) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_CallRequestRaw_Input_HttpRequest)
+func (this *Clt_Fuzz_Resetter) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Resetter)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_CallRequestRaw_Input) EqualVT(that *Clt_CallRequestRaw_Input) bool {
+func (this *Clt_Fuzz_Resetter_Shell_) EqualVT(thatIface isClt_Fuzz_Resetter_Resetter) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Shell_)
+	if !ok {
+		return false
+	}
 	if this == that {
 		return true
-	} else if this == nil || that == nil {
-		return false
 	}
-	if this.Input == nil && that.Input != nil {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
-	} else if this.Input != nil {
-		if that.Input == nil {
-			return false
+	}
+	if p, q := this.Shell, that.Shell; p != q {
+		if p == nil {
+			p = &Clt_Fuzz_Resetter_Shell{}
 		}
-		if !this.Input.(interface {
-			EqualVT(isClt_CallRequestRaw_Input_Input) bool
-		}).EqualVT(that.Input) {
+		if q == nil {
+			q = &Clt_Fuzz_Resetter_Shell{}
+		}
+		if !p.EqualVT(q) {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return true
 }
 
-func (this *Clt_CallRequestRaw_Input) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_CallRequestRaw_Input)
-	if !ok {
-		return false
-	}
-	return this.EqualVT(that)
-}
-func (this *Clt_CallRequestRaw_Input_HttpRequest_) EqualVT(thatIface isClt_CallRequestRaw_Input_Input) bool {
-	that, ok := thatIface.(*Clt_CallRequestRaw_Input_HttpRequest_)
+func (this *Clt_Fuzz_Resetter_Http) EqualVT(thatIface isClt_Fuzz_Resetter_Resetter) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Http)
 	if !ok {
 		return false
 	}
@@ -442,12 +521,12 @@ func (this *Clt_CallRequestRaw_Input_HttpRequest_) EqualVT(thatIface isClt_CallR
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.HttpRequest, that.HttpRequest; p != q {
+	if p, q := this.Http, that.Http; p != q {
 		if p == nil {
-			p = &Clt_CallRequestRaw_Input_HttpRequest{}
+			p = &Clt_Fuzz_Resetter_HTTP{}
 		}
 		if q == nil {
-			q = &Clt_CallRequestRaw_Input_HttpRequest{}
+			q = &Clt_Fuzz_Resetter_HTTP{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -456,119 +535,139 @@ func (this *Clt_CallRequestRaw_Input_HttpRequest_) EqualVT(thatIface isClt_CallR
 	return true
 }
 
-func (this *Clt_CallRequestRaw) EqualVT(that *Clt_CallRequestRaw) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
+func (this *Clt_Fuzz_Resetter_Snapshot_) EqualVT(thatIface isClt_Fuzz_Resetter_Resetter) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Snapshot_)
+	if !ok {
 		return false
 	}
-	if !this.Input.EqualVT(that.Input) {
-		return false
+	if this == that {
+		return true
 	}
-	if len(this.Reason) != len(that.Reason) {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	for i, vx := range this.Reason {
-		vy := that.Reason[i]
-		if vx != vy {
+	if p, q := this.Snapshot, that.Snapshot; p != q {
+		if p == nil {
+			p = &Clt_Fuzz_Resetter_Snapshot{}
+		}
+		if q == nil {
+			q = &Clt_Fuzz_Resetter_Snapshot{}
+		}
+		if !p.EqualVT(q) {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return true
 }
 
-func (this *Clt_CallRequestRaw) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_CallRequestRaw)
+func (this *Clt_Fuzz_Resetter_Process_) EqualVT(thatIface isClt_Fuzz_Resetter_Resetter) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Process_)
 	if !ok {
 		return false
 	}
-	return this.EqualVT(that)
-}
-func (this *Clt_CallResponseRaw_Output_HttpResponse) EqualVT(that *Clt_CallResponseRaw_Output_HttpResponse) bool {
 	if this == that {
 		return true
-	} else if this == nil || that == nil {
-		return false
 	}
-	if this.Error != that.Error {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if this.StatusCode != that.StatusCode {
-		return false
+	if p, q := this.Process, that.Process; p != q {
+		if p == nil {
+			p = &Clt_Fuzz_Resetter_Process{}
+		}
+		if q == nil {
+			q = &Clt_Fuzz_Resetter_Process{}
+		}
+		if !p.EqualVT(q) {
+			return false
+		}
 	}
-	if this.Reason != that.Reason {
+	return true
+}
+
+func (this *Clt_Fuzz_Resetter_Starlark_) EqualVT(thatIface isClt_Fuzz_Resetter_Resetter) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Resetter_Starlark_)
+	if !ok {
 		return false
 	}
-	if len(this.Headers) != len(that.Headers) {
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	for i, vx := range this.Headers {
-		vy := that.Headers[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &HeaderPair{}
-			}
-			if q == nil {
-				q = &HeaderPair{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
+	if p, q := this.Starlark, that.Starlark; p != q {
+		if p == nil {
+			p = &Clt_Fuzz_Resetter_Starlark{}
+		}
+		if q == nil {
+			q = &Clt_Fuzz_Resetter_Starlark{}
+		}
+		if !p.EqualVT(q) {
+			return false
 		}
 	}
-	if string(this.Body) != string(that.Body) {
+	return true
+}
+
+func (this *Clt_Fuzz_Model_OpenAPIv3) EqualVT(that *Clt_Fuzz_Model_OpenAPIv3) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
 		return false
 	}
-	if equal, ok := interface{}(this.BodyDecoded).(interface{ EqualVT(*structpb.Value) bool }); ok {
-		if !equal.EqualVT(that.BodyDecoded) {
-			return false
-		}
-	} else if !proto.Equal(this.BodyDecoded, that.BodyDecoded) {
+	if !this.Spec.EqualVT(that.Spec) {
 		return false
 	}
-	if this.ElapsedNs != that.ElapsedNs {
+	if this.File != that.File {
+		return false
+	}
+	if this.Host != that.Host {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_CallResponseRaw_Output_HttpResponse) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_CallResponseRaw_Output_HttpResponse)
+func (this *Clt_Fuzz_Model_OpenAPIv3) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Model_OpenAPIv3)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_CallResponseRaw_Output) EqualVT(that *Clt_CallResponseRaw_Output) bool {
+func (this *Clt_Fuzz_Model) EqualVT(that *Clt_Fuzz_Model) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Output == nil && that.Output != nil {
+	if this.Model == nil && that.Model != nil {
 		return false
-	} else if this.Output != nil {
-		if that.Output == nil {
+	} else if this.Model != nil {
+		if that.Model == nil {
 			return false
 		}
-		if !this.Output.(interface {
-			EqualVT(isClt_CallResponseRaw_Output_Output) bool
-		}).EqualVT(that.Output) {
+		if !this.Model.(interface {
+			EqualVT(isClt_Fuzz_Model_Model) bool
+		}).EqualVT(that.Model) {
 			return false
 		}
 	}
+	if this.Name != that.Name {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_CallResponseRaw_Output) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_CallResponseRaw_Output)
+func (this *Clt_Fuzz_Model) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz_Model)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_CallResponseRaw_Output_HttpResponse_) EqualVT(thatIface isClt_CallResponseRaw_Output_Output) bool {
-	that, ok := thatIface.(*Clt_CallResponseRaw_Output_HttpResponse_)
+func (this *Clt_Fuzz_Model_Openapiv3) EqualVT(thatIface isClt_Fuzz_Model_Model) bool {
+	that, ok := thatIface.(*Clt_Fuzz_Model_Openapiv3)
 	if !ok {
 		return false
 	}
@@ -578,12 +677,12 @@ func (this *Clt_CallResponseRaw_Output_HttpResponse_) EqualVT(thatIface isClt_Ca
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.HttpResponse, that.HttpResponse; p != q {
+	if p, q := this.Openapiv3, that.Openapiv3; p != q {
 		if p == nil {
-			p = &Clt_CallResponseRaw_Output_HttpResponse{}
+			p = &Clt_Fuzz_Model_OpenAPIv3{}
 		}
 		if q == nil {
-			q = &Clt_CallResponseRaw_Output_HttpResponse{}
+			q = &Clt_Fuzz_Model_OpenAPIv3{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -592,186 +691,324 @@ func (this *Clt_CallResponseRaw_Output_HttpResponse_) EqualVT(thatIface isClt_Ca
 	return true
 }
 
-func (this *Clt_CallResponseRaw) EqualVT(that *Clt_CallResponseRaw) bool {
+func (this *Clt_Fuzz) EqualVT(that *Clt_Fuzz) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.Output.EqualVT(that.Output) {
-		return false
-	}
-	if this.OutputId != that.OutputId {
-		return false
-	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *Clt_CallResponseRaw) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_CallResponseRaw)
-	if !ok {
+	if len(this.Resetters) != len(that.Resetters) {
 		return false
 	}
-	return this.EqualVT(that)
-}
-func (this *Clt_CallVerifProgress) EqualVT(that *Clt_CallVerifProgress) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
+	for i, vx := range this.Resetters {
+		vy := that.Resetters[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Clt_Fuzz_Resetter{}
+			}
+			if q == nil {
+				q = &Clt_Fuzz_Resetter{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.Models) != len(that.Models) {
 		return false
 	}
-	if this.Name != that.Name {
+	for i, vx := range this.Models {
+		vy := that.Models[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Clt_Fuzz_Model{}
+			}
+			if q == nil {
+				q = &Clt_Fuzz_Model{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.Usage) != len(that.Usage) {
 		return false
 	}
-	if this.Status != that.Status {
+	for i, vx := range this.Usage {
+		vy := that.Usage[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if string(this.Seed) != string(that.Seed) {
 		return false
 	}
-	if this.Origin != that.Origin {
+	if this.Ntensity != that.Ntensity {
 		return false
 	}
-	if len(this.Reason) != len(that.Reason) {
+	if len(this.EIDs) != len(that.EIDs) {
 		return false
 	}
-	for i, vx := range this.Reason {
-		vy := that.Reason[i]
+	for i, vx := range this.EIDs {
+		vy, ok := that.EIDs[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Uint32S{}
+			}
+			if q == nil {
+				q = &Uint32S{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if len(this.Labels) != len(that.Labels) {
+		return false
+	}
+	for i, vx := range this.Labels {
+		vy, ok := that.Labels[i]
+		if !ok {
+			return false
+		}
 		if vx != vy {
 			return false
 		}
 	}
-	if this.ElapsedNs != that.ElapsedNs {
+	if len(this.EnvRead) != len(that.EnvRead) {
 		return false
 	}
-	if this.ExecutionSteps != that.ExecutionSteps {
+	for i, vx := range this.EnvRead {
+		vy, ok := that.EnvRead[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.UUIDs) != len(that.UUIDs) {
+		return false
+	}
+	for i, vx := range this.UUIDs {
+		vy := that.UUIDs[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if len(this.Files) != len(that.Files) {
 		return false
 	}
+	for i, vx := range this.Files {
+		vy, ok := that.Files[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_CallVerifProgress) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt_CallVerifProgress)
+func (this *Clt_Fuzz) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_Fuzz)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt) EqualVT(that *Clt) bool {
+func (this *Clt_ResetProgress_Output) EqualVT(that *Clt_ResetProgress_Output) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Msg == nil && that.Msg != nil {
+	if this.Resetter != that.Resetter {
 		return false
-	} else if this.Msg != nil {
-		if that.Msg == nil {
-			return false
-		}
-		if !this.Msg.(interface{ EqualVT(isClt_Msg) bool }).EqualVT(that.Msg) {
+	}
+	if len(this.Lines) != len(that.Lines) {
+		return false
+	}
+	for i, vx := range this.Lines {
+		vy := that.Lines[i]
+		if vx != vy {
 			return false
 		}
 	}
+	if this.Truncated != that.Truncated {
+		return false
+	}
+	if this.Transcript != that.Transcript {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Clt)
+func (this *Clt_ResetProgress_Output) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_ResetProgress_Output)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Clt_Fuzz_) EqualVT(thatIface isClt_Msg) bool {
-	that, ok := thatIface.(*Clt_Fuzz_)
-	if !ok {
-		return false
-	}
+func (this *Clt_ResetProgress) EqualVT(that *Clt_ResetProgress) bool {
 	if this == that {
 		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if this == nil && that != nil || this != nil && that == nil {
+	if this.Status != that.Status {
 		return false
 	}
-	if p, q := this.Fuzz, that.Fuzz; p != q {
-		if p == nil {
-			p = &Clt_Fuzz{}
+	if this.ElapsedNs != that.ElapsedNs {
+		return false
+	}
+	if len(this.Reason) != len(that.Reason) {
+		return false
+	}
+	for i, vx := range this.Reason {
+		vy := that.Reason[i]
+		if vx != vy {
+			return false
 		}
-		if q == nil {
-			q = &Clt_Fuzz{}
+	}
+	if len(this.Retries) != len(that.Retries) {
+		return false
+	}
+	for i, vx := range this.Retries {
+		vy, ok := that.Retries[i]
+		if !ok {
+			return false
 		}
-		if !p.EqualVT(q) {
+		if vx != vy {
 			return false
 		}
 	}
-	return true
+	if len(this.Outputs) != len(that.Outputs) {
+		return false
+	}
+	for i, vx := range this.Outputs {
+		vy := that.Outputs[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Clt_ResetProgress_Output{}
+			}
+			if q == nil {
+				q = &Clt_ResetProgress_Output{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_ResetProgress_) EqualVT(thatIface isClt_Msg) bool {
-	that, ok := thatIface.(*Clt_ResetProgress_)
+func (this *Clt_ResetProgress) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_ResetProgress)
 	if !ok {
 		return false
 	}
+	return this.EqualVT(that)
+}
+func (this *Clt_CallRequestRaw_Input_HttpRequest) EqualVT(that *Clt_CallRequestRaw_Input_HttpRequest) bool {
 	if this == that {
 		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if this == nil && that != nil || this != nil && that == nil {
+	if this.Method != that.Method {
 		return false
 	}
-	if p, q := this.ResetProgress, that.ResetProgress; p != q {
-		if p == nil {
-			p = &Clt_ResetProgress{}
-		}
-		if q == nil {
-			q = &Clt_ResetProgress{}
+	if this.Url != that.Url {
+		return false
+	}
+	if len(this.Headers) != len(that.Headers) {
+		return false
+	}
+	for i, vx := range this.Headers {
+		vy := that.Headers[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &HeaderPair{}
+			}
+			if q == nil {
+				q = &HeaderPair{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
 		}
-		if !p.EqualVT(q) {
+	}
+	if string(this.Body) != string(that.Body) {
+		return false
+	}
+	if equal, ok := interface{}(this.BodyDecoded).(interface{ EqualVT(*structpb.Value) bool }); ok {
+		if !equal.EqualVT(that.BodyDecoded) {
 			return false
 		}
+	} else if !proto.Equal(this.BodyDecoded, that.BodyDecoded) {
+		return false
 	}
-	return true
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_CallRequestRaw_) EqualVT(thatIface isClt_Msg) bool {
-	that, ok := thatIface.(*Clt_CallRequestRaw_)
+func (this *Clt_CallRequestRaw_Input_HttpRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallRequestRaw_Input_HttpRequest)
 	if !ok {
 		return false
 	}
+	return this.EqualVT(that)
+}
+func (this *Clt_CallRequestRaw_Input) EqualVT(that *Clt_CallRequestRaw_Input) bool {
 	if this == that {
 		return true
-	}
-	if this == nil && that != nil || this != nil && that == nil {
+	} else if this == nil || that == nil {
 		return false
 	}
-	if p, q := this.CallRequestRaw, that.CallRequestRaw; p != q {
-		if p == nil {
-			p = &Clt_CallRequestRaw{}
-		}
-		if q == nil {
-			q = &Clt_CallRequestRaw{}
+	if this.Input == nil && that.Input != nil {
+		return false
+	} else if this.Input != nil {
+		if that.Input == nil {
+			return false
 		}
-		if !p.EqualVT(q) {
+		if !this.Input.(interface {
+			EqualVT(isClt_CallRequestRaw_Input_Input) bool
+		}).EqualVT(that.Input) {
 			return false
 		}
 	}
-	return true
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Clt_CallResponseRaw_) EqualVT(thatIface isClt_Msg) bool {
-	that, ok := thatIface.(*Clt_CallResponseRaw_)
+func (this *Clt_CallRequestRaw_Input) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallRequestRaw_Input)
 	if !ok {
 		return false
 	}
-	if this == that {
-		return true
-	}
-	if this == nil && that != nil || this != nil && that == nil {
-		return false
+	return this.EqualVT(that)
+}
+func (this *Clt_CallRequestRaw_Input_HttpRequest_) EqualVT(thatIface isClt_CallRequestRaw_Input_Input) bool {
+	that, ok := thatIface.(*Clt_CallRequestRaw_Input_HttpRequest_)
+	if !ok {
+		return false
 	}
-	if p, q := this.CallResponseRaw, that.CallResponseRaw; p != q {
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.HttpRequest, that.HttpRequest; p != q {
 		if p == nil {
-			p = &Clt_CallResponseRaw{}
+			p = &Clt_CallRequestRaw_Input_HttpRequest{}
 		}
 		if q == nil {
-			q = &Clt_CallResponseRaw{}
+			q = &Clt_CallRequestRaw_Input_HttpRequest{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -780,121 +1017,170 @@ func (this *Clt_CallResponseRaw_) EqualVT(thatIface isClt_Msg) bool {
 	return true
 }
 
-func (this *Clt_CallVerifProgress_) EqualVT(thatIface isClt_Msg) bool {
-	that, ok := thatIface.(*Clt_CallVerifProgress_)
-	if !ok {
-		return false
-	}
+func (this *Clt_CallRequestRaw) EqualVT(that *Clt_CallRequestRaw) bool {
 	if this == that {
 		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if this == nil && that != nil || this != nil && that == nil {
+	if !this.Input.EqualVT(that.Input) {
 		return false
 	}
-	if p, q := this.CallVerifProgress, that.CallVerifProgress; p != q {
-		if p == nil {
-			p = &Clt_CallVerifProgress{}
-		}
-		if q == nil {
-			q = &Clt_CallVerifProgress{}
-		}
-		if !p.EqualVT(q) {
+	if len(this.Reason) != len(that.Reason) {
+		return false
+	}
+	for i, vx := range this.Reason {
+		vy := that.Reason[i]
+		if vx != vy {
 			return false
 		}
 	}
-	return true
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Srv_FuzzingProgress) EqualVT(that *Srv_FuzzingProgress) bool {
+func (this *Clt_CallRequestRaw) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallRequestRaw)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Timings) EqualVT(that *Clt_CallResponseRaw_Output_HttpResponse_Timings) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Failure != that.Failure {
+	if this.DnsNs != that.DnsNs {
 		return false
 	}
-	if this.Success != that.Success {
+	if this.ConnectNs != that.ConnectNs {
 		return false
 	}
-	if this.LastCallSuccess != that.LastCallSuccess {
+	if this.TlsNs != that.TlsNs {
 		return false
 	}
-	if this.LastCheckFailure != that.LastCheckFailure {
+	if this.TtfbNs != that.TtfbNs {
 		return false
 	}
-	if this.LastCheckSuccess != that.LastCheckSuccess {
+	if this.TransferNs != that.TransferNs {
 		return false
 	}
-	if this.TotalTestsCount != that.TotalTestsCount {
+	if this.ReusedConn != that.ReusedConn {
 		return false
 	}
-	if this.TotalCallsCount != that.TotalCallsCount {
+	if this.LocalAddr != that.LocalAddr {
 		return false
 	}
-	if this.TotalChecksCount != that.TotalChecksCount {
+	if this.RemoteAddr != that.RemoteAddr {
 		return false
 	}
-	if this.TestCallsCount != that.TestCallsCount {
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Timings) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallResponseRaw_Output_HttpResponse_Timings)
+	if !ok {
 		return false
 	}
-	if this.CallChecksCount != that.CallChecksCount {
+	return this.EqualVT(that)
+}
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Redirect) EqualVT(that *Clt_CallResponseRaw_Output_HttpResponse_Redirect) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
 		return false
 	}
-	if this.CallChecksSkipped != that.CallChecksSkipped {
+	if this.Method != that.Method {
+		return false
+	}
+	if this.Url != that.Url {
+		return false
+	}
+	if this.StatusCode != that.StatusCode {
+		return false
+	}
+	if this.Reason != that.Reason {
+		return false
+	}
+	if len(this.Headers) != len(that.Headers) {
+		return false
+	}
+	for i, vx := range this.Headers {
+		vy := that.Headers[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &HeaderPair{}
+			}
+			if q == nil {
+				q = &HeaderPair{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if this.ElapsedNs != that.ElapsedNs {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Srv_FuzzingProgress) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv_FuzzingProgress)
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Redirect) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallResponseRaw_Output_HttpResponse_Redirect)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Srv_FuzzRep) EqualVT(that *Srv_FuzzRep) bool {
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Event) EqualVT(that *Clt_CallResponseRaw_Output_HttpResponse_Event) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.MaxTestsCount != that.MaxTestsCount {
+	if this.Id != that.Id {
 		return false
 	}
-	if string(this.Seed) != string(that.Seed) {
+	if this.Event != that.Event {
 		return false
 	}
-	if this.Token != that.Token {
+	if this.Data != that.Data {
 		return false
 	}
-	if this.MaxExecutionStepsPerCheck != that.MaxExecutionStepsPerCheck {
+	if equal, ok := interface{}(this.DataDecoded).(interface{ EqualVT(*structpb.Value) bool }); ok {
+		if !equal.EqualVT(that.DataDecoded) {
+			return false
+		}
+	} else if !proto.Equal(this.DataDecoded, that.DataDecoded) {
 		return false
 	}
-	if this.MaxExecutionMsPerCheck != that.MaxExecutionMsPerCheck {
+	if this.ElapsedNs != that.ElapsedNs {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Srv_FuzzRep) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv_FuzzRep)
+func (this *Clt_CallResponseRaw_Output_HttpResponse_Event) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallResponseRaw_Output_HttpResponse_Event)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Srv_Call_Input_HttpRequest) EqualVT(that *Srv_Call_Input_HttpRequest) bool {
+func (this *Clt_CallResponseRaw_Output_HttpResponse) EqualVT(that *Clt_CallResponseRaw_Output_HttpResponse) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Method != that.Method {
+	if this.Error != that.Error {
 		return false
 	}
-	if this.Url != that.Url {
+	if this.StatusCode != that.StatusCode {
+		return false
+	}
+	if this.Reason != that.Reason {
 		return false
 	}
 	if len(this.Headers) != len(that.Headers) {
@@ -914,53 +1200,111 @@ func (this *Srv_Call_Input_HttpRequest) EqualVT(that *Srv_Call_Input_HttpRequest
 			}
 		}
 	}
-	if equal, ok := interface{}(this.Body).(interface{ EqualVT(*structpb.Value) bool }); ok {
-		if !equal.EqualVT(that.Body) {
+	if string(this.Body) != string(that.Body) {
+		return false
+	}
+	if equal, ok := interface{}(this.BodyDecoded).(interface{ EqualVT(*structpb.Value) bool }); ok {
+		if !equal.EqualVT(that.BodyDecoded) {
 			return false
 		}
-	} else if !proto.Equal(this.Body, that.Body) {
+	} else if !proto.Equal(this.BodyDecoded, that.BodyDecoded) {
+		return false
+	}
+	if this.ElapsedNs != that.ElapsedNs {
+		return false
+	}
+	if !this.Timings.EqualVT(that.Timings) {
+		return false
+	}
+	if len(this.History) != len(that.History) {
+		return false
+	}
+	for i, vx := range this.History {
+		vy := that.History[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Clt_CallResponseRaw_Output_HttpResponse_Redirect{}
+			}
+			if q == nil {
+				q = &Clt_CallResponseRaw_Output_HttpResponse_Redirect{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if this.Retries != that.Retries {
+		return false
+	}
+	if len(this.Events) != len(that.Events) {
+		return false
+	}
+	for i, vx := range this.Events {
+		vy := that.Events[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Clt_CallResponseRaw_Output_HttpResponse_Event{}
+			}
+			if q == nil {
+				q = &Clt_CallResponseRaw_Output_HttpResponse_Event{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	if this.EventsTruncated != that.EventsTruncated {
+		return false
+	}
+	if this.BodySize != that.BodySize {
+		return false
+	}
+	if this.BodySha256 != that.BodySha256 {
+		return false
+	}
+	if this.BodyTruncated != that.BodyTruncated {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Srv_Call_Input_HttpRequest) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv_Call_Input_HttpRequest)
+func (this *Clt_CallResponseRaw_Output_HttpResponse) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallResponseRaw_Output_HttpResponse)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Srv_Call_Input) EqualVT(that *Srv_Call_Input) bool {
+func (this *Clt_CallResponseRaw_Output) EqualVT(that *Clt_CallResponseRaw_Output) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Input == nil && that.Input != nil {
+	if this.Output == nil && that.Output != nil {
 		return false
-	} else if this.Input != nil {
-		if that.Input == nil {
+	} else if this.Output != nil {
+		if that.Output == nil {
 			return false
 		}
-		if !this.Input.(interface {
-			EqualVT(isSrv_Call_Input_Input) bool
-		}).EqualVT(that.Input) {
+		if !this.Output.(interface {
+			EqualVT(isClt_CallResponseRaw_Output_Output) bool
+		}).EqualVT(that.Output) {
 			return false
 		}
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Srv_Call_Input) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv_Call_Input)
+func (this *Clt_CallResponseRaw_Output) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallResponseRaw_Output)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Srv_Call_Input_HttpRequest_) EqualVT(thatIface isSrv_Call_Input_Input) bool {
-	that, ok := thatIface.(*Srv_Call_Input_HttpRequest_)
+func (this *Clt_CallResponseRaw_Output_HttpResponse_) EqualVT(thatIface isClt_CallResponseRaw_Output_Output) bool {
+	that, ok := thatIface.(*Clt_CallResponseRaw_Output_HttpResponse_)
 	if !ok {
 		return false
 	}
@@ -970,12 +1314,12 @@ func (this *Srv_Call_Input_HttpRequest_) EqualVT(thatIface isSrv_Call_Input_Inpu
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.HttpRequest, that.HttpRequest; p != q {
+	if p, q := this.HttpResponse, that.HttpResponse; p != q {
 		if p == nil {
-			p = &Srv_Call_Input_HttpRequest{}
+			p = &Clt_CallResponseRaw_Output_HttpResponse{}
 		}
 		if q == nil {
-			q = &Srv_Call_Input_HttpRequest{}
+			q = &Clt_CallResponseRaw_Output_HttpResponse{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -984,148 +1328,121 @@ func (this *Srv_Call_Input_HttpRequest_) EqualVT(thatIface isSrv_Call_Input_Inpu
 	return true
 }
 
-func (this *Srv_Call) EqualVT(that *Srv_Call) bool {
+func (this *Clt_CallResponseRaw) EqualVT(that *Clt_CallResponseRaw) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.Input.EqualVT(that.Input) {
-		return false
-	}
-	if this.EID != that.EID {
+	if !this.Output.EqualVT(that.Output) {
 		return false
 	}
-	if this.ModelName != that.ModelName {
+	if this.OutputId != that.OutputId {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Srv_Call) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv_Call)
+func (this *Clt_CallResponseRaw) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallResponseRaw)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Srv_Reset) EqualVT(that *Srv_Reset) bool {
+func (this *Clt_CallVerifProgress) EqualVT(that *Clt_CallVerifProgress) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *Srv_Reset) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv_Reset)
-	if !ok {
+	if this.Name != that.Name {
 		return false
 	}
-	return this.EqualVT(that)
-}
-func (this *Srv_FuzzingResult_CounterexampleItem) EqualVT(that *Srv_FuzzingResult_CounterexampleItem) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
+	if this.Status != that.Status {
 		return false
 	}
-	if !this.CallRequest.EqualVT(that.CallRequest) {
+	if this.Origin != that.Origin {
 		return false
 	}
-	if !this.CallResponse.EqualVT(that.CallResponse) {
+	if len(this.Reason) != len(that.Reason) {
+		return false
+	}
+	for i, vx := range this.Reason {
+		vy := that.Reason[i]
+		if vx != vy {
+			return false
+		}
+	}
+	if this.ElapsedNs != that.ElapsedNs {
+		return false
+	}
+	if this.ExecutionSteps != that.ExecutionSteps {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Srv_FuzzingResult_CounterexampleItem) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv_FuzzingResult_CounterexampleItem)
+func (this *Clt_CallVerifProgress) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt_CallVerifProgress)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Srv_FuzzingResult) EqualVT(that *Srv_FuzzingResult) bool {
+func (this *Clt) EqualVT(that *Clt) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if string(this.SeedUsed) != string(that.SeedUsed) {
-		return false
-	}
-	if this.WasShrinking != that.WasShrinking {
-		return false
-	}
-	if string(this.NextSeed) != string(that.NextSeed) {
-		return false
-	}
-	if this.WillNowShrink != that.WillNowShrink {
-		return false
-	}
-	if string(this.SuggestedSeed) != string(that.SuggestedSeed) {
-		return false
-	}
-	if len(this.Counterexample) != len(that.Counterexample) {
+	if this.Msg == nil && that.Msg != nil {
 		return false
-	}
-	for i, vx := range this.Counterexample {
-		vy := that.Counterexample[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &Srv_FuzzingResult_CounterexampleItem{}
-			}
-			if q == nil {
-				q = &Srv_FuzzingResult_CounterexampleItem{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
+	} else if this.Msg != nil {
+		if that.Msg == nil {
+			return false
+		}
+		if !this.Msg.(interface{ EqualVT(isClt_Msg) bool }).EqualVT(that.Msg) {
+			return false
 		}
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Srv_FuzzingResult) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv_FuzzingResult)
+func (this *Clt) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Clt)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Srv) EqualVT(that *Srv) bool {
+func (this *Clt_Fuzz_) EqualVT(thatIface isClt_Msg) bool {
+	that, ok := thatIface.(*Clt_Fuzz_)
+	if !ok {
+		return false
+	}
 	if this == that {
 		return true
-	} else if this == nil || that == nil {
-		return false
 	}
-	if this.Msg == nil && that.Msg != nil {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
-	} else if this.Msg != nil {
-		if that.Msg == nil {
-			return false
+	}
+	if p, q := this.Fuzz, that.Fuzz; p != q {
+		if p == nil {
+			p = &Clt_Fuzz{}
 		}
-		if !this.Msg.(interface{ EqualVT(isSrv_Msg) bool }).EqualVT(that.Msg) {
+		if q == nil {
+			q = &Clt_Fuzz{}
+		}
+		if !p.EqualVT(q) {
 			return false
 		}
 	}
-	if !this.FuzzingProgress.EqualVT(that.FuzzingProgress) {
-		return false
-	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return true
 }
 
-func (this *Srv) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Srv)
-	if !ok {
-		return false
-	}
-	return this.EqualVT(that)
-}
-func (this *Srv_FuzzRep_) EqualVT(thatIface isSrv_Msg) bool {
-	that, ok := thatIface.(*Srv_FuzzRep_)
+func (this *Clt_ResetProgress_) EqualVT(thatIface isClt_Msg) bool {
+	that, ok := thatIface.(*Clt_ResetProgress_)
 	if !ok {
 		return false
 	}
@@ -1135,12 +1452,12 @@ func (this *Srv_FuzzRep_) EqualVT(thatIface isSrv_Msg) bool {
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.FuzzRep, that.FuzzRep; p != q {
+	if p, q := this.ResetProgress, that.ResetProgress; p != q {
 		if p == nil {
-			p = &Srv_FuzzRep{}
+			p = &Clt_ResetProgress{}
 		}
 		if q == nil {
-			q = &Srv_FuzzRep{}
+			q = &Clt_ResetProgress{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -1149,8 +1466,8 @@ func (this *Srv_FuzzRep_) EqualVT(thatIface isSrv_Msg) bool {
 	return true
 }
 
-func (this *Srv_Call_) EqualVT(thatIface isSrv_Msg) bool {
-	that, ok := thatIface.(*Srv_Call_)
+func (this *Clt_CallRequestRaw_) EqualVT(thatIface isClt_Msg) bool {
+	that, ok := thatIface.(*Clt_CallRequestRaw_)
 	if !ok {
 		return false
 	}
@@ -1160,12 +1477,12 @@ func (this *Srv_Call_) EqualVT(thatIface isSrv_Msg) bool {
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.Call, that.Call; p != q {
+	if p, q := this.CallRequestRaw, that.CallRequestRaw; p != q {
 		if p == nil {
-			p = &Srv_Call{}
+			p = &Clt_CallRequestRaw{}
 		}
 		if q == nil {
-			q = &Srv_Call{}
+			q = &Clt_CallRequestRaw{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -1174,8 +1491,8 @@ func (this *Srv_Call_) EqualVT(thatIface isSrv_Msg) bool {
 	return true
 }
 
-func (this *Srv_Reset_) EqualVT(thatIface isSrv_Msg) bool {
-	that, ok := thatIface.(*Srv_Reset_)
+func (this *Clt_CallResponseRaw_) EqualVT(thatIface isClt_Msg) bool {
+	that, ok := thatIface.(*Clt_CallResponseRaw_)
 	if !ok {
 		return false
 	}
@@ -1185,12 +1502,12 @@ func (this *Srv_Reset_) EqualVT(thatIface isSrv_Msg) bool {
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.Reset_, that.Reset_; p != q {
+	if p, q := this.CallResponseRaw, that.CallResponseRaw; p != q {
 		if p == nil {
-			p = &Srv_Reset{}
+			p = &Clt_CallResponseRaw{}
 		}
 		if q == nil {
-			q = &Srv_Reset{}
+			q = &Clt_CallResponseRaw{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -1199,8 +1516,8 @@ func (this *Srv_Reset_) EqualVT(thatIface isSrv_Msg) bool {
 	return true
 }
 
-func (this *Srv_FuzzingResult_) EqualVT(thatIface isSrv_Msg) bool {
-	that, ok := thatIface.(*Srv_FuzzingResult_)
+func (this *Clt_CallVerifProgress_) EqualVT(thatIface isClt_Msg) bool {
+	that, ok := thatIface.(*Clt_CallVerifProgress_)
 	if !ok {
 		return false
 	}
@@ -1210,12 +1527,12 @@ func (this *Srv_FuzzingResult_) EqualVT(thatIface isSrv_Msg) bool {
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.FuzzingResult, that.FuzzingResult; p != q {
+	if p, q := this.CallVerifProgress, that.CallVerifProgress; p != q {
 		if p == nil {
-			p = &Srv_FuzzingResult{}
+			p = &Clt_CallVerifProgress{}
 		}
 		if q == nil {
-			q = &Srv_FuzzingResult{}
+			q = &Clt_CallVerifProgress{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -1224,164 +1541,162 @@ func (this *Srv_FuzzingResult_) EqualVT(thatIface isSrv_Msg) bool {
 	return true
 }
 
-func (this *Uint32S) EqualVT(that *Uint32S) bool {
+func (this *Srv_FuzzingProgress) EqualVT(that *Srv_FuzzingProgress) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.Values) != len(that.Values) {
+	if this.Failure != that.Failure {
 		return false
 	}
-	for i, vx := range this.Values {
-		vy := that.Values[i]
-		if vx != vy {
-			return false
-		}
+	if this.Success != that.Success {
+		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *Uint32S) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Uint32S)
-	if !ok {
+	if this.LastCallSuccess != that.LastCallSuccess {
 		return false
 	}
-	return this.EqualVT(that)
-}
-func (this *HeaderPair) EqualVT(that *HeaderPair) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
+	if this.LastCheckFailure != that.LastCheckFailure {
 		return false
 	}
-	if this.Key != that.Key {
+	if this.LastCheckSuccess != that.LastCheckSuccess {
 		return false
 	}
-	if len(this.Values) != len(that.Values) {
+	if this.TotalTestsCount != that.TotalTestsCount {
 		return false
 	}
-	for i, vx := range this.Values {
-		vy := that.Values[i]
-		if vx != vy {
-			return false
-		}
+	if this.TotalCallsCount != that.TotalCallsCount {
+		return false
+	}
+	if this.TotalChecksCount != that.TotalChecksCount {
+		return false
+	}
+	if this.TestCallsCount != that.TestCallsCount {
+		return false
+	}
+	if this.CallChecksCount != that.CallChecksCount {
+		return false
+	}
+	if this.CallChecksSkipped != that.CallChecksSkipped {
+		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *HeaderPair) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*HeaderPair)
+func (this *Srv_FuzzingProgress) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv_FuzzingProgress)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *SpecIR) EqualVT(that *SpecIR) bool {
+func (this *Srv_FuzzRep) EqualVT(that *Srv_FuzzRep) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if !this.Schemas.EqualVT(that.Schemas) {
+	if this.MaxTestsCount != that.MaxTestsCount {
 		return false
 	}
-	if len(this.Endpoints) != len(that.Endpoints) {
+	if string(this.Seed) != string(that.Seed) {
 		return false
 	}
-	for i, vx := range this.Endpoints {
-		vy, ok := that.Endpoints[i]
-		if !ok {
-			return false
-		}
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &Endpoint{}
-			}
-			if q == nil {
-				q = &Endpoint{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	if this.Token != that.Token {
+		return false
+	}
+	if this.MaxExecutionStepsPerCheck != that.MaxExecutionStepsPerCheck {
+		return false
+	}
+	if this.MaxExecutionMsPerCheck != that.MaxExecutionMsPerCheck {
+		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *SpecIR) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*SpecIR)
+func (this *Srv_FuzzRep) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv_FuzzRep)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Schemas) EqualVT(that *Schemas) bool {
+func (this *Srv_Call_Input_HttpRequest) EqualVT(that *Srv_Call_Input_HttpRequest) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.Json) != len(that.Json) {
+	if this.Method != that.Method {
 		return false
 	}
-	for i, vx := range this.Json {
-		vy, ok := that.Json[i]
-		if !ok {
-			return false
-		}
+	if this.Url != that.Url {
+		return false
+	}
+	if len(this.Headers) != len(that.Headers) {
+		return false
+	}
+	for i, vx := range this.Headers {
+		vy := that.Headers[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &RefOrSchemaJSON{}
+				p = &HeaderPair{}
 			}
 			if q == nil {
-				q = &RefOrSchemaJSON{}
+				q = &HeaderPair{}
 			}
 			if !p.EqualVT(q) {
 				return false
 			}
 		}
 	}
+	if equal, ok := interface{}(this.Body).(interface{ EqualVT(*structpb.Value) bool }); ok {
+		if !equal.EqualVT(that.Body) {
+			return false
+		}
+	} else if !proto.Equal(this.Body, that.Body) {
+		return false
+	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Schemas) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Schemas)
+func (this *Srv_Call_Input_HttpRequest) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv_Call_Input_HttpRequest)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *RefOrSchemaJSON) EqualVT(that *RefOrSchemaJSON) bool {
+func (this *Srv_Call_Input) EqualVT(that *Srv_Call_Input) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.PtrOrSchema == nil && that.PtrOrSchema != nil {
+	if this.Input == nil && that.Input != nil {
 		return false
-	} else if this.PtrOrSchema != nil {
-		if that.PtrOrSchema == nil {
+	} else if this.Input != nil {
+		if that.Input == nil {
 			return false
 		}
-		if !this.PtrOrSchema.(interface {
-			EqualVT(isRefOrSchemaJSON_PtrOrSchema) bool
-		}).EqualVT(that.PtrOrSchema) {
+		if !this.Input.(interface {
+			EqualVT(isSrv_Call_Input_Input) bool
+		}).EqualVT(that.Input) {
 			return false
 		}
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *RefOrSchemaJSON) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*RefOrSchemaJSON)
+func (this *Srv_Call_Input) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv_Call_Input)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *RefOrSchemaJSON_Ptr) EqualVT(thatIface isRefOrSchemaJSON_PtrOrSchema) bool {
-	that, ok := thatIface.(*RefOrSchemaJSON_Ptr)
+func (this *Srv_Call_Input_HttpRequest_) EqualVT(thatIface isSrv_Call_Input_Input) bool {
+	that, ok := thatIface.(*Srv_Call_Input_HttpRequest_)
 	if !ok {
 		return false
 	}
@@ -1391,12 +1706,12 @@ func (this *RefOrSchemaJSON_Ptr) EqualVT(thatIface isRefOrSchemaJSON_PtrOrSchema
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if p, q := this.Ptr, that.Ptr; p != q {
+	if p, q := this.HttpRequest, that.HttpRequest; p != q {
 		if p == nil {
-			p = &SchemaPtr{}
+			p = &Srv_Call_Input_HttpRequest{}
 		}
 		if q == nil {
-			q = &SchemaPtr{}
+			q = &Srv_Call_Input_HttpRequest{}
 		}
 		if !p.EqualVT(q) {
 			return false
@@ -1405,227 +1720,198 @@ func (this *RefOrSchemaJSON_Ptr) EqualVT(thatIface isRefOrSchemaJSON_PtrOrSchema
 	return true
 }
 
-func (this *RefOrSchemaJSON_Schema) EqualVT(thatIface isRefOrSchemaJSON_PtrOrSchema) bool {
-	that, ok := thatIface.(*RefOrSchemaJSON_Schema)
-	if !ok {
-		return false
-	}
+func (this *Srv_Call) EqualVT(that *Srv_Call) bool {
 	if this == that {
 		return true
-	}
-	if this == nil && that != nil || this != nil && that == nil {
+	} else if this == nil || that == nil {
 		return false
 	}
-	if p, q := this.Schema, that.Schema; p != q {
-		if p == nil {
-			p = &Schema_JSON{}
-		}
-		if q == nil {
-			q = &Schema_JSON{}
-		}
-		if !p.EqualVT(q) {
-			return false
-		}
-	}
-	return true
-}
-
-func (this *SchemaPtr) EqualVT(that *SchemaPtr) bool {
-	if this == that {
-		return true
-	} else if this == nil || that == nil {
+	if !this.Input.EqualVT(that.Input) {
 		return false
 	}
-	if this.SID != that.SID {
+	if this.EID != that.EID {
 		return false
 	}
-	if this.Ref != that.Ref {
+	if this.ModelName != that.ModelName {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *SchemaPtr) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*SchemaPtr)
+func (this *Srv_Call) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv_Call)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Endpoint) EqualVT(that *Endpoint) bool {
+func (this *Srv_Reset) EqualVT(that *Srv_Reset) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Endpoint == nil && that.Endpoint != nil {
-		return false
-	} else if this.Endpoint != nil {
-		if that.Endpoint == nil {
-			return false
-		}
-		if !this.Endpoint.(interface {
-			EqualVT(isEndpoint_Endpoint) bool
-		}).EqualVT(that.Endpoint) {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Endpoint) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Endpoint)
+func (this *Srv_Reset) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv_Reset)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Endpoint_Json) EqualVT(thatIface isEndpoint_Endpoint) bool {
-	that, ok := thatIface.(*Endpoint_Json)
-	if !ok {
-		return false
-	}
+func (this *Srv_FuzzingResult_CounterexampleItem) EqualVT(that *Srv_FuzzingResult_CounterexampleItem) bool {
 	if this == that {
 		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if this == nil && that != nil || this != nil && that == nil {
+	if !this.CallRequest.EqualVT(that.CallRequest) {
 		return false
 	}
-	if p, q := this.Json, that.Json; p != q {
-		if p == nil {
-			p = &EndpointJSON{}
-		}
-		if q == nil {
-			q = &EndpointJSON{}
-		}
-		if !p.EqualVT(q) {
-			return false
-		}
+	if !this.CallResponse.EqualVT(that.CallResponse) {
+		return false
 	}
-	return true
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *EndpointJSON) EqualVT(that *EndpointJSON) bool {
+func (this *Srv_FuzzingResult_CounterexampleItem) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv_FuzzingResult_CounterexampleItem)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *Srv_FuzzingResult) EqualVT(that *Srv_FuzzingResult) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.Method != that.Method {
+	if string(this.SeedUsed) != string(that.SeedUsed) {
 		return false
 	}
-	if len(this.PathPartials) != len(that.PathPartials) {
+	if this.WasShrinking != that.WasShrinking {
 		return false
 	}
-	for i, vx := range this.PathPartials {
-		vy := that.PathPartials[i]
-		if p, q := vx, vy; p != q {
-			if p == nil {
-				p = &PathPartial{}
-			}
-			if q == nil {
-				q = &PathPartial{}
-			}
-			if !p.EqualVT(q) {
-				return false
-			}
-		}
+	if string(this.NextSeed) != string(that.NextSeed) {
+		return false
 	}
-	if len(this.Inputs) != len(that.Inputs) {
+	if this.WillNowShrink != that.WillNowShrink {
 		return false
 	}
-	for i, vx := range this.Inputs {
-		vy := that.Inputs[i]
+	if string(this.SuggestedSeed) != string(that.SuggestedSeed) {
+		return false
+	}
+	if len(this.Counterexample) != len(that.Counterexample) {
+		return false
+	}
+	for i, vx := range this.Counterexample {
+		vy := that.Counterexample[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &ParamJSON{}
+				p = &Srv_FuzzingResult_CounterexampleItem{}
 			}
 			if q == nil {
-				q = &ParamJSON{}
+				q = &Srv_FuzzingResult_CounterexampleItem{}
 			}
 			if !p.EqualVT(q) {
 				return false
 			}
 		}
 	}
-	if len(this.Outputs) != len(that.Outputs) {
-		return false
-	}
-	for i, vx := range this.Outputs {
-		vy, ok := that.Outputs[i]
-		if !ok {
-			return false
-		}
-		if vx != vy {
-			return false
-		}
-	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *EndpointJSON) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*EndpointJSON)
+func (this *Srv_FuzzingResult) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv_FuzzingResult)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *ParamJSON) EqualVT(that *ParamJSON) bool {
+func (this *Srv) EqualVT(that *Srv) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.IsRequired != that.IsRequired {
-		return false
-	}
-	if this.SID != that.SID {
-		return false
-	}
-	if this.Name != that.Name {
+	if this.Msg == nil && that.Msg != nil {
 		return false
+	} else if this.Msg != nil {
+		if that.Msg == nil {
+			return false
+		}
+		if !this.Msg.(interface{ EqualVT(isSrv_Msg) bool }).EqualVT(that.Msg) {
+			return false
+		}
 	}
-	if this.Kind != that.Kind {
+	if !this.FuzzingProgress.EqualVT(that.FuzzingProgress) {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *ParamJSON) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*ParamJSON)
+func (this *Srv) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Srv)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *PathPartial) EqualVT(that *PathPartial) bool {
+func (this *Srv_FuzzRep_) EqualVT(thatIface isSrv_Msg) bool {
+	that, ok := thatIface.(*Srv_FuzzRep_)
+	if !ok {
+		return false
+	}
 	if this == that {
 		return true
-	} else if this == nil || that == nil {
-		return false
 	}
-	if this.Pp == nil && that.Pp != nil {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
-	} else if this.Pp != nil {
-		if that.Pp == nil {
-			return false
+	}
+	if p, q := this.FuzzRep, that.FuzzRep; p != q {
+		if p == nil {
+			p = &Srv_FuzzRep{}
 		}
-		if !this.Pp.(interface{ EqualVT(isPathPartial_Pp) bool }).EqualVT(that.Pp) {
+		if q == nil {
+			q = &Srv_FuzzRep{}
+		}
+		if !p.EqualVT(q) {
 			return false
 		}
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
+	return true
 }
 
-func (this *PathPartial) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*PathPartial)
+func (this *Srv_Call_) EqualVT(thatIface isSrv_Msg) bool {
+	that, ok := thatIface.(*Srv_Call_)
 	if !ok {
 		return false
 	}
-	return this.EqualVT(that)
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.Call, that.Call; p != q {
+		if p == nil {
+			p = &Srv_Call{}
+		}
+		if q == nil {
+			q = &Srv_Call{}
+		}
+		if !p.EqualVT(q) {
+			return false
+		}
+	}
+	return true
 }
-func (this *PathPartial_Part) EqualVT(thatIface isPathPartial_Pp) bool {
-	that, ok := thatIface.(*PathPartial_Part)
+
+func (this *Srv_Reset_) EqualVT(thatIface isSrv_Msg) bool {
+	that, ok := thatIface.(*Srv_Reset_)
 	if !ok {
 		return false
 	}
@@ -1635,14 +1921,22 @@ func (this *PathPartial_Part) EqualVT(thatIface isPathPartial_Pp) bool {
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if this.Part != that.Part {
-		return false
+	if p, q := this.Reset_, that.Reset_; p != q {
+		if p == nil {
+			p = &Srv_Reset{}
+		}
+		if q == nil {
+			q = &Srv_Reset{}
+		}
+		if !p.EqualVT(q) {
+			return false
+		}
 	}
 	return true
 }
 
-func (this *PathPartial_Ptr) EqualVT(thatIface isPathPartial_Pp) bool {
-	that, ok := thatIface.(*PathPartial_Ptr)
+func (this *Srv_FuzzingResult_) EqualVT(thatIface isSrv_Msg) bool {
+	that, ok := thatIface.(*Srv_FuzzingResult_)
 	if !ok {
 		return false
 	}
@@ -1652,682 +1946,729 @@ func (this *PathPartial_Ptr) EqualVT(thatIface isPathPartial_Pp) bool {
 	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	if this.Ptr != that.Ptr {
-		return false
+	if p, q := this.FuzzingResult, that.FuzzingResult; p != q {
+		if p == nil {
+			p = &Srv_FuzzingResult{}
+		}
+		if q == nil {
+			q = &Srv_FuzzingResult{}
+		}
+		if !p.EqualVT(q) {
+			return false
+		}
 	}
 	return true
 }
 
-func (this *Schema_JSON_AdditionalProperties) EqualVT(that *Schema_JSON_AdditionalProperties) bool {
+func (this *Uint32S) EqualVT(that *Uint32S) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if this.AddProps == nil && that.AddProps != nil {
+	if len(this.Values) != len(that.Values) {
 		return false
-	} else if this.AddProps != nil {
-		if that.AddProps == nil {
-			return false
-		}
-		if !this.AddProps.(interface {
-			EqualVT(isSchema_JSON_AdditionalProperties_AddProps) bool
-		}).EqualVT(that.AddProps) {
+	}
+	for i, vx := range this.Values {
+		vy := that.Values[i]
+		if vx != vy {
 			return false
 		}
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Schema_JSON_AdditionalProperties) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Schema_JSON_AdditionalProperties)
+func (this *Uint32S) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Uint32S)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Schema_JSON_AdditionalProperties_AlwaysSucceed) EqualVT(thatIface isSchema_JSON_AdditionalProperties_AddProps) bool {
-	that, ok := thatIface.(*Schema_JSON_AdditionalProperties_AlwaysSucceed)
-	if !ok {
-		return false
-	}
+func (this *HeaderPair) EqualVT(that *HeaderPair) bool {
 	if this == that {
 		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if this == nil && that != nil || this != nil && that == nil {
+	if this.Key != that.Key {
 		return false
 	}
-	if this.AlwaysSucceed != that.AlwaysSucceed {
+	if len(this.Values) != len(that.Values) {
 		return false
 	}
-	return true
+	for i, vx := range this.Values {
+		vy := that.Values[i]
+		if vx != vy {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Schema_JSON_AdditionalProperties_SID) EqualVT(thatIface isSchema_JSON_AdditionalProperties_AddProps) bool {
-	that, ok := thatIface.(*Schema_JSON_AdditionalProperties_SID)
+func (this *HeaderPair) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*HeaderPair)
 	if !ok {
 		return false
 	}
+	return this.EqualVT(that)
+}
+func (this *SpecIR) EqualVT(that *SpecIR) bool {
 	if this == that {
 		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	if this == nil && that != nil || this != nil && that == nil {
+	if !this.Schemas.EqualVT(that.Schemas) {
 		return false
 	}
-	if this.SID != that.SID {
+	if len(this.Endpoints) != len(that.Endpoints) {
 		return false
 	}
-	return true
+	for i, vx := range this.Endpoints {
+		vy, ok := that.Endpoints[i]
+		if !ok {
+			return false
+		}
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &Endpoint{}
+			}
+			if q == nil {
+				q = &Endpoint{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Schema_JSON) EqualVT(that *Schema_JSON) bool {
+func (this *SpecIR) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SpecIR)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *Schemas) EqualVT(that *Schemas) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.Types) != len(that.Types) {
+	if len(this.Json) != len(that.Json) {
 		return false
 	}
-	for i, vx := range this.Types {
-		vy := that.Types[i]
-		if vx != vy {
+	for i, vx := range this.Json {
+		vy, ok := that.Json[i]
+		if !ok {
 			return false
 		}
-	}
-	if len(this.Enum) != len(that.Enum) {
-		return false
-	}
-	for i, vx := range this.Enum {
-		vy := that.Enum[i]
 		if p, q := vx, vy; p != q {
 			if p == nil {
-				p = &structpb.Value{}
+				p = &RefOrSchemaJSON{}
 			}
 			if q == nil {
-				q = &structpb.Value{}
+				q = &RefOrSchemaJSON{}
 			}
-			if equal, ok := interface{}(p).(interface{ EqualVT(*structpb.Value) bool }); ok {
-				if !equal.EqualVT(q) {
-					return false
-				}
-			} else if !proto.Equal(p, q) {
+			if !p.EqualVT(q) {
 				return false
 			}
 		}
 	}
-	if this.Format != that.Format {
-		return false
-	}
-	if this.MinLength != that.MinLength {
-		return false
-	}
-	if this.MaxLength != that.MaxLength {
-		return false
-	}
-	if this.HasMaxLength != that.HasMaxLength {
-		return false
-	}
-	if this.Pattern != that.Pattern {
-		return false
-	}
-	if this.Minimum != that.Minimum {
-		return false
-	}
-	if this.Maximum != that.Maximum {
-		return false
-	}
-	if this.HasMinimum != that.HasMinimum {
-		return false
-	}
-	if this.HasMaximum != that.HasMaximum {
-		return false
-	}
-	if this.TranslatedMultipleOf != that.TranslatedMultipleOf {
-		return false
-	}
-	if this.ExclusiveMinimum != that.ExclusiveMinimum {
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Schemas) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Schemas)
+	if !ok {
 		return false
 	}
-	if this.ExclusiveMaximum != that.ExclusiveMaximum {
+	return this.EqualVT(that)
+}
+func (this *RefOrSchemaJSON) EqualVT(that *RefOrSchemaJSON) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
 		return false
 	}
-	if len(this.Items) != len(that.Items) {
+	if this.PtrOrSchema == nil && that.PtrOrSchema != nil {
 		return false
-	}
-	for i, vx := range this.Items {
-		vy := that.Items[i]
-		if vx != vy {
+	} else if this.PtrOrSchema != nil {
+		if that.PtrOrSchema == nil {
+			return false
+		}
+		if !this.PtrOrSchema.(interface {
+			EqualVT(isRefOrSchemaJSON_PtrOrSchema) bool
+		}).EqualVT(that.PtrOrSchema) {
 			return false
 		}
 	}
-	if this.UniqueItems != that.UniqueItems {
-		return false
-	}
-	if this.MinItems != that.MinItems {
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *RefOrSchemaJSON) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*RefOrSchemaJSON)
+	if !ok {
 		return false
 	}
-	if this.MaxItems != that.MaxItems {
+	return this.EqualVT(that)
+}
+func (this *RefOrSchemaJSON_Ptr) EqualVT(thatIface isRefOrSchemaJSON_PtrOrSchema) bool {
+	that, ok := thatIface.(*RefOrSchemaJSON_Ptr)
+	if !ok {
 		return false
 	}
-	if this.HasMaxItems != that.HasMaxItems {
-		return false
+	if this == that {
+		return true
 	}
-	if len(this.Properties) != len(that.Properties) {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	for i, vx := range this.Properties {
-		vy, ok := that.Properties[i]
-		if !ok {
-			return false
+	if p, q := this.Ptr, that.Ptr; p != q {
+		if p == nil {
+			p = &SchemaPtr{}
 		}
-		if vx != vy {
-			return false
+		if q == nil {
+			q = &SchemaPtr{}
 		}
-	}
-	if len(this.Required) != len(that.Required) {
-		return false
-	}
-	for i, vx := range this.Required {
-		vy := that.Required[i]
-		if vx != vy {
+		if !p.EqualVT(q) {
 			return false
 		}
 	}
-	if this.MinProperties != that.MinProperties {
-		return false
-	}
-	if this.MaxProperties != that.MaxProperties {
-		return false
-	}
-	if this.HasMaxProperties != that.HasMaxProperties {
-		return false
-	}
-	if !this.AdditionalProperties.EqualVT(that.AdditionalProperties) {
+	return true
+}
+
+func (this *RefOrSchemaJSON_Schema) EqualVT(thatIface isRefOrSchemaJSON_PtrOrSchema) bool {
+	that, ok := thatIface.(*RefOrSchemaJSON_Schema)
+	if !ok {
 		return false
 	}
-	if this.HasAdditionalProperties != that.HasAdditionalProperties {
-		return false
+	if this == that {
+		return true
 	}
-	if len(this.AllOf) != len(that.AllOf) {
+	if this == nil && that != nil || this != nil && that == nil {
 		return false
 	}
-	for i, vx := range this.AllOf {
-		vy := that.AllOf[i]
-		if vx != vy {
-			return false
+	if p, q := this.Schema, that.Schema; p != q {
+		if p == nil {
+			p = &Schema_JSON{}
 		}
-	}
-	if len(this.AnyOf) != len(that.AnyOf) {
-		return false
-	}
-	for i, vx := range this.AnyOf {
-		vy := that.AnyOf[i]
-		if vx != vy {
+		if q == nil {
+			q = &Schema_JSON{}
+		}
+		if !p.EqualVT(q) {
 			return false
 		}
 	}
-	if len(this.OneOf) != len(that.OneOf) {
+	return true
+}
+
+func (this *SchemaPtr) EqualVT(that *SchemaPtr) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
 		return false
 	}
-	for i, vx := range this.OneOf {
-		vy := that.OneOf[i]
-		if vx != vy {
-			return false
-		}
+	if this.SID != that.SID {
+		return false
 	}
-	if this.Not != that.Not {
+	if this.Ref != that.Ref {
 		return false
 	}
 	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (this *Schema_JSON) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Schema_JSON)
+func (this *SchemaPtr) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*SchemaPtr)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (this *Schema) EqualVT(that *Schema) bool {
+func (this *Endpoint) EqualVT(that *Endpoint) bool {
 	if this == that {
 		return true
 	} else if this == nil || that == nil {
 		return false
 	}
-	return string(this.unknownFields) == string(that.unknownFields)
-}
-
-func (this *Schema) EqualMessageVT(thatMsg proto.Message) bool {
-	that, ok := thatMsg.(*Schema)
+	if this.Endpoint == nil && that.Endpoint != nil {
+		return false
+	} else if this.Endpoint != nil {
+		if that.Endpoint == nil {
+			return false
+		}
+		if !this.Endpoint.(interface {
+			EqualVT(isEndpoint_Endpoint) bool
+		}).EqualVT(that.Endpoint) {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Endpoint) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Endpoint)
 	if !ok {
 		return false
 	}
 	return this.EqualVT(that)
 }
-func (m *Clt_Fuzz_Resetter_Shell) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (this *Endpoint_Json) EqualVT(thatIface isEndpoint_Endpoint) bool {
+	that, ok := thatIface.(*Endpoint_Json)
+	if !ok {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this == that {
+		return true
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt_Fuzz_Resetter_Shell) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if p, q := this.Json, that.Json; p != q {
+		if p == nil {
+			p = &EndpointJSON{}
+		}
+		if q == nil {
+			q = &EndpointJSON{}
+		}
+		if !p.EqualVT(q) {
+			return false
+		}
+	}
+	return true
 }
 
-func (m *Clt_Fuzz_Resetter_Shell) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *EndpointJSON) EqualVT(that *EndpointJSON) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this.Method != that.Method {
+		return false
 	}
-	if len(m.Stop) > 0 {
-		i -= len(m.Stop)
-		copy(dAtA[i:], m.Stop)
-		i = encodeVarint(dAtA, i, uint64(len(m.Stop)))
-		i--
-		dAtA[i] = 0x1a
+	if len(this.PathPartials) != len(that.PathPartials) {
+		return false
 	}
-	if len(m.Rst) > 0 {
-		i -= len(m.Rst)
-		copy(dAtA[i:], m.Rst)
-		i = encodeVarint(dAtA, i, uint64(len(m.Rst)))
-		i--
-		dAtA[i] = 0x12
+	for i, vx := range this.PathPartials {
+		vy := that.PathPartials[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &PathPartial{}
+			}
+			if q == nil {
+				q = &PathPartial{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
 	}
-	if len(m.Start) > 0 {
-		i -= len(m.Start)
-		copy(dAtA[i:], m.Start)
-		i = encodeVarint(dAtA, i, uint64(len(m.Start)))
-		i--
-		dAtA[i] = 0xa
+	if len(this.Inputs) != len(that.Inputs) {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Clt_Fuzz_Resetter) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	for i, vx := range this.Inputs {
+		vy := that.Inputs[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &ParamJSON{}
+			}
+			if q == nil {
+				q = &ParamJSON{}
+			}
+			if !p.EqualVT(q) {
+				return false
+			}
+		}
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Outputs) != len(that.Outputs) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Clt_Fuzz_Resetter) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Clt_Fuzz_Resetter) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	for i, vx := range this.Outputs {
+		vy, ok := that.Outputs[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
+		}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this.OperationId != that.OperationId {
+		return false
 	}
-	if vtmsg, ok := m.Resetter.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if len(this.Tags) != len(that.Tags) {
+		return false
 	}
-	if len(m.Provides) > 0 {
-		for iNdEx := len(m.Provides) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Provides[iNdEx])
-			copy(dAtA[i:], m.Provides[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Provides[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	for i, vx := range this.Tags {
+		vy := that.Tags[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarint(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if this.Deprecated != that.Deprecated {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Clt_Fuzz_Resetter_Shell_) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Clt_Fuzz_Resetter_Shell_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Shell != nil {
-		size, err := m.Shell.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x1a
+	if this.Skip != that.Skip {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if this.Weight != that.Weight {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if this.MaxLatencyMs != that.MaxLatencyMs {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Idempotent != that.Idempotent {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *EndpointJSON) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*EndpointJSON)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
-
-func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *ParamJSON) EqualVT(that *ParamJSON) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this.IsRequired != that.IsRequired {
+		return false
 	}
-	if len(m.Host) > 0 {
-		i -= len(m.Host)
-		copy(dAtA[i:], m.Host)
-		i = encodeVarint(dAtA, i, uint64(len(m.Host)))
-		i--
-		dAtA[i] = 0x1a
+	if this.SID != that.SID {
+		return false
 	}
-	if len(m.File) > 0 {
-		i -= len(m.File)
-		copy(dAtA[i:], m.File)
-		i = encodeVarint(dAtA, i, uint64(len(m.File)))
-		i--
-		dAtA[i] = 0x12
+	if this.Name != that.Name {
+		return false
 	}
-	if m.Spec != nil {
-		size, err := m.Spec.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0xa
+	if this.Kind != that.Kind {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Clt_Fuzz_Model) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+func (this *ParamJSON) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*ParamJSON)
+	if !ok {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return this.EqualVT(that)
+}
+func (this *PathPartial) EqualVT(that *PathPartial) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	return dAtA[:n], nil
+	if this.Pp == nil && that.Pp != nil {
+		return false
+	} else if this.Pp != nil {
+		if that.Pp == nil {
+			return false
+		}
+		if !this.Pp.(interface{ EqualVT(isPathPartial_Pp) bool }).EqualVT(that.Pp) {
+			return false
+		}
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Clt_Fuzz_Model) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *PathPartial) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*PathPartial)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
 }
-
-func (m *Clt_Fuzz_Model) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *PathPartial_Part) EqualVT(thatIface isPathPartial_Pp) bool {
+	that, ok := thatIface.(*PathPartial_Part)
+	if !ok {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if this == that {
+		return true
 	}
-	if vtmsg, ok := m.Model.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarint(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0xa
+	if this.Part != that.Part {
+		return false
 	}
-	return len(dAtA) - i, nil
+	return true
 }
 
-func (m *Clt_Fuzz_Model_Openapiv3) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *PathPartial_Ptr) EqualVT(thatIface isPathPartial_Pp) bool {
+	that, ok := thatIface.(*PathPartial_Ptr)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.Ptr != that.Ptr {
+		return false
+	}
+	return true
 }
 
-func (m *Clt_Fuzz_Model_Openapiv3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Openapiv3 != nil {
-		size, err := m.Openapiv3.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+func (this *Schema_JSON_AdditionalProperties) EqualVT(that *Schema_JSON_AdditionalProperties) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	if this.AddProps == nil && that.AddProps != nil {
+		return false
+	} else if this.AddProps != nil {
+		if that.AddProps == nil {
+			return false
+		}
+		if !this.AddProps.(interface {
+			EqualVT(isSchema_JSON_AdditionalProperties_AddProps) bool
+		}).EqualVT(that.AddProps) {
+			return false
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
+	return string(this.unknownFields) == string(that.unknownFields)
 }
-func (m *Clt_Fuzz) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+
+func (this *Schema_JSON_AdditionalProperties) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Schema_JSON_AdditionalProperties)
+	if !ok {
+		return false
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	return this.EqualVT(that)
+}
+func (this *Schema_JSON_AdditionalProperties_AlwaysSucceed) EqualVT(thatIface isSchema_JSON_AdditionalProperties_AddProps) bool {
+	that, ok := thatIface.(*Schema_JSON_AdditionalProperties_AlwaysSucceed)
+	if !ok {
+		return false
 	}
-	return dAtA[:n], nil
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.AlwaysSucceed != that.AlwaysSucceed {
+		return false
+	}
+	return true
 }
 
-func (m *Clt_Fuzz) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
+func (this *Schema_JSON_AdditionalProperties_SID) EqualVT(thatIface isSchema_JSON_AdditionalProperties_AddProps) bool {
+	that, ok := thatIface.(*Schema_JSON_AdditionalProperties_SID)
+	if !ok {
+		return false
+	}
+	if this == that {
+		return true
+	}
+	if this == nil && that != nil || this != nil && that == nil {
+		return false
+	}
+	if this.SID != that.SID {
+		return false
+	}
+	return true
 }
 
-func (m *Clt_Fuzz) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+func (this *Schema_JSON) EqualVT(that *Schema_JSON) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if len(this.Types) != len(that.Types) {
+		return false
 	}
-	if len(m.Files) > 0 {
-		for k := range m.Files {
-			v := m.Files[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x52
+	for i, vx := range this.Types {
+		vy := that.Types[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.UUIDs) > 0 {
-		for iNdEx := len(m.UUIDs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.UUIDs[iNdEx])
-			copy(dAtA[i:], m.UUIDs[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.UUIDs[iNdEx])))
-			i--
-			dAtA[i] = 0x4a
+	if len(this.Enum) != len(that.Enum) {
+		return false
+	}
+	for i, vx := range this.Enum {
+		vy := that.Enum[i]
+		if p, q := vx, vy; p != q {
+			if p == nil {
+				p = &structpb.Value{}
+			}
+			if q == nil {
+				q = &structpb.Value{}
+			}
+			if equal, ok := interface{}(p).(interface{ EqualVT(*structpb.Value) bool }); ok {
+				if !equal.EqualVT(q) {
+					return false
+				}
+			} else if !proto.Equal(p, q) {
+				return false
+			}
 		}
 	}
-	if len(m.EnvRead) > 0 {
-		for k := range m.EnvRead {
-			v := m.EnvRead[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x42
+	if this.Format != that.Format {
+		return false
+	}
+	if this.MinLength != that.MinLength {
+		return false
+	}
+	if this.MaxLength != that.MaxLength {
+		return false
+	}
+	if this.HasMaxLength != that.HasMaxLength {
+		return false
+	}
+	if this.Pattern != that.Pattern {
+		return false
+	}
+	if this.Minimum != that.Minimum {
+		return false
+	}
+	if this.Maximum != that.Maximum {
+		return false
+	}
+	if this.HasMinimum != that.HasMinimum {
+		return false
+	}
+	if this.HasMaximum != that.HasMaximum {
+		return false
+	}
+	if this.TranslatedMultipleOf != that.TranslatedMultipleOf {
+		return false
+	}
+	if this.ExclusiveMinimum != that.ExclusiveMinimum {
+		return false
+	}
+	if this.ExclusiveMaximum != that.ExclusiveMaximum {
+		return false
+	}
+	if len(this.Items) != len(that.Items) {
+		return false
+	}
+	for i, vx := range this.Items {
+		vy := that.Items[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.Labels) > 0 {
-		for k := range m.Labels {
-			v := m.Labels[k]
-			baseI := i
-			i -= len(v)
-			copy(dAtA[i:], v)
-			i = encodeVarint(dAtA, i, uint64(len(v)))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x3a
+	if this.UniqueItems != that.UniqueItems {
+		return false
+	}
+	if this.MinItems != that.MinItems {
+		return false
+	}
+	if this.MaxItems != that.MaxItems {
+		return false
+	}
+	if this.HasMaxItems != that.HasMaxItems {
+		return false
+	}
+	if len(this.Properties) != len(that.Properties) {
+		return false
+	}
+	for i, vx := range this.Properties {
+		vy, ok := that.Properties[i]
+		if !ok {
+			return false
+		}
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.EIDs) > 0 {
-		for k := range m.EIDs {
-			v := m.EIDs[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x32
+	if len(this.Required) != len(that.Required) {
+		return false
+	}
+	for i, vx := range this.Required {
+		vy := that.Required[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if m.Ntensity != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Ntensity))
-		i--
-		dAtA[i] = 0x28
+	if this.MinProperties != that.MinProperties {
+		return false
 	}
-	if len(m.Seed) > 0 {
-		i -= len(m.Seed)
-		copy(dAtA[i:], m.Seed)
-		i = encodeVarint(dAtA, i, uint64(len(m.Seed)))
-		i--
-		dAtA[i] = 0x22
+	if this.MaxProperties != that.MaxProperties {
+		return false
 	}
-	if len(m.Usage) > 0 {
-		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Usage[iNdEx])
-			copy(dAtA[i:], m.Usage[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Usage[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+	if this.HasMaxProperties != that.HasMaxProperties {
+		return false
+	}
+	if !this.AdditionalProperties.EqualVT(that.AdditionalProperties) {
+		return false
+	}
+	if this.HasAdditionalProperties != that.HasAdditionalProperties {
+		return false
+	}
+	if len(this.AllOf) != len(that.AllOf) {
+		return false
+	}
+	for i, vx := range this.AllOf {
+		vy := that.AllOf[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.Models) > 0 {
-		for iNdEx := len(m.Models) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Models[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
+	if len(this.AnyOf) != len(that.AnyOf) {
+		return false
+	}
+	for i, vx := range this.AnyOf {
+		vy := that.AnyOf[i]
+		if vx != vy {
+			return false
 		}
 	}
-	if len(m.Resetters) > 0 {
-		for iNdEx := len(m.Resetters) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Resetters[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0xa
+	if len(this.OneOf) != len(that.OneOf) {
+		return false
+	}
+	for i, vx := range this.OneOf {
+		vy := that.OneOf[i]
+		if vx != vy {
+			return false
 		}
 	}
-	return len(dAtA) - i, nil
+	if this.Not != that.Not {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
 }
 
-func (m *Clt_ResetProgress) MarshalVT() (dAtA []byte, err error) {
+func (this *Schema_JSON) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Schema_JSON)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (this *Schema) EqualVT(that *Schema) bool {
+	if this == that {
+		return true
+	} else if this == nil || that == nil {
+		return false
+	}
+	return string(this.unknownFields) == string(that.unknownFields)
+}
+
+func (this *Schema) EqualMessageVT(thatMsg proto.Message) bool {
+	that, ok := thatMsg.(*Schema)
+	if !ok {
+		return false
+	}
+	return this.EqualVT(that)
+}
+func (m *Clt_Fuzz_Resetter_Shell) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -2340,12 +2681,12 @@ func (m *Clt_ResetProgress) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clt_ResetProgress) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Shell) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_ResetProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Shell) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2357,31 +2698,76 @@ func (m *Clt_ResetProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Reason) > 0 {
-		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Reason[iNdEx])
-			copy(dAtA[i:], m.Reason[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Reason[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
+	if m.StopTimeoutMs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.StopTimeoutMs))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.ElapsedNs != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ElapsedNs))
+	if m.RstTimeoutMs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.RstTimeoutMs))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x48
 	}
-	if m.Status != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Status))
+	if m.StartTimeoutMs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.StartTimeoutMs))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x40
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if len(m.Shell) > 0 {
+		i -= len(m.Shell)
+		copy(dAtA[i:], m.Shell)
+		i = encodeVarint(dAtA, i, uint64(len(m.Shell)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.StopFile) > 0 {
+		i -= len(m.StopFile)
+		copy(dAtA[i:], m.StopFile)
+		i = encodeVarint(dAtA, i, uint64(len(m.StopFile)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RstFile) > 0 {
+		i -= len(m.RstFile)
+		copy(dAtA[i:], m.RstFile)
+		i = encodeVarint(dAtA, i, uint64(len(m.RstFile)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.StartFile) > 0 {
+		i -= len(m.StartFile)
+		copy(dAtA[i:], m.StartFile)
+		i = encodeVarint(dAtA, i, uint64(len(m.StartFile)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Stop) > 0 {
+		i -= len(m.Stop)
+		copy(dAtA[i:], m.Stop)
+		i = encodeVarint(dAtA, i, uint64(len(m.Stop)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Rst) > 0 {
+		i -= len(m.Rst)
+		copy(dAtA[i:], m.Rst)
+		i = encodeVarint(dAtA, i, uint64(len(m.Rst)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarint(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Resetter_HTTP_Request) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
 	size := m.SizeVT()
 	dAtA = make([]byte, size)
@@ -2392,12 +2778,12 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalVT() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_HTTP_Request) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_HTTP_Request) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2409,25 +2795,23 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalToSizedBufferVT(dAtA []byt
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.BodyDecoded != nil {
-		if vtmsg, ok := interface{}(m.BodyDecoded).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.BodyDecoded)
-			if err != nil {
-				return 0, err
+	if len(m.Expect) > 0 {
+		var pksize2 int
+		for _, num := range m.Expect {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num := range m.Expect {
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
 			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			dAtA[j1] = uint8(num)
+			j1++
 		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
 		i--
 		dAtA[i] = 0x2a
 	}
@@ -2467,7 +2851,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalToSizedBufferVT(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *Clt_CallRequestRaw_Input) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_Fuzz_Resetter_HTTP) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -2480,12 +2864,12 @@ func (m *Clt_CallRequestRaw_Input) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clt_CallRequestRaw_Input) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_HTTP) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallRequestRaw_Input) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_HTTP) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2497,38 +2881,46 @@ func (m *Clt_CallRequestRaw_Input) MarshalToSizedBufferVT(dAtA []byte) (int, err
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Input.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Stop) > 0 {
+		for iNdEx := len(m.Stop) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Stop[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Clt_CallRequestRaw_Input_HttpRequest_) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Clt_CallRequestRaw_Input_HttpRequest_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.HttpRequest != nil {
-		size, err := m.HttpRequest.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Rst) > 0 {
+		for iNdEx := len(m.Rst) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Rst[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Start) > 0 {
+		for iNdEx := len(m.Start) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Start[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Clt_CallRequestRaw) MarshalVT() (dAtA []byte, err error) {
+
+func (m *Clt_Fuzz_Resetter_Snapshot) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -2541,12 +2933,12 @@ func (m *Clt_CallRequestRaw) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clt_CallRequestRaw) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Snapshot) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallRequestRaw) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Snapshot) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2558,29 +2950,19 @@ func (m *Clt_CallRequestRaw) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Reason) > 0 {
-		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Reason[iNdEx])
-			copy(dAtA[i:], m.Reason[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Reason[iNdEx])))
+	if len(m.Paths) > 0 {
+		for iNdEx := len(m.Paths) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Paths[iNdEx])
+			copy(dAtA[i:], m.Paths[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Paths[iNdEx])))
 			i--
-			dAtA[i] = 0x12
-		}
-	}
-	if m.Input != nil {
-		size, err := m.Input.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+			dAtA[i] = 0xa
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_Fuzz_Resetter_Probe_HTTP) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -2593,12 +2975,12 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalVT() (dAtA []byte, err
 	return dAtA[:n], nil
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Probe_HTTP) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Probe_HTTP) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2610,75 +2992,22 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalToSizedBufferVT(dAtA []
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.ElapsedNs != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ElapsedNs))
-		i--
-		dAtA[i] = 0x38
-	}
-	if m.BodyDecoded != nil {
-		if vtmsg, ok := interface{}(m.BodyDecoded).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-		} else {
-			encoded, err := proto.Marshal(m.BodyDecoded)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Body) > 0 {
-		i -= len(m.Body)
-		copy(dAtA[i:], m.Body)
-		i = encodeVarint(dAtA, i, uint64(len(m.Body)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Headers) > 0 {
-		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Headers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Reason) > 0 {
-		i -= len(m.Reason)
-		copy(dAtA[i:], m.Reason)
-		i = encodeVarint(dAtA, i, uint64(len(m.Reason)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.StatusCode != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.StatusCode))
+	if m.Status != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Status))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Error) > 0 {
-		i -= len(m.Error)
-		copy(dAtA[i:], m.Error)
-		i = encodeVarint(dAtA, i, uint64(len(m.Error)))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarint(dAtA, i, uint64(len(m.Url)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Clt_CallResponseRaw_Output) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_Fuzz_Resetter_Probe) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -2691,12 +3020,12 @@ func (m *Clt_CallResponseRaw_Output) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clt_CallResponseRaw_Output) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Probe) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallResponseRaw_Output) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Probe) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2708,7 +3037,7 @@ func (m *Clt_CallResponseRaw_Output) MarshalToSizedBufferVT(dAtA []byte) (int, e
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Output.(interface {
+	if vtmsg, ok := m.Probe.(interface {
 		MarshalToSizedBufferVT([]byte) (int, error)
 	}); ok {
 		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
@@ -2717,29 +3046,76 @@ func (m *Clt_CallResponseRaw_Output) MarshalToSizedBufferVT(dAtA []byte) (int, e
 		}
 		i -= size
 	}
+	if m.TimeoutMs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TimeoutMs))
+		i--
+		dAtA[i] = 0x28
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse_) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Probe_Tcp) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Probe_Tcp) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.HttpResponse != nil {
-		size, err := m.HttpResponse.MarshalToSizedBufferVT(dAtA[:i])
+	i -= len(m.Tcp)
+	copy(dAtA[i:], m.Tcp)
+	i = encodeVarint(dAtA, i, uint64(len(m.Tcp)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Probe_Http) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Probe_Http) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Http != nil {
+		size, err := m.Http.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Clt_CallResponseRaw) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_Fuzz_Resetter_Probe_LogLine) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Probe_LogLine) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.LogLine)
+	copy(dAtA[i:], m.LogLine)
+	i = encodeVarint(dAtA, i, uint64(len(m.LogLine)))
+	i--
+	dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Probe_Predicate) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Probe_Predicate) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Predicate)
+	copy(dAtA[i:], m.Predicate)
+	i = encodeVarint(dAtA, i, uint64(len(m.Predicate)))
+	i--
+	dAtA[i] = 0x22
+	return len(dAtA) - i, nil
+}
+func (m *Clt_Fuzz_Resetter_Process) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -2752,12 +3128,12 @@ func (m *Clt_CallResponseRaw) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clt_CallResponseRaw) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Process) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallResponseRaw) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Process) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2769,25 +3145,48 @@ func (m *Clt_CallResponseRaw) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.OutputId != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.OutputId))
+	if m.RestartOnReset {
 		i--
-		dAtA[i] = 0x10
-	}
-	if m.Output != nil {
-		size, err := m.Output.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+		if m.RestartOnReset {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x20
+	}
+	if len(m.Env) > 0 {
+		for k := range m.Env {
+			v := m.Env[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Command) > 0 {
+		for iNdEx := len(m.Command) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Command[iNdEx])
+			copy(dAtA[i:], m.Command[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Command[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Clt_CallVerifProgress) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_Fuzz_Resetter_Starlark) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -2800,12 +3199,12 @@ func (m *Clt_CallVerifProgress) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clt_CallVerifProgress) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Starlark) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallVerifProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Starlark) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2817,46 +3216,84 @@ func (m *Clt_CallVerifProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error)
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.ExecutionSteps != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ExecutionSteps))
+	if len(m.Stop) > 0 {
+		i -= len(m.Stop)
+		copy(dAtA[i:], m.Stop)
+		i = encodeVarint(dAtA, i, uint64(len(m.Stop)))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x1a
 	}
-	if m.ElapsedNs != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.ElapsedNs))
+	if len(m.Rst) > 0 {
+		i -= len(m.Rst)
+		copy(dAtA[i:], m.Rst)
+		i = encodeVarint(dAtA, i, uint64(len(m.Rst)))
 		i--
-		dAtA[i] = 0x28
+		dAtA[i] = 0x12
 	}
-	if len(m.Reason) > 0 {
-		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Reason[iNdEx])
-			copy(dAtA[i:], m.Reason[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Reason[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+	if len(m.Start) > 0 {
+		i -= len(m.Start)
+		copy(dAtA[i:], m.Start)
+		i = encodeVarint(dAtA, i, uint64(len(m.Start)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if m.Origin != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Origin))
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Resetter_Retry) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Resetter_Retry) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Resetter_Retry) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.Restart {
+		i--
+		if m.Restart {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x18
 	}
-	if m.Status != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Status))
+	if m.BackoffMs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.BackoffMs))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarint(dAtA, i, uint64(len(m.Name)))
+	if m.Retries != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Retries))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Clt) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_Fuzz_Resetter) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -2869,12 +3306,12 @@ func (m *Clt) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Clt) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -2886,7 +3323,7 @@ func (m *Clt) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Msg.(interface {
+	if vtmsg, ok := m.Resetter.(interface {
 		MarshalToSizedBufferVT([]byte) (int, error)
 	}); ok {
 		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
@@ -2895,105 +3332,150 @@ func (m *Clt) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		}
 		i -= size
 	}
+	if m.Retry != nil {
+		size, err := m.Retry.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.DependsOn) > 0 {
+		for iNdEx := len(m.DependsOn) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.DependsOn[iNdEx])
+			copy(dAtA[i:], m.DependsOn[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.DependsOn[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.Ready != nil {
+		size, err := m.Ready.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Provides) > 0 {
+		for iNdEx := len(m.Provides) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Provides[iNdEx])
+			copy(dAtA[i:], m.Provides[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Provides[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Clt_Fuzz_) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Shell_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_Fuzz_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Shell_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Fuzz != nil {
-		size, err := m.Fuzz.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Shell != nil {
+		size, err := m.Shell.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x1a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Clt_ResetProgress_) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Http) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_ResetProgress_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Http) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.ResetProgress != nil {
-		size, err := m.ResetProgress.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Http != nil {
+		size, err := m.Http.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Clt_CallRequestRaw_) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Snapshot_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallRequestRaw_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Snapshot_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.CallRequestRaw != nil {
-		size, err := m.CallRequestRaw.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Snapshot != nil {
+		size, err := m.Snapshot.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Clt_CallResponseRaw_) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Process_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallResponseRaw_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Process_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.CallResponseRaw != nil {
-		size, err := m.CallResponseRaw.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Process != nil {
+		size, err := m.Process.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Clt_CallVerifProgress_) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Starlark_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallVerifProgress_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Resetter_Starlark_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.CallVerifProgress != nil {
-		size, err := m.CallVerifProgress.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Starlark != nil {
+		size, err := m.Starlark.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x42
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Srv_FuzzingProgress) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3006,12 +3488,12 @@ func (m *Srv_FuzzingProgress) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Srv_FuzzingProgress) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_FuzzingProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_Model_OpenAPIv3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3023,90 +3505,102 @@ func (m *Srv_FuzzingProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.CallChecksSkipped != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.CallChecksSkipped))
+	if len(m.Host) > 0 {
+		i -= len(m.Host)
+		copy(dAtA[i:], m.Host)
+		i = encodeVarint(dAtA, i, uint64(len(m.Host)))
 		i--
-		dAtA[i] = 0x58
+		dAtA[i] = 0x1a
 	}
-	if m.CallChecksCount != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.CallChecksCount))
+	if len(m.File) > 0 {
+		i -= len(m.File)
+		copy(dAtA[i:], m.File)
+		i = encodeVarint(dAtA, i, uint64(len(m.File)))
 		i--
-		dAtA[i] = 0x50
+		dAtA[i] = 0x12
 	}
-	if m.TestCallsCount != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.TestCallsCount))
+	if m.Spec != nil {
+		size, err := m.Spec.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x48
+		dAtA[i] = 0xa
 	}
-	if m.TotalChecksCount != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.TotalChecksCount))
-		i--
-		dAtA[i] = 0x40
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	if m.TotalCallsCount != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.TotalCallsCount))
-		i--
-		dAtA[i] = 0x38
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.TotalTestsCount != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.TotalTestsCount))
-		i--
-		dAtA[i] = 0x30
+	return dAtA[:n], nil
+}
+
+func (m *Clt_Fuzz_Model) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
-	if m.LastCheckSuccess {
-		i--
-		if m.LastCheckSuccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.LastCheckFailure {
-		i--
-		if m.LastCheckFailure {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x20
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.LastCallSuccess {
-		i--
-		if m.LastCallSuccess {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if vtmsg, ok := m.Model.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x18
+		i -= size
 	}
-	if m.Success {
-		i--
-		if m.Success {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarint(dAtA, i, uint64(len(m.Name)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0xa
 	}
-	if m.Failure {
-		i--
-		if m.Failure {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+
+func (m *Clt_Fuzz_Model_Openapiv3) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_Fuzz_Model_Openapiv3) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Openapiv3 != nil {
+		size, err := m.Openapiv3.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x12
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Srv_FuzzRep) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_Fuzz) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3119,12 +3613,12 @@ func (m *Srv_FuzzRep) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Srv_FuzzRep) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_FuzzRep) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3136,39 +3630,143 @@ func (m *Srv_FuzzRep) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.MaxExecutionMsPerCheck != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MaxExecutionMsPerCheck))
-		i--
-		dAtA[i] = 0x28
+	if len(m.Files) > 0 {
+		for k := range m.Files {
+			v := m.Files[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	if m.MaxExecutionStepsPerCheck != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MaxExecutionStepsPerCheck))
-		i--
-		dAtA[i] = 0x20
+	if len(m.UUIDs) > 0 {
+		for iNdEx := len(m.UUIDs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.UUIDs[iNdEx])
+			copy(dAtA[i:], m.UUIDs[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.UUIDs[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
 	}
-	if len(m.Token) > 0 {
-		i -= len(m.Token)
-		copy(dAtA[i:], m.Token)
-		i = encodeVarint(dAtA, i, uint64(len(m.Token)))
+	if len(m.EnvRead) > 0 {
+		for k := range m.EnvRead {
+			v := m.EnvRead[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k := range m.Labels {
+			v := m.Labels[k]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarint(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.EIDs) > 0 {
+		for k := range m.EIDs {
+			v := m.EIDs[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Ntensity != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Ntensity))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
 	if len(m.Seed) > 0 {
 		i -= len(m.Seed)
 		copy(dAtA[i:], m.Seed)
 		i = encodeVarint(dAtA, i, uint64(len(m.Seed)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	if m.MaxTestsCount != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MaxTestsCount))
-		i--
-		dAtA[i] = 0x8
+	if len(m.Usage) > 0 {
+		for iNdEx := len(m.Usage) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Usage[iNdEx])
+			copy(dAtA[i:], m.Usage[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Usage[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Models) > 0 {
+		for iNdEx := len(m.Models) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Models[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Resetters) > 0 {
+		for iNdEx := len(m.Resetters) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Resetters[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Srv_Call_Input_HttpRequest) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_ResetProgress_Output) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3181,12 +3779,12 @@ func (m *Srv_Call_Input_HttpRequest) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Srv_Call_Input_HttpRequest) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_ResetProgress_Output) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_Call_Input_HttpRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_ResetProgress_Output) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3198,58 +3796,43 @@ func (m *Srv_Call_Input_HttpRequest) MarshalToSizedBufferVT(dAtA []byte) (int, e
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Body != nil {
-		if vtmsg, ok := interface{}(m.Body).(interface {
-			MarshalToSizedBufferVT([]byte) (int, error)
-		}); ok {
-			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.Transcript) > 0 {
+		i -= len(m.Transcript)
+		copy(dAtA[i:], m.Transcript)
+		i = encodeVarint(dAtA, i, uint64(len(m.Transcript)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Truncated {
+		i--
+		if m.Truncated {
+			dAtA[i] = 1
 		} else {
-			encoded, err := proto.Marshal(m.Body)
-			if err != nil {
-				return 0, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x18
 	}
-	if len(m.Headers) > 0 {
-		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Headers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.Lines) > 0 {
+		for iNdEx := len(m.Lines) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Lines[iNdEx])
+			copy(dAtA[i:], m.Lines[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Lines[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.Url) > 0 {
-		i -= len(m.Url)
-		copy(dAtA[i:], m.Url)
-		i = encodeVarint(dAtA, i, uint64(len(m.Url)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Method) > 0 {
-		i -= len(m.Method)
-		copy(dAtA[i:], m.Method)
-		i = encodeVarint(dAtA, i, uint64(len(m.Method)))
+	if len(m.Resetter) > 0 {
+		i -= len(m.Resetter)
+		copy(dAtA[i:], m.Resetter)
+		i = encodeVarint(dAtA, i, uint64(len(m.Resetter)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Srv_Call_Input) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_ResetProgress) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3262,12 +3845,12 @@ func (m *Srv_Call_Input) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Srv_Call_Input) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_ResetProgress) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_Call_Input) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_ResetProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3279,38 +3862,58 @@ func (m *Srv_Call_Input) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Input.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Outputs) > 0 {
+		for iNdEx := len(m.Outputs) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Outputs[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i -= size
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Srv_Call_Input_HttpRequest_) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Srv_Call_Input_HttpRequest_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.HttpRequest != nil {
-		size, err := m.HttpRequest.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Retries) > 0 {
+		for k := range m.Retries {
+			v := m.Retries[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	}
+	if len(m.Reason) > 0 {
+		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reason[iNdEx])
+			copy(dAtA[i:], m.Reason[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Reason[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.ElapsedNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ElapsedNs))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x10
+	}
+	if m.Status != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Srv_Call) MarshalVT() (dAtA []byte, err error) {
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3323,12 +3926,12 @@ func (m *Srv_Call) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Srv_Call) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_Call) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3340,32 +3943,65 @@ func (m *Srv_Call) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.ModelName) > 0 {
-		i -= len(m.ModelName)
-		copy(dAtA[i:], m.ModelName)
-		i = encodeVarint(dAtA, i, uint64(len(m.ModelName)))
+	if m.BodyDecoded != nil {
+		if vtmsg, ok := interface{}(m.BodyDecoded).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+		} else {
+			encoded, err := proto.Marshal(m.BodyDecoded)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x2a
 	}
-	if m.EID != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.EID))
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarint(dAtA, i, uint64(len(m.Body)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x22
 	}
-	if m.Input != nil {
-		size, err := m.Input.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Headers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarint(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarint(dAtA, i, uint64(len(m.Method)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Srv_Reset) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_CallRequestRaw_Input) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3378,12 +4014,12 @@ func (m *Srv_Reset) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Srv_Reset) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallRequestRaw_Input) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_Reset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallRequestRaw_Input) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3395,10 +4031,38 @@ func (m *Srv_Reset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if vtmsg, ok := m.Input.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Srv_FuzzingResult_CounterexampleItem) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_CallRequestRaw_Input_HttpRequest_) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.HttpRequest != nil {
+		size, err := m.HttpRequest.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallRequestRaw) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3411,12 +4075,12 @@ func (m *Srv_FuzzingResult_CounterexampleItem) MarshalVT() (dAtA []byte, err err
 	return dAtA[:n], nil
 }
 
-func (m *Srv_FuzzingResult_CounterexampleItem) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallRequestRaw) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_FuzzingResult_CounterexampleItem) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallRequestRaw) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3428,18 +4092,17 @@ func (m *Srv_FuzzingResult_CounterexampleItem) MarshalToSizedBufferVT(dAtA []byt
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.CallResponse != nil {
-		size, err := m.CallResponse.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Reason) > 0 {
+		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reason[iNdEx])
+			copy(dAtA[i:], m.Reason[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Reason[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
-		i--
-		dAtA[i] = 0x12
 	}
-	if m.CallRequest != nil {
-		size, err := m.CallRequest.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Input != nil {
+		size, err := m.Input.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3451,7 +4114,7 @@ func (m *Srv_FuzzingResult_CounterexampleItem) MarshalToSizedBufferVT(dAtA []byt
 	return len(dAtA) - i, nil
 }
 
-func (m *Srv_FuzzingResult) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3464,12 +4127,12 @@ func (m *Srv_FuzzingResult) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Srv_FuzzingResult) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_FuzzingResult) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3481,63 +4144,59 @@ func (m *Srv_FuzzingResult) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Counterexample) > 0 {
-		for iNdEx := len(m.Counterexample) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Counterexample[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x32
-		}
+	if len(m.RemoteAddr) > 0 {
+		i -= len(m.RemoteAddr)
+		copy(dAtA[i:], m.RemoteAddr)
+		i = encodeVarint(dAtA, i, uint64(len(m.RemoteAddr)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.SuggestedSeed) > 0 {
-		i -= len(m.SuggestedSeed)
-		copy(dAtA[i:], m.SuggestedSeed)
-		i = encodeVarint(dAtA, i, uint64(len(m.SuggestedSeed)))
+	if len(m.LocalAddr) > 0 {
+		i -= len(m.LocalAddr)
+		copy(dAtA[i:], m.LocalAddr)
+		i = encodeVarint(dAtA, i, uint64(len(m.LocalAddr)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x3a
 	}
-	if m.WillNowShrink {
+	if m.ReusedConn {
 		i--
-		if m.WillNowShrink {
+		if m.ReusedConn {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x30
 	}
-	if len(m.NextSeed) > 0 {
-		i -= len(m.NextSeed)
-		copy(dAtA[i:], m.NextSeed)
-		i = encodeVarint(dAtA, i, uint64(len(m.NextSeed)))
+	if m.TransferNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TransferNs))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x28
 	}
-	if m.WasShrinking {
+	if m.TtfbNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TtfbNs))
 		i--
-		if m.WasShrinking {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x20
+	}
+	if m.TlsNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TlsNs))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ConnectNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ConnectNs))
 		i--
 		dAtA[i] = 0x10
 	}
-	if len(m.SeedUsed) > 0 {
-		i -= len(m.SeedUsed)
-		copy(dAtA[i:], m.SeedUsed)
-		i = encodeVarint(dAtA, i, uint64(len(m.SeedUsed)))
+	if m.DnsNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.DnsNs))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Srv) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3550,12 +4209,12 @@ func (m *Srv) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Srv) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3567,105 +4226,53 @@ func (m *Srv) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Msg.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-	}
-	if m.FuzzingProgress != nil {
-		size, err := m.FuzzingProgress.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.ElapsedNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ElapsedNs))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x30
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Srv_FuzzRep_) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Srv_FuzzRep_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.FuzzRep != nil {
-		size, err := m.FuzzRep.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Headers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x2a
 		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarint(dAtA, i, uint64(len(m.Reason)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x22
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Srv_Call_) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Srv_Call_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Call != nil {
-		size, err := m.Call.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if m.StatusCode != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.StatusCode))
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x18
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Srv_Reset_) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Srv_Reset_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.Reset_ != nil {
-		size, err := m.Reset_.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarint(dAtA, i, uint64(len(m.Url)))
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x12
 	}
-	return len(dAtA) - i, nil
-}
-func (m *Srv_FuzzingResult_) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Srv_FuzzingResult_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	if m.FuzzingResult != nil {
-		size, err := m.FuzzingResult.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarint(dAtA, i, uint64(size))
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarint(dAtA, i, uint64(len(m.Method)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *Uint32S) MarshalVT() (dAtA []byte, err error) {
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3678,12 +4285,12 @@ func (m *Uint32S) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Uint32S) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Uint32S) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3695,30 +4302,58 @@ func (m *Uint32S) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Values) > 0 {
-		var pksize2 int
-		for _, num := range m.Values {
-			pksize2 += sov(uint64(num))
-		}
-		i -= pksize2
-		j1 := i
-		for _, num := range m.Values {
-			for num >= 1<<7 {
-				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
+	if m.ElapsedNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ElapsedNs))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.DataDecoded != nil {
+		if vtmsg, ok := interface{}(m.DataDecoded).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			dAtA[j1] = uint8(num)
-			j1++
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+		} else {
+			encoded, err := proto.Marshal(m.DataDecoded)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarint(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Event) > 0 {
+		i -= len(m.Event)
+		copy(dAtA[i:], m.Event)
+		i = encodeVarint(dAtA, i, uint64(len(m.Event)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarint(dAtA, i, uint64(len(m.Id)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *HeaderPair) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3731,12 +4366,12 @@ func (m *HeaderPair) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *HeaderPair) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *HeaderPair) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3748,142 +4383,146 @@ func (m *HeaderPair) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Values) > 0 {
-		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Values[iNdEx])
-			copy(dAtA[i:], m.Values[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Values[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+	if m.BodyTruncated {
+		i--
+		if m.BodyTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x78
 	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarint(dAtA, i, uint64(len(m.Key)))
+	if len(m.BodySha256) > 0 {
+		i -= len(m.BodySha256)
+		copy(dAtA[i:], m.BodySha256)
+		i = encodeVarint(dAtA, i, uint64(len(m.BodySha256)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x72
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *SpecIR) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
+	if m.BodySize != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.BodySize))
+		i--
+		dAtA[i] = 0x68
 	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.EventsTruncated {
+		i--
+		if m.EventsTruncated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
 	}
-	return dAtA[:n], nil
-}
-
-func (m *SpecIR) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *SpecIR) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	if len(m.Events) > 0 {
+		for iNdEx := len(m.Events) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Events[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x5a
+		}
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.Retries != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x50
 	}
-	if len(m.Endpoints) > 0 {
-		for k := range m.Endpoints {
-			v := m.Endpoints[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.History[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x4a
 		}
 	}
-	if m.Schemas != nil {
-		size, err := m.Schemas.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Timings != nil {
+		size, err := m.Timings.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x42
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *Schemas) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
-	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.ElapsedNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ElapsedNs))
+		i--
+		dAtA[i] = 0x38
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Schemas) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *Schemas) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
+	if m.BodyDecoded != nil {
+		if vtmsg, ok := interface{}(m.BodyDecoded).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+		} else {
+			encoded, err := proto.Marshal(m.BodyDecoded)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
+		}
+		i--
+		dAtA[i] = 0x32
 	}
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if len(m.Body) > 0 {
+		i -= len(m.Body)
+		copy(dAtA[i:], m.Body)
+		i = encodeVarint(dAtA, i, uint64(len(m.Body)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Json) > 0 {
-		for k := range m.Json {
-			v := m.Json[k]
-			baseI := i
-			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Headers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
 			i -= size
 			i = encodeVarint(dAtA, i, uint64(size))
 			i--
-			dAtA[i] = 0x12
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x22
 		}
 	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarint(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.StatusCode != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.StatusCode))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarint(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RefOrSchemaJSON) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt_CallResponseRaw_Output) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3896,12 +4535,12 @@ func (m *RefOrSchemaJSON) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RefOrSchemaJSON) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *RefOrSchemaJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3913,7 +4552,7 @@ func (m *RefOrSchemaJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.PtrOrSchema.(interface {
+	if vtmsg, ok := m.Output.(interface {
 		MarshalToSizedBufferVT([]byte) (int, error)
 	}); ok {
 		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
@@ -3925,15 +4564,15 @@ func (m *RefOrSchemaJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RefOrSchemaJSON_Ptr) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *RefOrSchemaJSON_Ptr) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Ptr != nil {
-		size, err := m.Ptr.MarshalToSizedBufferVT(dAtA[:i])
+	if m.HttpResponse != nil {
+		size, err := m.HttpResponse.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -3944,26 +4583,55 @@ func (m *RefOrSchemaJSON_Ptr) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func (m *RefOrSchemaJSON_Schema) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Clt_CallResponseRaw) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *RefOrSchemaJSON_Schema) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallResponseRaw) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
 	i := len(dAtA)
-	if m.Schema != nil {
-		size, err := m.Schema.MarshalToSizedBufferVT(dAtA[:i])
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.OutputId != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.OutputId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Output != nil {
+		size, err := m.Output.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
-func (m *SchemaPtr) MarshalVT() (dAtA []byte, err error) {
+
+func (m *Clt_CallVerifProgress) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -3976,12 +4644,12 @@ func (m *SchemaPtr) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *SchemaPtr) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_CallVerifProgress) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *SchemaPtr) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_CallVerifProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -3993,22 +4661,46 @@ func (m *SchemaPtr) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Ref) > 0 {
-		i -= len(m.Ref)
-		copy(dAtA[i:], m.Ref)
-		i = encodeVarint(dAtA, i, uint64(len(m.Ref)))
+	if m.ExecutionSteps != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ExecutionSteps))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x30
 	}
-	if m.SID != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SID))
+	if m.ElapsedNs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.ElapsedNs))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x28
+	}
+	if len(m.Reason) > 0 {
+		for iNdEx := len(m.Reason) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reason[iNdEx])
+			copy(dAtA[i:], m.Reason[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Reason[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Origin != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Origin))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Status != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Endpoint) MarshalVT() (dAtA []byte, err error) {
+func (m *Clt) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4021,12 +4713,12 @@ func (m *Endpoint) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Endpoint) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Endpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4038,7 +4730,7 @@ func (m *Endpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Endpoint.(interface {
+	if vtmsg, ok := m.Msg.(interface {
 		MarshalToSizedBufferVT([]byte) (int, error)
 	}); ok {
 		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
@@ -4050,15 +4742,15 @@ func (m *Endpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Endpoint_Json) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Endpoint_Json) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Clt_Fuzz_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	if m.Json != nil {
-		size, err := m.Json.MarshalToSizedBufferVT(dAtA[:i])
+	if m.Fuzz != nil {
+		size, err := m.Fuzz.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4069,84 +4761,83 @@ func (m *Endpoint_Json) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	}
 	return len(dAtA) - i, nil
 }
-func (m *EndpointJSON) MarshalVT() (dAtA []byte, err error) {
-	if m == nil {
-		return nil, nil
-	}
+func (m *Clt_ResetProgress_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *EndpointJSON) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Clt_ResetProgress_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.ResetProgress != nil {
+		size, err := m.ResetProgress.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallRequestRaw_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *EndpointJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	if m == nil {
-		return 0, nil
-	}
+func (m *Clt_CallRequestRaw_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.unknownFields != nil {
-		i -= len(m.unknownFields)
-		copy(dAtA[i:], m.unknownFields)
+	if m.CallRequestRaw != nil {
+		size, err := m.CallRequestRaw.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.Outputs) > 0 {
-		for k := range m.Outputs {
-			v := m.Outputs[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i = encodeVarint(dAtA, i, uint64(k))
-			i--
-			dAtA[i] = 0x8
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Inputs) > 0 {
-		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.Inputs[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x1a
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallResponseRaw_) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_CallResponseRaw_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CallResponseRaw != nil {
+		size, err := m.CallResponseRaw.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.PathPartials) > 0 {
-		for iNdEx := len(m.PathPartials) - 1; iNdEx >= 0; iNdEx-- {
-			size, err := m.PathPartials[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarint(dAtA, i, uint64(size))
-			i--
-			dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *Clt_CallVerifProgress_) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Clt_CallVerifProgress_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.CallVerifProgress != nil {
+		size, err := m.CallVerifProgress.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-	}
-	if m.Method != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Method))
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *ParamJSON) MarshalVT() (dAtA []byte, err error) {
+func (m *Srv_FuzzingProgress) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4159,12 +4850,12 @@ func (m *ParamJSON) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ParamJSON) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Srv_FuzzingProgress) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *ParamJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Srv_FuzzingProgress) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4176,26 +4867,79 @@ func (m *ParamJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Kind != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Kind))
+	if m.CallChecksSkipped != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.CallChecksSkipped))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CallChecksCount != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.CallChecksCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.TestCallsCount != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TestCallsCount))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.TotalChecksCount != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TotalChecksCount))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.TotalCallsCount != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TotalCallsCount))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.TotalTestsCount != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.TotalTestsCount))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastCheckSuccess {
+		i--
+		if m.LastCheckSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastCheckFailure {
+		i--
+		if m.LastCheckFailure {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x20
 	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarint(dAtA, i, uint64(len(m.Name)))
+	if m.LastCallSuccess {
 		i--
-		dAtA[i] = 0x1a
+		if m.LastCallSuccess {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.SID != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.SID))
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
 		i--
 		dAtA[i] = 0x10
 	}
-	if m.IsRequired {
+	if m.Failure {
 		i--
-		if m.IsRequired {
+		if m.Failure {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
@@ -4206,7 +4950,7 @@ func (m *ParamJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PathPartial) MarshalVT() (dAtA []byte, err error) {
+func (m *Srv_FuzzRep) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4219,12 +4963,12 @@ func (m *PathPartial) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PathPartial) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Srv_FuzzRep) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *PathPartial) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Srv_FuzzRep) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4236,47 +4980,39 @@ func (m *PathPartial) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Pp.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
+	if m.MaxExecutionMsPerCheck != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MaxExecutionMsPerCheck))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxExecutionStepsPerCheck != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MaxExecutionStepsPerCheck))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Token) > 0 {
+		i -= len(m.Token)
+		copy(dAtA[i:], m.Token)
+		i = encodeVarint(dAtA, i, uint64(len(m.Token)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Seed) > 0 {
+		i -= len(m.Seed)
+		copy(dAtA[i:], m.Seed)
+		i = encodeVarint(dAtA, i, uint64(len(m.Seed)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.MaxTestsCount != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MaxTestsCount))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PathPartial_Part) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *PathPartial_Part) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Part)
-	copy(dAtA[i:], m.Part)
-	i = encodeVarint(dAtA, i, uint64(len(m.Part)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-func (m *PathPartial_Ptr) MarshalToVT(dAtA []byte) (int, error) {
-	size := m.SizeVT()
-	return m.MarshalToSizedBufferVT(dAtA[:size])
-}
-
-func (m *PathPartial_Ptr) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Ptr)
-	copy(dAtA[i:], m.Ptr)
-	i = encodeVarint(dAtA, i, uint64(len(m.Ptr)))
-	i--
-	dAtA[i] = 0x12
-	return len(dAtA) - i, nil
-}
-func (m *Schema_JSON_AdditionalProperties) MarshalVT() (dAtA []byte, err error) {
+func (m *Srv_Call_Input_HttpRequest) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4289,12 +5025,12 @@ func (m *Schema_JSON_AdditionalProperties) MarshalVT() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *Schema_JSON_AdditionalProperties) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Srv_Call_Input_HttpRequest) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Schema_JSON_AdditionalProperties) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Srv_Call_Input_HttpRequest) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4306,48 +5042,119 @@ func (m *Schema_JSON_AdditionalProperties) MarshalToSizedBufferVT(dAtA []byte) (
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.AddProps.(interface {
-		MarshalToSizedBufferVT([]byte) (int, error)
-	}); ok {
-		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.Body != nil {
+		if vtmsg, ok := interface{}(m.Body).(interface {
+			MarshalToSizedBufferVT([]byte) (int, error)
+		}); ok {
+			size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+		} else {
+			encoded, err := proto.Marshal(m.Body)
+			if err != nil {
+				return 0, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = encodeVarint(dAtA, i, uint64(len(encoded)))
 		}
-		i -= size
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Headers) > 0 {
+		for iNdEx := len(m.Headers) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Headers[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Url) > 0 {
+		i -= len(m.Url)
+		copy(dAtA[i:], m.Url)
+		i = encodeVarint(dAtA, i, uint64(len(m.Url)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Method) > 0 {
+		i -= len(m.Method)
+		copy(dAtA[i:], m.Method)
+		i = encodeVarint(dAtA, i, uint64(len(m.Method)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Srv_Call_Input) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_Call_Input) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Srv_Call_Input) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
 	i := len(dAtA)
-	i--
-	if m.AlwaysSucceed {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if vtmsg, ok := m.Input.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 	}
-	i--
-	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
-func (m *Schema_JSON_AdditionalProperties_SID) MarshalToVT(dAtA []byte) (int, error) {
+
+func (m *Srv_Call_Input_HttpRequest_) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Schema_JSON_AdditionalProperties_SID) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Srv_Call_Input_HttpRequest_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	i := len(dAtA)
-	i = encodeVarint(dAtA, i, uint64(m.SID))
-	i--
-	dAtA[i] = 0x10
+	if m.HttpRequest != nil {
+		size, err := m.HttpRequest.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
-func (m *Schema_JSON) MarshalVT() (dAtA []byte, err error) {
+func (m *Srv_Call) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4360,12 +5167,12 @@ func (m *Schema_JSON) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Schema_JSON) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Srv_Call) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Schema_JSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Srv_Call) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4377,358 +5184,332 @@ func (m *Schema_JSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Not != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.Not))
-		i--
-		dAtA[i] = 0x1
+	if len(m.ModelName) > 0 {
+		i -= len(m.ModelName)
+		copy(dAtA[i:], m.ModelName)
+		i = encodeVarint(dAtA, i, uint64(len(m.ModelName)))
 		i--
-		dAtA[i] = 0xf0
+		dAtA[i] = 0x1a
 	}
-	if len(m.OneOf) > 0 {
-		var pksize2 int
-		for _, num := range m.OneOf {
-			pksize2 += sov(uint64(num))
-		}
-		i -= pksize2
-		j1 := i
-		for _, num := range m.OneOf {
-			for num >= 1<<7 {
-				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA[j1] = uint8(num)
-			j1++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize2))
-		i--
-		dAtA[i] = 0x1
+	if m.EID != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.EID))
 		i--
-		dAtA[i] = 0xea
+		dAtA[i] = 0x10
 	}
-	if len(m.AnyOf) > 0 {
-		var pksize4 int
-		for _, num := range m.AnyOf {
-			pksize4 += sov(uint64(num))
-		}
-		i -= pksize4
-		j3 := i
-		for _, num := range m.AnyOf {
-			for num >= 1<<7 {
-				dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j3++
-			}
-			dAtA[j3] = uint8(num)
-			j3++
+	if m.Input != nil {
+		size, err := m.Input.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize4))
-		i--
-		dAtA[i] = 0x1
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0xe2
+		dAtA[i] = 0xa
 	}
-	if len(m.AllOf) > 0 {
-		var pksize6 int
-		for _, num := range m.AllOf {
-			pksize6 += sov(uint64(num))
-		}
-		i -= pksize6
-		j5 := i
-		for _, num := range m.AllOf {
-			for num >= 1<<7 {
-				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j5++
-			}
-			dAtA[j5] = uint8(num)
-			j5++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize6))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xda
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_Reset) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	if m.HasAdditionalProperties {
-		i--
-		if m.HasAdditionalProperties {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xd0
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.AdditionalProperties != nil {
-		size, err := m.AdditionalProperties.MarshalToSizedBufferVT(dAtA[:i])
+	return dAtA[:n], nil
+}
+
+func (m *Srv_Reset) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Srv_Reset) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
+	}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	if m.CallResponse != nil {
+		size, err := m.CallResponse.MarshalToSizedBufferVT(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
 		i -= size
 		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xca
+		dAtA[i] = 0x12
 	}
-	if m.HasMaxProperties {
-		i--
-		if m.HasMaxProperties {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if m.CallRequest != nil {
+		size, err := m.CallRequest.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xc0
+		dAtA[i] = 0xa
 	}
-	if m.MaxProperties != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MaxProperties))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb8
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_FuzzingResult) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	if m.MinProperties != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MinProperties))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0xb0
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Required) > 0 {
-		for iNdEx := len(m.Required) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Required[iNdEx])
-			copy(dAtA[i:], m.Required[iNdEx])
-			i = encodeVarint(dAtA, i, uint64(len(m.Required[iNdEx])))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xaa
-		}
+	return dAtA[:n], nil
+}
+
+func (m *Srv_FuzzingResult) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Srv_FuzzingResult) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
-	if len(m.Properties) > 0 {
-		for k := range m.Properties {
-			v := m.Properties[k]
-			baseI := i
-			i = encodeVarint(dAtA, i, uint64(v))
-			i--
-			dAtA[i] = 0x10
-			i -= len(k)
-			copy(dAtA[i:], k)
-			i = encodeVarint(dAtA, i, uint64(len(k)))
-			i--
-			dAtA[i] = 0xa
-			i = encodeVarint(dAtA, i, uint64(baseI-i))
-			i--
-			dAtA[i] = 0x1
-			i--
-			dAtA[i] = 0xa2
-		}
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.HasMaxItems {
-		i--
-		if m.HasMaxItems {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	if len(m.Counterexample) > 0 {
+		for iNdEx := len(m.Counterexample) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Counterexample[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x32
 		}
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x98
 	}
-	if m.MaxItems != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MaxItems))
-		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x90
-	}
-	if m.MinItems != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MinItems))
-		i--
-		dAtA[i] = 0x1
+	if len(m.SuggestedSeed) > 0 {
+		i -= len(m.SuggestedSeed)
+		copy(dAtA[i:], m.SuggestedSeed)
+		i = encodeVarint(dAtA, i, uint64(len(m.SuggestedSeed)))
 		i--
-		dAtA[i] = 0x88
+		dAtA[i] = 0x2a
 	}
-	if m.UniqueItems {
+	if m.WillNowShrink {
 		i--
-		if m.UniqueItems {
+		if m.WillNowShrink {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x1
-		i--
-		dAtA[i] = 0x80
+		dAtA[i] = 0x20
 	}
-	if len(m.Items) > 0 {
-		var pksize8 int
-		for _, num := range m.Items {
-			pksize8 += sov(uint64(num))
-		}
-		i -= pksize8
-		j7 := i
-		for _, num := range m.Items {
-			for num >= 1<<7 {
-				dAtA[j7] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j7++
-			}
-			dAtA[j7] = uint8(num)
-			j7++
-		}
-		i = encodeVarint(dAtA, i, uint64(pksize8))
+	if len(m.NextSeed) > 0 {
+		i -= len(m.NextSeed)
+		copy(dAtA[i:], m.NextSeed)
+		i = encodeVarint(dAtA, i, uint64(len(m.NextSeed)))
 		i--
-		dAtA[i] = 0x7a
+		dAtA[i] = 0x1a
 	}
-	if m.ExclusiveMaximum {
+	if m.WasShrinking {
 		i--
-		if m.ExclusiveMaximum {
+		if m.WasShrinking {
 			dAtA[i] = 1
 		} else {
 			dAtA[i] = 0
 		}
 		i--
-		dAtA[i] = 0x70
+		dAtA[i] = 0x10
 	}
-	if m.ExclusiveMinimum {
-		i--
-		if m.ExclusiveMinimum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+	if len(m.SeedUsed) > 0 {
+		i -= len(m.SeedUsed)
+		copy(dAtA[i:], m.SeedUsed)
+		i = encodeVarint(dAtA, i, uint64(len(m.SeedUsed)))
 		i--
-		dAtA[i] = 0x68
+		dAtA[i] = 0xa
 	}
-	if m.TranslatedMultipleOf != 0 {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TranslatedMultipleOf))))
-		i--
-		dAtA[i] = 0x61
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	if m.HasMaximum {
-		i--
-		if m.HasMaximum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x58
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.HasMinimum {
-		i--
-		if m.HasMinimum {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
-		i--
-		dAtA[i] = 0x50
+	return dAtA[:n], nil
+}
+
+func (m *Srv) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Srv) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
-	if m.Maximum != 0 {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Maximum))))
-		i--
-		dAtA[i] = 0x49
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Minimum != 0 {
-		i -= 8
-		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Minimum))))
-		i--
-		dAtA[i] = 0x41
+	if vtmsg, ok := m.Msg.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
 	}
-	if len(m.Pattern) > 0 {
-		i -= len(m.Pattern)
-		copy(dAtA[i:], m.Pattern)
-		i = encodeVarint(dAtA, i, uint64(len(m.Pattern)))
+	if m.FuzzingProgress != nil {
+		size, err := m.FuzzingProgress.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0xa
 	}
-	if m.HasMaxLength {
-		i--
-		if m.HasMaxLength {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+	return len(dAtA) - i, nil
+}
+
+func (m *Srv_FuzzRep_) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Srv_FuzzRep_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FuzzRep != nil {
+		size, err := m.FuzzRep.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0x30
-	}
-	if m.MaxLength != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MaxLength))
-		i--
-		dAtA[i] = 0x28
-	}
-	if m.MinLength != 0 {
-		i = encodeVarint(dAtA, i, uint64(m.MinLength))
-		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x12
 	}
-	if len(m.Format) > 0 {
-		i -= len(m.Format)
-		copy(dAtA[i:], m.Format)
-		i = encodeVarint(dAtA, i, uint64(len(m.Format)))
+	return len(dAtA) - i, nil
+}
+func (m *Srv_Call_) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Srv_Call_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Call != nil {
+		size, err := m.Call.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Enum) > 0 {
-		for iNdEx := len(m.Enum) - 1; iNdEx >= 0; iNdEx-- {
-			if vtmsg, ok := interface{}(m.Enum[iNdEx]).(interface {
-				MarshalToSizedBufferVT([]byte) (int, error)
-			}); ok {
-				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarint(dAtA, i, uint64(size))
-			} else {
-				encoded, err := proto.Marshal(m.Enum[iNdEx])
-				if err != nil {
-					return 0, err
-				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = encodeVarint(dAtA, i, uint64(len(encoded)))
-			}
-			i--
-			dAtA[i] = 0x12
+	return len(dAtA) - i, nil
+}
+func (m *Srv_Reset_) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Srv_Reset_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Reset_ != nil {
+		size, err := m.Reset_.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Types) > 0 {
-		var pksize10 int
-		for _, num := range m.Types {
-			pksize10 += sov(uint64(num))
-		}
-		i -= pksize10
-		j9 := i
-		for _, num1 := range m.Types {
-			num := uint64(num1)
-			for num >= 1<<7 {
-				dAtA[j9] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j9++
-			}
-			dAtA[j9] = uint8(num)
-			j9++
+	return len(dAtA) - i, nil
+}
+func (m *Srv_FuzzingResult_) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Srv_FuzzingResult_) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.FuzzingResult != nil {
+		size, err := m.FuzzingResult.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i = encodeVarint(dAtA, i, uint64(pksize10))
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x2a
 	}
 	return len(dAtA) - i, nil
 }
-
-func (m *Schema) MarshalVT() (dAtA []byte, err error) {
+func (m *Uint32S) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
 		return nil, nil
 	}
@@ -4741,12 +5522,12 @@ func (m *Schema) MarshalVT() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Schema) MarshalToVT(dAtA []byte) (int, error) {
+func (m *Uint32S) MarshalToVT(dAtA []byte) (int, error) {
 	size := m.SizeVT()
 	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Schema) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+func (m *Uint32S) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
 		return 0, nil
 	}
@@ -4758,1222 +5539,6087 @@ func (m *Schema) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 		i -= len(m.unknownFields)
 		copy(dAtA[i:], m.unknownFields)
 	}
+	if len(m.Values) > 0 {
+		var pksize2 int
+		for _, num := range m.Values {
+			pksize2 += sov(uint64(num))
+		}
+		i -= pksize2
+		j1 := i
+		for _, num := range m.Values {
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA[j1] = uint8(num)
+			j1++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarint(dAtA []byte, offset int, v uint64) int {
-	offset -= sov(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Clt_Fuzz_Resetter_Shell) SizeVT() (n int) {
+func (m *HeaderPair) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Start)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	l = len(m.Rst)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+		return nil, nil
 	}
-	l = len(m.Stop)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Clt_Fuzz_Resetter) SizeVT() (n int) {
+func (m *HeaderPair) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *HeaderPair) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Provides) > 0 {
-		for _, s := range m.Provides {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if vtmsg, ok := m.Resetter.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarint(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Clt_Fuzz_Resetter_Shell_) SizeVT() (n int) {
+func (m *SpecIR) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if m.Shell != nil {
-		l = m.Shell.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Clt_Fuzz_Model_OpenAPIv3) SizeVT() (n int) {
+
+func (m *SpecIR) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *SpecIR) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Spec != nil {
-		l = m.Spec.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	l = len(m.File)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if len(m.Endpoints) > 0 {
+		for k := range m.Endpoints {
+			v := m.Endpoints[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Host)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.Schemas != nil {
+		size, err := m.Schemas.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Clt_Fuzz_Model) SizeVT() (n int) {
+func (m *Schemas) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
+	}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Schemas) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Schemas) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	if m == nil {
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if vtmsg, ok := m.Model.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	if len(m.Json) > 0 {
+		for k := range m.Json {
+			v := m.Json[k]
+			baseI := i
+			size, err := v.MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Clt_Fuzz_Model_Openapiv3) SizeVT() (n int) {
+func (m *RefOrSchemaJSON) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if m.Openapiv3 != nil {
-		l = m.Openapiv3.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Clt_Fuzz) SizeVT() (n int) {
+
+func (m *RefOrSchemaJSON) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RefOrSchemaJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Resetters) > 0 {
-		for _, e := range m.Resetters {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
-	}
-	if len(m.Models) > 0 {
-		for _, e := range m.Models {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
-	}
-	if len(m.Usage) > 0 {
-		for _, s := range m.Usage {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
-		}
-	}
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.Ntensity != 0 {
-		n += 1 + sov(uint64(m.Ntensity))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.EIDs) > 0 {
-		for k, v := range m.EIDs {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.SizeVT()
-			}
-			l += 1 + sov(uint64(l))
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+	if vtmsg, ok := m.PtrOrSchema.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
 	}
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+	return len(dAtA) - i, nil
+}
+
+func (m *RefOrSchemaJSON_Ptr) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RefOrSchemaJSON_Ptr) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Ptr != nil {
+		size, err := m.Ptr.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.EnvRead) > 0 {
-		for k, v := range m.EnvRead {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+	return len(dAtA) - i, nil
+}
+func (m *RefOrSchemaJSON_Schema) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *RefOrSchemaJSON_Schema) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Schema != nil {
+		size, err := m.Schema.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.UUIDs) > 0 {
-		for _, s := range m.UUIDs {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+func (m *SchemaPtr) MarshalVT() (dAtA []byte, err error) {
+	if m == nil {
+		return nil, nil
 	}
-	if len(m.Files) > 0 {
-		for k, v := range m.Files {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
-		}
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Clt_ResetProgress) SizeVT() (n int) {
+func (m *SchemaPtr) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *SchemaPtr) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Status != 0 {
-		n += 1 + sov(uint64(m.Status))
-	}
-	if m.ElapsedNs != 0 {
-		n += 1 + sov(uint64(m.ElapsedNs))
-	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
-		}
-	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *Clt_CallRequestRaw_Input_HttpRequest) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	l = len(m.Body)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if len(m.Ref) > 0 {
+		i -= len(m.Ref)
+		copy(dAtA[i:], m.Ref)
+		i = encodeVarint(dAtA, i, uint64(len(m.Ref)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.BodyDecoded != nil {
-		if size, ok := interface{}(m.BodyDecoded).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.BodyDecoded)
-		}
-		n += 1 + l + sov(uint64(l))
+	if m.SID != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SID))
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Clt_CallRequestRaw_Input) SizeVT() (n int) {
+func (m *Endpoint) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Input.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Clt_CallRequestRaw_Input_HttpRequest_) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HttpRequest != nil {
-		l = m.HttpRequest.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	return n
-}
-func (m *Clt_CallRequestRaw) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
-		}
-	}
-	n += len(m.unknownFields)
-	return n
+func (m *Endpoint) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse) SizeVT() (n int) {
+func (m *Endpoint) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Error)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.StatusCode != 0 {
-		n += 1 + sov(uint64(m.StatusCode))
-	}
-	l = len(m.Reason)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
-	}
-	l = len(m.Body)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.BodyDecoded != nil {
-		if size, ok := interface{}(m.BodyDecoded).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.BodyDecoded)
+	if vtmsg, ok := m.Endpoint.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.ElapsedNs != 0 {
-		n += 1 + sov(uint64(m.ElapsedNs))
+		i -= size
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Clt_CallResponseRaw_Output) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Output.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
-	}
-	n += len(m.unknownFields)
-	return n
+func (m *Endpoint_Json) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Clt_CallResponseRaw_Output_HttpResponse_) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HttpResponse != nil {
-		l = m.HttpResponse.SizeVT()
-		n += 1 + l + sov(uint64(l))
+func (m *Endpoint_Json) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Json != nil {
+		size, err := m.Json.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Clt_CallResponseRaw) SizeVT() (n int) {
+func (m *EndpointJSON) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Output != nil {
-		l = m.Output.SizeVT()
-		n += 1 + l + sov(uint64(l))
+		return nil, nil
 	}
-	if m.OutputId != 0 {
-		n += 1 + sov(uint64(m.OutputId))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Clt_CallVerifProgress) SizeVT() (n int) {
+func (m *EndpointJSON) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *EndpointJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.Status != 0 {
-		n += 1 + sov(uint64(m.Status))
-	}
-	if m.Origin != 0 {
-		n += 1 + sov(uint64(m.Origin))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Reason) > 0 {
-		for _, s := range m.Reason {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
+	if m.Idempotent {
+		i--
+		if m.Idempotent {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if m.ElapsedNs != 0 {
-		n += 1 + sov(uint64(m.ElapsedNs))
+	if m.MaxLatencyMs != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MaxLatencyMs))
+		i--
+		dAtA[i] = 0x50
 	}
-	if m.ExecutionSteps != 0 {
-		n += 1 + sov(uint64(m.ExecutionSteps))
+	if m.Weight != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x48
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *Clt) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.Skip {
+		i--
+		if m.Skip {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
 	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Msg.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	if m.Deprecated {
+		i--
+		if m.Deprecated {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *Clt_Fuzz_) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Tags) > 0 {
+		for iNdEx := len(m.Tags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Tags[iNdEx])
+			copy(dAtA[i:], m.Tags[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Tags[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	var l int
-	_ = l
-	if m.Fuzz != nil {
-		l = m.Fuzz.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if len(m.OperationId) > 0 {
+		i -= len(m.OperationId)
+		copy(dAtA[i:], m.OperationId)
+		i = encodeVarint(dAtA, i, uint64(len(m.OperationId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-func (m *Clt_ResetProgress_) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Outputs) > 0 {
+		for k := range m.Outputs {
+			v := m.Outputs[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i = encodeVarint(dAtA, i, uint64(k))
+			i--
+			dAtA[i] = 0x8
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	var l int
-	_ = l
-	if m.ResetProgress != nil {
-		l = m.ResetProgress.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if len(m.Inputs) > 0 {
+		for iNdEx := len(m.Inputs) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.Inputs[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	return n
-}
-func (m *Clt_CallRequestRaw_) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if len(m.PathPartials) > 0 {
+		for iNdEx := len(m.PathPartials) - 1; iNdEx >= 0; iNdEx-- {
+			size, err := m.PathPartials[iNdEx].MarshalToSizedBufferVT(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarint(dAtA, i, uint64(size))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	var l int
-	_ = l
-	if m.CallRequestRaw != nil {
-		l = m.CallRequestRaw.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.Method != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Method))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
-func (m *Clt_CallResponseRaw_) SizeVT() (n int) {
+
+func (m *ParamJSON) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if m.CallResponseRaw != nil {
-		l = m.CallResponseRaw.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Clt_CallVerifProgress_) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CallVerifProgress != nil {
-		l = m.CallVerifProgress.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	return n
+
+func (m *ParamJSON) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
-func (m *Srv_FuzzingProgress) SizeVT() (n int) {
+
+func (m *ParamJSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Failure {
-		n += 2
-	}
-	if m.Success {
-		n += 2
-	}
-	if m.LastCallSuccess {
-		n += 2
-	}
-	if m.LastCheckFailure {
-		n += 2
-	}
-	if m.LastCheckSuccess {
-		n += 2
-	}
-	if m.TotalTestsCount != 0 {
-		n += 1 + sov(uint64(m.TotalTestsCount))
-	}
-	if m.TotalCallsCount != 0 {
-		n += 1 + sov(uint64(m.TotalCallsCount))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.TotalChecksCount != 0 {
-		n += 1 + sov(uint64(m.TotalChecksCount))
+	if m.Kind != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.TestCallsCount != 0 {
-		n += 1 + sov(uint64(m.TestCallsCount))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarint(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if m.CallChecksCount != 0 {
-		n += 1 + sov(uint64(m.CallChecksCount))
+	if m.SID != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.SID))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.CallChecksSkipped != 0 {
-		n += 1 + sov(uint64(m.CallChecksSkipped))
+	if m.IsRequired {
+		i--
+		if m.IsRequired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Srv_FuzzRep) SizeVT() (n int) {
+func (m *PathPartial) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.MaxTestsCount != 0 {
-		n += 1 + sov(uint64(m.MaxTestsCount))
-	}
-	l = len(m.Seed)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	l = len(m.Token)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.MaxExecutionStepsPerCheck != 0 {
-		n += 1 + sov(uint64(m.MaxExecutionStepsPerCheck))
+		return nil, nil
 	}
-	if m.MaxExecutionMsPerCheck != 0 {
-		n += 1 + sov(uint64(m.MaxExecutionMsPerCheck))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Srv_Call_Input_HttpRequest) SizeVT() (n int) {
+func (m *PathPartial) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *PathPartial) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Method)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	l = len(m.Url)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if len(m.Headers) > 0 {
-		for _, e := range m.Headers {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
-		}
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if m.Body != nil {
-		if size, ok := interface{}(m.Body).(interface {
-			SizeVT() int
-		}); ok {
-			l = size.SizeVT()
-		} else {
-			l = proto.Size(m.Body)
+	if vtmsg, ok := m.Pp.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		n += 1 + l + sov(uint64(l))
+		i -= size
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Srv_Call_Input) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Input.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
-	}
-	n += len(m.unknownFields)
-	return n
+func (m *PathPartial_Part) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_Call_Input_HttpRequest_) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.HttpRequest != nil {
-		l = m.HttpRequest.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	return n
+func (m *PathPartial_Part) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Part)
+	copy(dAtA[i:], m.Part)
+	i = encodeVarint(dAtA, i, uint64(len(m.Part)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
-func (m *Srv_Call) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Input != nil {
-		l = m.Input.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.EID != 0 {
-		n += 1 + sov(uint64(m.EID))
-	}
-	l = len(m.ModelName)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	n += len(m.unknownFields)
-	return n
+func (m *PathPartial_Ptr) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_Reset) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	n += len(m.unknownFields)
-	return n
+func (m *PathPartial_Ptr) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Ptr)
+	copy(dAtA[i:], m.Ptr)
+	i = encodeVarint(dAtA, i, uint64(len(m.Ptr)))
+	i--
+	dAtA[i] = 0x12
+	return len(dAtA) - i, nil
 }
-
-func (m *Srv_FuzzingResult_CounterexampleItem) SizeVT() (n int) {
+func (m *Schema_JSON_AdditionalProperties) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.CallRequest != nil {
-		l = m.CallRequest.SizeVT()
-		n += 1 + l + sov(uint64(l))
+		return nil, nil
 	}
-	if m.CallResponse != nil {
-		l = m.CallResponse.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	n += len(m.unknownFields)
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *Srv_FuzzingResult) SizeVT() (n int) {
+func (m *Schema_JSON_AdditionalProperties) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Schema_JSON_AdditionalProperties) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.SeedUsed)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.WasShrinking {
-		n += 2
-	}
-	l = len(m.NextSeed)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.WillNowShrink {
-		n += 2
-	}
-	l = len(m.SuggestedSeed)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	if len(m.Counterexample) > 0 {
-		for _, e := range m.Counterexample {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	if vtmsg, ok := m.AddProps.(interface {
+		MarshalToSizedBufferVT([]byte) (int, error)
+	}); ok {
+		size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
 	}
-	n += len(m.unknownFields)
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *Srv) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FuzzingProgress != nil {
-		l = m.FuzzingProgress.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	if vtmsg, ok := m.Msg.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
-	}
-	n += len(m.unknownFields)
-	return n
+func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
 
-func (m *Srv_FuzzRep_) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.FuzzRep != nil {
-		l = m.FuzzRep.SizeVT()
-		n += 1 + l + sov(uint64(l))
+func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i--
+	if m.AlwaysSucceed {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
-	return n
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
 }
-func (m *Srv_Call_) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Call != nil {
-		l = m.Call.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	return n
+func (m *Schema_JSON_AdditionalProperties_SID) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
 }
-func (m *Srv_Reset_) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Reset_ != nil {
-		l = m.Reset_.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	return n
+
+func (m *Schema_JSON_AdditionalProperties_SID) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i = encodeVarint(dAtA, i, uint64(m.SID))
+	i--
+	dAtA[i] = 0x10
+	return len(dAtA) - i, nil
 }
-func (m *Srv_FuzzingResult_) SizeVT() (n int) {
+func (m *Schema_JSON) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	if m.FuzzingResult != nil {
-		l = m.FuzzingResult.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
-func (m *Uint32S) SizeVT() (n int) {
+
+func (m *Schema_JSON) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Schema_JSON) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Values) > 0 {
-		l = 0
-		for _, e := range m.Values {
-			l += sov(uint64(e))
-		}
-		n += 1 + sov(uint64(l)) + l
-	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *HeaderPair) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
 	}
-	var l int
-	_ = l
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.Not != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.Not))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xf0
 	}
-	if len(m.Values) > 0 {
-		for _, s := range m.Values {
-			l = len(s)
-			n += 1 + l + sov(uint64(l))
+	if len(m.OneOf) > 0 {
+		var pksize2 int
+		for _, num := range m.OneOf {
+			pksize2 += sov(uint64(num))
 		}
-	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *SpecIR) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Schemas != nil {
-		l = m.Schemas.SizeVT()
-		n += 1 + l + sov(uint64(l))
-	}
-	if len(m.Endpoints) > 0 {
-		for k, v := range m.Endpoints {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.SizeVT()
+		i -= pksize2
+		j1 := i
+		for _, num := range m.OneOf {
+			for num >= 1<<7 {
+				dAtA[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
 			}
-			l += 1 + sov(uint64(l))
-			mapEntrySize := 1 + sov(uint64(k)) + l
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+			dAtA[j1] = uint8(num)
+			j1++
 		}
+		i = encodeVarint(dAtA, i, uint64(pksize2))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xea
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *Schemas) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Json) > 0 {
-		for k, v := range m.Json {
-			_ = k
-			_ = v
-			l = 0
-			if v != nil {
-				l = v.SizeVT()
+	if len(m.AnyOf) > 0 {
+		var pksize4 int
+		for _, num := range m.AnyOf {
+			pksize4 += sov(uint64(num))
+		}
+		i -= pksize4
+		j3 := i
+		for _, num := range m.AnyOf {
+			for num >= 1<<7 {
+				dAtA[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
 			}
-			l += 1 + sov(uint64(l))
-			mapEntrySize := 1 + sov(uint64(k)) + l
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+			dAtA[j3] = uint8(num)
+			j3++
 		}
+		i = encodeVarint(dAtA, i, uint64(pksize4))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xe2
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *RefOrSchemaJSON) SizeVT() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.PtrOrSchema.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	if len(m.AllOf) > 0 {
+		var pksize6 int
+		for _, num := range m.AllOf {
+			pksize6 += sov(uint64(num))
+		}
+		i -= pksize6
+		j5 := i
+		for _, num := range m.AllOf {
+			for num >= 1<<7 {
+				dAtA[j5] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j5++
+			}
+			dAtA[j5] = uint8(num)
+			j5++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize6))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xda
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *RefOrSchemaJSON_Ptr) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.HasAdditionalProperties {
+		i--
+		if m.HasAdditionalProperties {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
 	}
-	var l int
-	_ = l
-	if m.Ptr != nil {
-		l = m.Ptr.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.AdditionalProperties != nil {
+		size, err := m.AdditionalProperties.MarshalToSizedBufferVT(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarint(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xca
 	}
-	return n
-}
-func (m *RefOrSchemaJSON_Schema) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.HasMaxProperties {
+		i--
+		if m.HasMaxProperties {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
 	}
-	var l int
-	_ = l
-	if m.Schema != nil {
-		l = m.Schema.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.MaxProperties != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MaxProperties))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
 	}
-	return n
-}
-func (m *SchemaPtr) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.MinProperties != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MinProperties))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
 	}
-	var l int
-	_ = l
-	if m.SID != 0 {
-		n += 1 + sov(uint64(m.SID))
+	if len(m.Required) > 0 {
+		for iNdEx := len(m.Required) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Required[iNdEx])
+			copy(dAtA[i:], m.Required[iNdEx])
+			i = encodeVarint(dAtA, i, uint64(len(m.Required[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
 	}
-	l = len(m.Ref)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if len(m.Properties) > 0 {
+		for k := range m.Properties {
+			v := m.Properties[k]
+			baseI := i
+			i = encodeVarint(dAtA, i, uint64(v))
+			i--
+			dAtA[i] = 0x10
+			i -= len(k)
+			copy(dAtA[i:], k)
+			i = encodeVarint(dAtA, i, uint64(len(k)))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarint(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *Endpoint) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.HasMaxItems {
+		i--
+		if m.HasMaxItems {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
 	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Endpoint.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	if m.MaxItems != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MaxItems))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *Endpoint_Json) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.MinItems != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MinItems))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
 	}
-	var l int
-	_ = l
-	if m.Json != nil {
-		l = m.Json.SizeVT()
-		n += 1 + l + sov(uint64(l))
+	if m.UniqueItems {
+		i--
+		if m.UniqueItems {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
 	}
-	return n
-}
-func (m *EndpointJSON) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Items) > 0 {
+		var pksize8 int
+		for _, num := range m.Items {
+			pksize8 += sov(uint64(num))
+		}
+		i -= pksize8
+		j7 := i
+		for _, num := range m.Items {
+			for num >= 1<<7 {
+				dAtA[j7] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j7++
+			}
+			dAtA[j7] = uint8(num)
+			j7++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize8))
+		i--
+		dAtA[i] = 0x7a
 	}
-	var l int
-	_ = l
-	if m.Method != 0 {
-		n += 1 + sov(uint64(m.Method))
+	if m.ExclusiveMaximum {
+		i--
+		if m.ExclusiveMaximum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
 	}
-	if len(m.PathPartials) > 0 {
-		for _, e := range m.PathPartials {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	if m.ExclusiveMinimum {
+		i--
+		if m.ExclusiveMinimum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x68
 	}
-	if len(m.Inputs) > 0 {
-		for _, e := range m.Inputs {
-			l = e.SizeVT()
-			n += 1 + l + sov(uint64(l))
+	if m.TranslatedMultipleOf != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.TranslatedMultipleOf))))
+		i--
+		dAtA[i] = 0x61
+	}
+	if m.HasMaximum {
+		i--
+		if m.HasMaximum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x58
 	}
-	if len(m.Outputs) > 0 {
-		for k, v := range m.Outputs {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
-			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+	if m.HasMinimum {
+		i--
+		if m.HasMinimum {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
 		}
+		i--
+		dAtA[i] = 0x50
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *ParamJSON) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.Maximum != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Maximum))))
+		i--
+		dAtA[i] = 0x49
 	}
-	var l int
-	_ = l
-	if m.IsRequired {
-		n += 2
+	if m.Minimum != 0 {
+		i -= 8
+		binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.Minimum))))
+		i--
+		dAtA[i] = 0x41
 	}
-	if m.SID != 0 {
-		n += 1 + sov(uint64(m.SID))
+	if len(m.Pattern) > 0 {
+		i -= len(m.Pattern)
+		copy(dAtA[i:], m.Pattern)
+		i = encodeVarint(dAtA, i, uint64(len(m.Pattern)))
+		i--
+		dAtA[i] = 0x3a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
+	if m.HasMaxLength {
+		i--
+		if m.HasMaxLength {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
 	}
-	if m.Kind != 0 {
-		n += 1 + sov(uint64(m.Kind))
+	if m.MaxLength != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MaxLength))
+		i--
+		dAtA[i] = 0x28
 	}
-	n += len(m.unknownFields)
-	return n
-}
-
-func (m *PathPartial) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if m.MinLength != 0 {
+		i = encodeVarint(dAtA, i, uint64(m.MinLength))
+		i--
+		dAtA[i] = 0x20
 	}
-	var l int
-	_ = l
-	if vtmsg, ok := m.Pp.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	if len(m.Format) > 0 {
+		i -= len(m.Format)
+		copy(dAtA[i:], m.Format)
+		i = encodeVarint(dAtA, i, uint64(len(m.Format)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	n += len(m.unknownFields)
-	return n
+	if len(m.Enum) > 0 {
+		for iNdEx := len(m.Enum) - 1; iNdEx >= 0; iNdEx-- {
+			if vtmsg, ok := interface{}(m.Enum[iNdEx]).(interface {
+				MarshalToSizedBufferVT([]byte) (int, error)
+			}); ok {
+				size, err := vtmsg.MarshalToSizedBufferVT(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarint(dAtA, i, uint64(size))
+			} else {
+				encoded, err := proto.Marshal(m.Enum[iNdEx])
+				if err != nil {
+					return 0, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = encodeVarint(dAtA, i, uint64(len(encoded)))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Types) > 0 {
+		var pksize10 int
+		for _, num := range m.Types {
+			pksize10 += sov(uint64(num))
+		}
+		i -= pksize10
+		j9 := i
+		for _, num1 := range m.Types {
+			num := uint64(num1)
+			for num >= 1<<7 {
+				dAtA[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA[j9] = uint8(num)
+			j9++
+		}
+		i = encodeVarint(dAtA, i, uint64(pksize10))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *PathPartial_Part) SizeVT() (n int) {
+func (m *Schema) MarshalVT() (dAtA []byte, err error) {
 	if m == nil {
-		return 0
+		return nil, nil
 	}
-	var l int
-	_ = l
-	l = len(m.Part)
-	n += 1 + l + sov(uint64(l))
-	return n
+	size := m.SizeVT()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBufferVT(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
 }
-func (m *PathPartial_Ptr) SizeVT() (n int) {
+
+func (m *Schema) MarshalToVT(dAtA []byte) (int, error) {
+	size := m.SizeVT()
+	return m.MarshalToSizedBufferVT(dAtA[:size])
+}
+
+func (m *Schema) MarshalToSizedBufferVT(dAtA []byte) (int, error) {
 	if m == nil {
-		return 0
+		return 0, nil
 	}
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Ptr)
-	n += 1 + l + sov(uint64(l))
-	return n
+	if m.unknownFields != nil {
+		i -= len(m.unknownFields)
+		copy(dAtA[i:], m.unknownFields)
+	}
+	return len(dAtA) - i, nil
 }
-func (m *Schema_JSON_AdditionalProperties) SizeVT() (n int) {
+
+func encodeVarint(dAtA []byte, offset int, v uint64) int {
+	offset -= sov(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Clt_Fuzz_Resetter_Shell) SizeVT() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if vtmsg, ok := m.AddProps.(interface{ SizeVT() int }); ok {
-		n += vtmsg.SizeVT()
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Rst)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Stop)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.StartFile)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.RstFile)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.StopFile)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Shell)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.StartTimeoutMs != 0 {
+		n += 1 + sov(uint64(m.StartTimeoutMs))
+	}
+	if m.RstTimeoutMs != 0 {
+		n += 1 + sov(uint64(m.RstTimeoutMs))
+	}
+	if m.StopTimeoutMs != 0 {
+		n += 1 + sov(uint64(m.StopTimeoutMs))
 	}
 	n += len(m.unknownFields)
 	return n
 }
 
-func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) SizeVT() (n int) {
+func (m *Clt_Fuzz_Resetter_HTTP_Request) SizeVT() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 2
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Expect) > 0 {
+		l = 0
+		for _, e := range m.Expect {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
 	return n
 }
-func (m *Schema_JSON_AdditionalProperties_SID) SizeVT() (n int) {
+
+func (m *Clt_Fuzz_Resetter_HTTP) SizeVT() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sov(uint64(m.SID))
+	if len(m.Start) > 0 {
+		for _, e := range m.Start {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Rst) > 0 {
+		for _, e := range m.Rst {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Stop) > 0 {
+		for _, e := range m.Stop {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
 	return n
 }
-func (m *Schema_JSON) SizeVT() (n int) {
+
+func (m *Clt_Fuzz_Resetter_Snapshot) SizeVT() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Types) > 0 {
-		l = 0
-		for _, e := range m.Types {
-			l += sov(uint64(e))
-		}
-		n += 1 + sov(uint64(l)) + l
-	}
-	if len(m.Enum) > 0 {
-		for _, e := range m.Enum {
-			if size, ok := interface{}(e).(interface {
-				SizeVT() int
-			}); ok {
-				l = size.SizeVT()
-			} else {
-				l = proto.Size(e)
-			}
+	if len(m.Paths) > 0 {
+		for _, s := range m.Paths {
+			l = len(s)
 			n += 1 + l + sov(uint64(l))
 		}
 	}
-	l = len(m.Format)
-	if l > 0 {
-		n += 1 + l + sov(uint64(l))
-	}
-	if m.MinLength != 0 {
-		n += 1 + sov(uint64(m.MinLength))
-	}
-	if m.MaxLength != 0 {
-		n += 1 + sov(uint64(m.MaxLength))
-	}
-	if m.HasMaxLength {
-		n += 2
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Probe_HTTP) SizeVT() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Pattern)
+	var l int
+	_ = l
+	l = len(m.Url)
 	if l > 0 {
 		n += 1 + l + sov(uint64(l))
 	}
-	if m.Minimum != 0 {
-		n += 9
-	}
-	if m.Maximum != 0 {
-		n += 9
+	if m.Status != 0 {
+		n += 1 + sov(uint64(m.Status))
 	}
-	if m.HasMinimum {
-		n += 2
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Probe) SizeVT() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.HasMaximum {
-		n += 2
+	var l int
+	_ = l
+	if vtmsg, ok := m.Probe.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
 	}
-	if m.TranslatedMultipleOf != 0 {
-		n += 9
+	if m.TimeoutMs != 0 {
+		n += 1 + sov(uint64(m.TimeoutMs))
 	}
-	if m.ExclusiveMinimum {
-		n += 2
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Probe_Tcp) SizeVT() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.ExclusiveMaximum {
-		n += 2
+	var l int
+	_ = l
+	l = len(m.Tcp)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Probe_Http) SizeVT() (n int) {
+	if m == nil {
+		return 0
 	}
-	if len(m.Items) > 0 {
-		l = 0
-		for _, e := range m.Items {
-			l += sov(uint64(e))
-		}
-		n += 1 + sov(uint64(l)) + l
+	var l int
+	_ = l
+	if m.Http != nil {
+		l = m.Http.SizeVT()
+		n += 1 + l + sov(uint64(l))
 	}
-	if m.UniqueItems {
-		n += 3
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Probe_LogLine) SizeVT() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.MinItems != 0 {
-		n += 2 + sov(uint64(m.MinItems))
+	var l int
+	_ = l
+	l = len(m.LogLine)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Probe_Predicate) SizeVT() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.MaxItems != 0 {
-		n += 2 + sov(uint64(m.MaxItems))
+	var l int
+	_ = l
+	l = len(m.Predicate)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Process) SizeVT() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.HasMaxItems {
-		n += 3
+	var l int
+	_ = l
+	if len(m.Command) > 0 {
+		for _, s := range m.Command {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
 	}
-	if len(m.Properties) > 0 {
-		for k, v := range m.Properties {
+	if len(m.Env) > 0 {
+		for k, v := range m.Env {
 			_ = k
 			_ = v
-			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
-			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
-		}
-	}
-	if len(m.Required) > 0 {
-		for _, s := range m.Required {
-			l = len(s)
-			n += 2 + l + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
 		}
 	}
-	if m.MinProperties != 0 {
-		n += 2 + sov(uint64(m.MinProperties))
-	}
-	if m.MaxProperties != 0 {
-		n += 2 + sov(uint64(m.MaxProperties))
+	if m.RestartOnReset {
+		n += 2
 	}
-	if m.HasMaxProperties {
-		n += 3
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Starlark) SizeVT() (n int) {
+	if m == nil {
+		return 0
 	}
-	if m.AdditionalProperties != nil {
-		l = m.AdditionalProperties.SizeVT()
-		n += 2 + l + sov(uint64(l))
+	var l int
+	_ = l
+	l = len(m.Start)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
 	}
-	if m.HasAdditionalProperties {
-		n += 3
+	l = len(m.Rst)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
 	}
-	if len(m.AllOf) > 0 {
-		l = 0
-		for _, e := range m.AllOf {
-			l += sov(uint64(e))
+	l = len(m.Stop)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Retry) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		n += 1 + sov(uint64(m.Retries))
+	}
+	if m.BackoffMs != 0 {
+		n += 1 + sov(uint64(m.BackoffMs))
+	}
+	if m.Restart {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Provides) > 0 {
+		for _, s := range m.Provides {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
-	if len(m.AnyOf) > 0 {
-		l = 0
-		for _, e := range m.AnyOf {
-			l += sov(uint64(e))
+	if vtmsg, ok := m.Resetter.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	if m.Ready != nil {
+		l = m.Ready.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.DependsOn) > 0 {
+		for _, s := range m.DependsOn {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
 		}
-		n += 2 + sov(uint64(l)) + l
 	}
-	if len(m.OneOf) > 0 {
-		l = 0
-		for _, e := range m.OneOf {
-			l += sov(uint64(e))
+	if m.Retry != nil {
+		l = m.Retry.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Resetter_Shell_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Shell != nil {
+		l = m.Shell.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Http) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Http != nil {
+		l = m.Http.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Snapshot_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Snapshot != nil {
+		l = m.Snapshot.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Process_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Process != nil {
+		l = m.Process.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Resetter_Starlark_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Starlark != nil {
+		l = m.Starlark.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz_Model_OpenAPIv3) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.File)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Host)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Model) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if vtmsg, ok := m.Model.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_Model_Openapiv3) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Openapiv3 != nil {
+		l = m.Openapiv3.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_Fuzz) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Resetters) > 0 {
+		for _, e := range m.Resetters {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Models) > 0 {
+		for _, e := range m.Models {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Usage) > 0 {
+		for _, s := range m.Usage {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Ntensity != 0 {
+		n += 1 + sov(uint64(m.Ntensity))
+	}
+	if len(m.EIDs) > 0 {
+		for k, v := range m.EIDs {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.EnvRead) > 0 {
+		for k, v := range m.EnvRead {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.UUIDs) > 0 {
+		for _, s := range m.UUIDs {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Files) > 0 {
+		for k, v := range m.Files {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + len(v) + sov(uint64(len(v)))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_ResetProgress_Output) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Resetter)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Lines) > 0 {
+		for _, s := range m.Lines {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.Truncated {
+		n += 2
+	}
+	l = len(m.Transcript)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_ResetProgress) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Status != 0 {
+		n += 1 + sov(uint64(m.Status))
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sov(uint64(m.ElapsedNs))
+	}
+	if len(m.Reason) > 0 {
+		for _, s := range m.Reason {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Retries) > 0 {
+		for k, v := range m.Retries {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for _, e := range m.Outputs {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.BodyDecoded != nil {
+		if size, ok := interface{}(m.BodyDecoded).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.BodyDecoded)
+		}
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Input.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallRequestRaw_Input_HttpRequest_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HttpRequest != nil {
+		l = m.HttpRequest.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallRequestRaw) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Reason) > 0 {
+		for _, s := range m.Reason {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DnsNs != 0 {
+		n += 1 + sov(uint64(m.DnsNs))
+	}
+	if m.ConnectNs != 0 {
+		n += 1 + sov(uint64(m.ConnectNs))
+	}
+	if m.TlsNs != 0 {
+		n += 1 + sov(uint64(m.TlsNs))
+	}
+	if m.TtfbNs != 0 {
+		n += 1 + sov(uint64(m.TtfbNs))
+	}
+	if m.TransferNs != 0 {
+		n += 1 + sov(uint64(m.TransferNs))
+	}
+	if m.ReusedConn {
+		n += 2
+	}
+	l = len(m.LocalAddr)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.RemoteAddr)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.StatusCode != 0 {
+		n += 1 + sov(uint64(m.StatusCode))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sov(uint64(m.ElapsedNs))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Event)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.DataDecoded != nil {
+		if size, ok := interface{}(m.DataDecoded).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.DataDecoded)
+		}
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sov(uint64(m.ElapsedNs))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.StatusCode != 0 {
+		n += 1 + sov(uint64(m.StatusCode))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	l = len(m.Body)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.BodyDecoded != nil {
+		if size, ok := interface{}(m.BodyDecoded).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.BodyDecoded)
+		}
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sov(uint64(m.ElapsedNs))
+	}
+	if m.Timings != nil {
+		l = m.Timings.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.Retries != 0 {
+		n += 1 + sov(uint64(m.Retries))
+	}
+	if len(m.Events) > 0 {
+		for _, e := range m.Events {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.EventsTruncated {
+		n += 2
+	}
+	if m.BodySize != 0 {
+		n += 1 + sov(uint64(m.BodySize))
+	}
+	l = len(m.BodySha256)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.BodyTruncated {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Output.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallResponseRaw_Output_HttpResponse_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HttpResponse != nil {
+		l = m.HttpResponse.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallResponseRaw) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Output != nil {
+		l = m.Output.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.OutputId != 0 {
+		n += 1 + sov(uint64(m.OutputId))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_CallVerifProgress) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sov(uint64(m.Status))
+	}
+	if m.Origin != 0 {
+		n += 1 + sov(uint64(m.Origin))
+	}
+	if len(m.Reason) > 0 {
+		for _, s := range m.Reason {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.ElapsedNs != 0 {
+		n += 1 + sov(uint64(m.ElapsedNs))
+	}
+	if m.ExecutionSteps != 0 {
+		n += 1 + sov(uint64(m.ExecutionSteps))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Msg.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Clt_Fuzz_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Fuzz != nil {
+		l = m.Fuzz.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_ResetProgress_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ResetProgress != nil {
+		l = m.ResetProgress.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallRequestRaw_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallRequestRaw != nil {
+		l = m.CallRequestRaw.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallResponseRaw_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallResponseRaw != nil {
+		l = m.CallResponseRaw.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Clt_CallVerifProgress_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallVerifProgress != nil {
+		l = m.CallVerifProgress.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Srv_FuzzingProgress) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Failure {
+		n += 2
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.LastCallSuccess {
+		n += 2
+	}
+	if m.LastCheckFailure {
+		n += 2
+	}
+	if m.LastCheckSuccess {
+		n += 2
+	}
+	if m.TotalTestsCount != 0 {
+		n += 1 + sov(uint64(m.TotalTestsCount))
+	}
+	if m.TotalCallsCount != 0 {
+		n += 1 + sov(uint64(m.TotalCallsCount))
+	}
+	if m.TotalChecksCount != 0 {
+		n += 1 + sov(uint64(m.TotalChecksCount))
+	}
+	if m.TestCallsCount != 0 {
+		n += 1 + sov(uint64(m.TestCallsCount))
+	}
+	if m.CallChecksCount != 0 {
+		n += 1 + sov(uint64(m.CallChecksCount))
+	}
+	if m.CallChecksSkipped != 0 {
+		n += 1 + sov(uint64(m.CallChecksSkipped))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv_FuzzRep) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MaxTestsCount != 0 {
+		n += 1 + sov(uint64(m.MaxTestsCount))
+	}
+	l = len(m.Seed)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Token)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.MaxExecutionStepsPerCheck != 0 {
+		n += 1 + sov(uint64(m.MaxExecutionStepsPerCheck))
+	}
+	if m.MaxExecutionMsPerCheck != 0 {
+		n += 1 + sov(uint64(m.MaxExecutionMsPerCheck))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv_Call_Input_HttpRequest) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Method)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	l = len(m.Url)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Headers) > 0 {
+		for _, e := range m.Headers {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.Body != nil {
+		if size, ok := interface{}(m.Body).(interface {
+			SizeVT() int
+		}); ok {
+			l = size.SizeVT()
+		} else {
+			l = proto.Size(m.Body)
+		}
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv_Call_Input) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Input.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv_Call_Input_HttpRequest_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HttpRequest != nil {
+		l = m.HttpRequest.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Srv_Call) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Input != nil {
+		l = m.Input.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.EID != 0 {
+		n += 1 + sov(uint64(m.EID))
+	}
+	l = len(m.ModelName)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv_Reset) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv_FuzzingResult_CounterexampleItem) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CallRequest != nil {
+		l = m.CallRequest.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.CallResponse != nil {
+		l = m.CallResponse.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv_FuzzingResult) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SeedUsed)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.WasShrinking {
+		n += 2
+	}
+	l = len(m.NextSeed)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.WillNowShrink {
+		n += 2
+	}
+	l = len(m.SuggestedSeed)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Counterexample) > 0 {
+		for _, e := range m.Counterexample {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FuzzingProgress != nil {
+		l = m.FuzzingProgress.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if vtmsg, ok := m.Msg.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Srv_FuzzRep_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FuzzRep != nil {
+		l = m.FuzzRep.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Srv_Call_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Call != nil {
+		l = m.Call.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Srv_Reset_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reset_ != nil {
+		l = m.Reset_.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Srv_FuzzingResult_) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.FuzzingResult != nil {
+		l = m.FuzzingResult.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *Uint32S) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Values) > 0 {
+		l = 0
+		for _, e := range m.Values {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *HeaderPair) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *SpecIR) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schemas != nil {
+		l = m.Schemas.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Endpoints) > 0 {
+		for k, v := range m.Endpoints {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Schemas) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Json) > 0 {
+		for k, v := range m.Json {
+			_ = k
+			_ = v
+			l = 0
+			if v != nil {
+				l = v.SizeVT()
+			}
+			l += 1 + sov(uint64(l))
+			mapEntrySize := 1 + sov(uint64(k)) + l
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RefOrSchemaJSON) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.PtrOrSchema.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *RefOrSchemaJSON_Ptr) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Ptr != nil {
+		l = m.Ptr.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *RefOrSchemaJSON_Schema) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schema != nil {
+		l = m.Schema.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *SchemaPtr) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SID != 0 {
+		n += 1 + sov(uint64(m.SID))
+	}
+	l = len(m.Ref)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Endpoint) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Endpoint.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Endpoint_Json) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Json != nil {
+		l = m.Json.SizeVT()
+		n += 1 + l + sov(uint64(l))
+	}
+	return n
+}
+func (m *EndpointJSON) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Method != 0 {
+		n += 1 + sov(uint64(m.Method))
+	}
+	if len(m.PathPartials) > 0 {
+		for _, e := range m.PathPartials {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Inputs) > 0 {
+		for _, e := range m.Inputs {
+			l = e.SizeVT()
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if len(m.Outputs) > 0 {
+		for k, v := range m.Outputs {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + sov(uint64(k)) + 1 + sov(uint64(v))
+			n += mapEntrySize + 1 + sov(uint64(mapEntrySize))
+		}
+	}
+	l = len(m.OperationId)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if len(m.Tags) > 0 {
+		for _, s := range m.Tags {
+			l = len(s)
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	if m.Deprecated {
+		n += 2
+	}
+	if m.Skip {
+		n += 2
+	}
+	if m.Weight != 0 {
+		n += 1 + sov(uint64(m.Weight))
+	}
+	if m.MaxLatencyMs != 0 {
+		n += 1 + sov(uint64(m.MaxLatencyMs))
+	}
+	if m.Idempotent {
+		n += 2
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *ParamJSON) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRequired {
+		n += 2
+	}
+	if m.SID != 0 {
+		n += 1 + sov(uint64(m.SID))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sov(uint64(m.Kind))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *PathPartial) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.Pp.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *PathPartial_Part) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Part)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *PathPartial_Ptr) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Ptr)
+	n += 1 + l + sov(uint64(l))
+	return n
+}
+func (m *Schema_JSON_AdditionalProperties) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if vtmsg, ok := m.AddProps.(interface{ SizeVT() int }); ok {
+		n += vtmsg.SizeVT()
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Schema_JSON_AdditionalProperties_AlwaysSucceed) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 2
+	return n
+}
+func (m *Schema_JSON_AdditionalProperties_SID) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sov(uint64(m.SID))
+	return n
+}
+func (m *Schema_JSON) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		l = 0
+		for _, e := range m.Types {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	if len(m.Enum) > 0 {
+		for _, e := range m.Enum {
+			if size, ok := interface{}(e).(interface {
+				SizeVT() int
+			}); ok {
+				l = size.SizeVT()
+			} else {
+				l = proto.Size(e)
+			}
+			n += 1 + l + sov(uint64(l))
+		}
+	}
+	l = len(m.Format)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.MinLength != 0 {
+		n += 1 + sov(uint64(m.MinLength))
+	}
+	if m.MaxLength != 0 {
+		n += 1 + sov(uint64(m.MaxLength))
+	}
+	if m.HasMaxLength {
+		n += 2
+	}
+	l = len(m.Pattern)
+	if l > 0 {
+		n += 1 + l + sov(uint64(l))
+	}
+	if m.Minimum != 0 {
+		n += 9
+	}
+	if m.Maximum != 0 {
+		n += 9
+	}
+	if m.HasMinimum {
+		n += 2
+	}
+	if m.HasMaximum {
+		n += 2
+	}
+	if m.TranslatedMultipleOf != 0 {
+		n += 9
+	}
+	if m.ExclusiveMinimum {
+		n += 2
+	}
+	if m.ExclusiveMaximum {
+		n += 2
+	}
+	if len(m.Items) > 0 {
+		l = 0
+		for _, e := range m.Items {
+			l += sov(uint64(e))
+		}
+		n += 1 + sov(uint64(l)) + l
+	}
+	if m.UniqueItems {
+		n += 3
+	}
+	if m.MinItems != 0 {
+		n += 2 + sov(uint64(m.MinItems))
+	}
+	if m.MaxItems != 0 {
+		n += 2 + sov(uint64(m.MaxItems))
+	}
+	if m.HasMaxItems {
+		n += 3
+	}
+	if len(m.Properties) > 0 {
+		for k, v := range m.Properties {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sov(uint64(len(k))) + 1 + sov(uint64(v))
+			n += mapEntrySize + 2 + sov(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Required) > 0 {
+		for _, s := range m.Required {
+			l = len(s)
+			n += 2 + l + sov(uint64(l))
+		}
+	}
+	if m.MinProperties != 0 {
+		n += 2 + sov(uint64(m.MinProperties))
+	}
+	if m.MaxProperties != 0 {
+		n += 2 + sov(uint64(m.MaxProperties))
+	}
+	if m.HasMaxProperties {
+		n += 3
+	}
+	if m.AdditionalProperties != nil {
+		l = m.AdditionalProperties.SizeVT()
+		n += 2 + l + sov(uint64(l))
+	}
+	if m.HasAdditionalProperties {
+		n += 3
+	}
+	if len(m.AllOf) > 0 {
+		l = 0
+		for _, e := range m.AllOf {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.AnyOf) > 0 {
+		l = 0
+		for _, e := range m.AnyOf {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if len(m.OneOf) > 0 {
+		l = 0
+		for _, e := range m.OneOf {
+			l += sov(uint64(e))
+		}
+		n += 2 + sov(uint64(l)) + l
+	}
+	if m.Not != 0 {
+		n += 2 + sov(uint64(m.Not))
+	}
+	n += len(m.unknownFields)
+	return n
+}
+
+func (m *Schema) SizeVT() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += len(m.unknownFields)
+	return n
+}
+
+func sov(x uint64) (n int) {
+	return (bits.Len64(x|1) + 6) / 7
+}
+func soz(x uint64) (n int) {
+	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Clt_Fuzz_Resetter_Shell) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Shell: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Shell: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rst = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stop = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RstFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RstFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopFile", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StopFile = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Shell = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimeoutMs", wireType)
+			}
+			m.StartTimeoutMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimeoutMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RstTimeoutMs", wireType)
+			}
+			m.RstTimeoutMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RstTimeoutMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StopTimeoutMs", wireType)
+			}
+			m.StopTimeoutMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StopTimeoutMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_HTTP_Request) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_HTTP_Request: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_HTTP_Request: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Method = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headers = append(m.Headers, &HeaderPair{})
+			if err := m.Headers[len(m.Headers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.Expect = append(m.Expect, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLength
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLength
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Expect) == 0 {
+					m.Expect = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Expect = append(m.Expect, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expect", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_HTTP) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_HTTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = append(m.Start, &Clt_Fuzz_Resetter_HTTP_Request{})
+			if err := m.Start[len(m.Start)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rst", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rst = append(m.Rst, &Clt_Fuzz_Resetter_HTTP_Request{})
+			if err := m.Rst[len(m.Rst)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stop = append(m.Stop, &Clt_Fuzz_Resetter_HTTP_Request{})
+			if err := m.Stop[len(m.Stop)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Snapshot) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Snapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Snapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paths", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Paths = append(m.Paths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Probe_HTTP) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Probe_HTTP: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Probe_HTTP: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Probe) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Probe: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Probe: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tcp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Probe = &Clt_Fuzz_Resetter_Probe_Tcp{Tcp: string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Probe.(*Clt_Fuzz_Resetter_Probe_Http); ok {
+				if err := oneof.Http.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Clt_Fuzz_Resetter_Probe_HTTP{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Probe = &Clt_Fuzz_Resetter_Probe_Http{Http: v}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogLine", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Probe = &Clt_Fuzz_Resetter_Probe_LogLine{LogLine: string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Probe = &Clt_Fuzz_Resetter_Probe_Predicate{Predicate: string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutMs", wireType)
+			}
+			m.TimeoutMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Process) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Process: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Process: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Command", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Command = append(m.Command, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Env", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Env == nil {
+				m.Env = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Env[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartOnReset", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RestartOnReset = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Starlark) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Starlark: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Starlark: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Start = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rst", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Rst = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stop = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter_Retry) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Retry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Retry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BackoffMs", wireType)
+			}
+			m.BackoffMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BackoffMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Restart", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Restart = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Resetter) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Resetter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provides", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provides = append(m.Provides, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Resetter.(*Clt_Fuzz_Resetter_Shell_); ok {
+				if err := oneof.Shell.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Clt_Fuzz_Resetter_Shell{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Resetter = &Clt_Fuzz_Resetter_Shell_{Shell: v}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Http", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Resetter.(*Clt_Fuzz_Resetter_Http); ok {
+				if err := oneof.Http.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Clt_Fuzz_Resetter_HTTP{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Resetter = &Clt_Fuzz_Resetter_Http{Http: v}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshot", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Resetter.(*Clt_Fuzz_Resetter_Snapshot_); ok {
+				if err := oneof.Snapshot.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Clt_Fuzz_Resetter_Snapshot{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Resetter = &Clt_Fuzz_Resetter_Snapshot_{Snapshot: v}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Process", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Resetter.(*Clt_Fuzz_Resetter_Process_); ok {
+				if err := oneof.Process.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Clt_Fuzz_Resetter_Process{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Resetter = &Clt_Fuzz_Resetter_Process_{Process: v}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ready", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Ready == nil {
+				m.Ready = &Clt_Fuzz_Resetter_Probe{}
+			}
+			if err := m.Ready.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Starlark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Resetter.(*Clt_Fuzz_Resetter_Starlark_); ok {
+				if err := oneof.Starlark.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Clt_Fuzz_Resetter_Starlark{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Resetter = &Clt_Fuzz_Resetter_Starlark_{Starlark: v}
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependsOn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependsOn = append(m.DependsOn, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Retry == nil {
+				m.Retry = &Clt_Fuzz_Resetter_Retry{}
+			}
+			if err := m.Retry.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model_OpenAPIv3) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Model_OpenAPIv3: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Model_OpenAPIv3: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Spec == nil {
+				m.Spec = &SpecIR{}
+			}
+			if err := m.Spec.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.File = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Host = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz_Model) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz_Model: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz_Model: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Openapiv3", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if oneof, ok := m.Model.(*Clt_Fuzz_Model_Openapiv3); ok {
+				if err := oneof.Openapiv3.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				v := &Clt_Fuzz_Model_OpenAPIv3{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+				m.Model = &Clt_Fuzz_Model_Openapiv3{Openapiv3: v}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_Fuzz: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_Fuzz: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resetters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resetters = append(m.Resetters, &Clt_Fuzz_Resetter{})
+			if err := m.Resetters[len(m.Resetters)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Models", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Models = append(m.Models, &Clt_Fuzz_Model{})
+			if err := m.Models[len(m.Models)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Usage = append(m.Usage, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
+			if m.Seed == nil {
+				m.Seed = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ntensity", wireType)
+			}
+			m.Ntensity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Ntensity |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EIDs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EIDs == nil {
+				m.EIDs = make(map[string]*Uint32S)
+			}
+			var mapkey string
+			var mapvalue *Uint32S
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLength
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLength
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &Uint32S{}
+					if err := mapvalue.UnmarshalVT(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EIDs[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnvRead", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EnvRead == nil {
+				m.EnvRead = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.EnvRead[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UUIDs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UUIDs = append(m.UUIDs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Files == nil {
+				m.Files = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Files[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_ResetProgress_Output) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_ResetProgress_Output: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_ResetProgress_Output: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resetter", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Resetter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Lines", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Lines = append(m.Lines, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Truncated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Truncated = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transcript", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transcript = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
 		}
-		n += 2 + sov(uint64(l)) + l
-	}
-	if m.Not != 0 {
-		n += 2 + sov(uint64(m.Not))
 	}
-	n += len(m.unknownFields)
-	return n
-}
 
-func (m *Schema) SizeVT() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	n += len(m.unknownFields)
-	return n
-}
-
-func sov(x uint64) (n int) {
-	return (bits.Len64(x|1) + 6) / 7
-}
-func soz(x uint64) (n int) {
-	return sov(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Clt_Fuzz_Resetter_Shell) UnmarshalVT(dAtA []byte) error {
+func (m *Clt_ResetProgress) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5996,15 +11642,53 @@ func (m *Clt_Fuzz_Resetter_Shell) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Shell: wiretype end group for non-group")
+			return fmt.Errorf("proto: Clt_ResetProgress: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_Fuzz_Resetter_Shell: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Clt_ResetProgress: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= Clt_ResetProgress_Status(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
+			}
+			m.ElapsedNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Start", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6032,13 +11716,13 @@ func (m *Clt_Fuzz_Resetter_Shell) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Start = string(dAtA[iNdEx:postIndex])
+			m.Reason = append(m.Reason, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Rst", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6048,29 +11732,110 @@ func (m *Clt_Fuzz_Resetter_Shell) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Rst = string(dAtA[iNdEx:postIndex])
+			if m.Retries == nil {
+				m.Retries = make(map[string]uint32)
+			}
+			var mapkey string
+			var mapvalue uint32
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLength
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLength
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflow
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapvalue |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skip(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLength
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Retries[mapkey] = mapvalue
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stop", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Outputs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6080,23 +11845,25 @@ func (m *Clt_Fuzz_Resetter_Shell) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stop = string(dAtA[iNdEx:postIndex])
+			m.Outputs = append(m.Outputs, &Clt_ResetProgress_Output{})
+			if err := m.Outputs[len(m.Outputs)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6120,7 +11887,7 @@ func (m *Clt_Fuzz_Resetter_Shell) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_Fuzz_Resetter) UnmarshalVT(dAtA []byte) error {
+func (m *Clt_CallRequestRaw_Input_HttpRequest) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6143,15 +11910,15 @@ func (m *Clt_Fuzz_Resetter) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_Fuzz_Resetter: wiretype end group for non-group")
+			return fmt.Errorf("proto: Clt_CallRequestRaw_Input_HttpRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_Fuzz_Resetter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Clt_CallRequestRaw_Input_HttpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6179,11 +11946,11 @@ func (m *Clt_Fuzz_Resetter) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Provides", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6211,11 +11978,11 @@ func (m *Clt_Fuzz_Resetter) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Provides = append(m.Provides, string(dAtA[iNdEx:postIndex]))
+			m.Url = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shell", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6242,16 +12009,87 @@ func (m *Clt_Fuzz_Resetter) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.Resetter.(*Clt_Fuzz_Resetter_Shell_); ok {
-				if err := oneof.Shell.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			m.Headers = append(m.Headers, &HeaderPair{})
+			if err := m.Headers[len(m.Headers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
+			if m.Body == nil {
+				m.Body = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BodyDecoded == nil {
+				m.BodyDecoded = &structpb.Value{}
+			}
+			if unmarshal, ok := interface{}(m.BodyDecoded).(interface {
+				UnmarshalVT([]byte) error
+			}); ok {
+				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 					return err
 				}
 			} else {
-				v := &Clt_Fuzz_Resetter_Shell{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.BodyDecoded); err != nil {
 					return err
 				}
-				m.Resetter = &Clt_Fuzz_Resetter_Shell_{Shell: v}
 			}
 			iNdEx = postIndex
 		default:
@@ -6276,7 +12114,7 @@ func (m *Clt_Fuzz_Resetter) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_Fuzz_Model_OpenAPIv3) UnmarshalVT(dAtA []byte) error {
+func (m *Clt_CallRequestRaw_Input) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6299,15 +12137,15 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_Fuzz_Model_OpenAPIv3: wiretype end group for non-group")
+			return fmt.Errorf("proto: Clt_CallRequestRaw_Input: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_Fuzz_Model_OpenAPIv3: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Clt_CallRequestRaw_Input: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HttpRequest", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6334,76 +12172,17 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &SpecIR{}
-			}
-			if err := m.Spec.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field File", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.File = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Host", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			if oneof, ok := m.Input.(*Clt_CallRequestRaw_Input_HttpRequest_); ok {
+				if err := oneof.HttpRequest.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+			} else {
+				v := &Clt_CallRequestRaw_Input_HttpRequest{}
+				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
 				}
+				m.Input = &Clt_CallRequestRaw_Input_HttpRequest_{HttpRequest: v}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Host = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6427,7 +12206,7 @@ func (m *Clt_Fuzz_Model_OpenAPIv3) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_Fuzz_Model) UnmarshalVT(dAtA []byte) error {
+func (m *Clt_CallRequestRaw) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6450,17 +12229,17 @@ func (m *Clt_Fuzz_Model) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_Fuzz_Model: wiretype end group for non-group")
+			return fmt.Errorf("proto: Clt_CallRequestRaw: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_Fuzz_Model: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Clt_CallRequestRaw: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6470,29 +12249,33 @@ func (m *Clt_Fuzz_Model) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Input == nil {
+				m.Input = &Clt_CallRequestRaw_Input{}
+			}
+			if err := m.Input.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Openapiv3", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6502,32 +12285,23 @@ func (m *Clt_Fuzz_Model) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if oneof, ok := m.Model.(*Clt_Fuzz_Model_Openapiv3); ok {
-				if err := oneof.Openapiv3.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := &Clt_Fuzz_Model_OpenAPIv3{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.Model = &Clt_Fuzz_Model_Openapiv3{Openapiv3: v}
-			}
+			m.Reason = append(m.Reason, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6551,7 +12325,7 @@ func (m *Clt_Fuzz_Model) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Timings) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6574,17 +12348,55 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_Fuzz: wiretype end group for non-group")
+			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse_Timings: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_Fuzz: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse_Timings: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Resetters", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DnsNs", wireType)
+			}
+			m.DnsNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DnsNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectNs", wireType)
+			}
+			m.ConnectNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConnectNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			var msglen int
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TlsNs", wireType)
+			}
+			m.TlsNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6594,31 +12406,16 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TlsNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Resetters = append(m.Resetters, &Clt_Fuzz_Resetter{})
-			if err := m.Resetters[len(m.Resetters)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Models", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TtfbNs", wireType)
 			}
-			var msglen int
+			m.TtfbNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6628,31 +12425,16 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.TtfbNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Models = append(m.Models, &Clt_Fuzz_Model{})
-			if err := m.Models[len(m.Models)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Usage", wireType)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransferNs", wireType)
 			}
-			var stringLen uint64
+			m.TransferNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6662,29 +12444,16 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TransferNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Usage = append(m.Usage, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Seed", wireType)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReusedConn", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6694,31 +12463,17 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Seed = append(m.Seed[:0], dAtA[iNdEx:postIndex]...)
-			if m.Seed == nil {
-				m.Seed = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ntensity", wireType)
+			m.ReusedConn = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LocalAddr", wireType)
 			}
-			m.Ntensity = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6728,16 +12483,29 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Ntensity |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 6:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LocalAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAddr", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6747,126 +12515,80 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EIDs == nil {
-				m.EIDs = make(map[string]*Uint32S)
+			m.RemoteAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skip(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue *Uint32S
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLength
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLength
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLength
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLength
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &Uint32S{}
-					if err := mapvalue.UnmarshalVT(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skip(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLength
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLength
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Redirect) UnmarshalVT(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflow
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			m.EIDs[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse_Redirect: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse_Redirect: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -6876,124 +12598,29 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLength
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLength
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLength
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLength
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skip(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLength
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.Labels[mapkey] = mapvalue
+			m.Method = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EnvRead", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7003,122 +12630,46 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EnvRead == nil {
-				m.EnvRead = make(map[string]string)
+			m.Url = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			m.StatusCode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLength
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLength
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLength
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLength
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skip(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLength
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StatusCode |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.EnvRead[mapkey] = mapvalue
-			iNdEx = postIndex
-		case 9:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UUIDs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7146,11 +12697,11 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UUIDs = append(m.UUIDs, string(dAtA[iNdEx:postIndex]))
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Files", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7177,104 +12728,30 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Files == nil {
-				m.Files = make(map[string]string)
+			m.Headers = append(m.Headers, &HeaderPair{})
+			if err := m.Headers[len(m.Headers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflow
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
+			}
+			m.ElapsedNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLength
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLength
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflow
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLength
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLength
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skip(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLength
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Files[mapkey] = mapvalue
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -7297,7 +12774,7 @@ func (m *Clt_Fuzz) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_ResetProgress) UnmarshalVT(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse_Event) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7320,17 +12797,17 @@ func (m *Clt_ResetProgress) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_ResetProgress: wiretype end group for non-group")
+			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse_Event: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_ResetProgress: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse_Event: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.Status = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7340,16 +12817,29 @@ func (m *Clt_ResetProgress) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Status |= Clt_ResetProgress_Status(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Event", wireType)
 			}
-			m.ElapsedNs = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7359,14 +12849,27 @@ func (m *Clt_ResetProgress) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElapsedNs |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Event = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7394,8 +12897,71 @@ func (m *Clt_ResetProgress) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = append(m.Reason, string(dAtA[iNdEx:postIndex]))
+			m.Data = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DataDecoded", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DataDecoded == nil {
+				m.DataDecoded = &structpb.Value{}
+			}
+			if unmarshal, ok := interface{}(m.DataDecoded).(interface {
+				UnmarshalVT([]byte) error
+			}); ok {
+				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+					return err
+				}
+			} else {
+				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.DataDecoded); err != nil {
+					return err
+				}
+			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
+			}
+			m.ElapsedNs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ElapsedNs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -7418,7 +12984,7 @@ func (m *Clt_ResetProgress) UnmarshalVT(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Clt_CallRequestRaw_Input_HttpRequest) UnmarshalVT(dAtA []byte) error {
+func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7441,17 +13007,49 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) UnmarshalVT(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_CallRequestRaw_Input_HttpRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_CallRequestRaw_Input_HttpRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Method", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
 			}
-			var stringLen uint64
+			m.StatusCode = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7461,27 +13059,14 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StatusCode |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Method = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Url", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7509,9 +13094,9 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Url = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
 			}
@@ -7545,7 +13130,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) UnmarshalVT(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
 			}
@@ -7579,7 +13164,7 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) UnmarshalVT(dAtA []byte) error {
 				m.Body = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
 			}
@@ -7623,62 +13208,11 @@ func (m *Clt_CallRequestRaw_Input_HttpRequest) UnmarshalVT(dAtA []byte) error {
 				}
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLength
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Clt_CallRequestRaw_Input) UnmarshalVT(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_CallRequestRaw_Input: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_CallRequestRaw_Input: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HttpRequest", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
 			}
-			var msglen int
+			m.ElapsedNs = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7688,87 +13222,14 @@ func (m *Clt_CallRequestRaw_Input) UnmarshalVT(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ElapsedNs |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if oneof, ok := m.Input.(*Clt_CallRequestRaw_Input_HttpRequest_); ok {
-				if err := oneof.HttpRequest.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				v := &Clt_CallRequestRaw_Input_HttpRequest{}
-				if err := v.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-				m.Input = &Clt_CallRequestRaw_Input_HttpRequest_{HttpRequest: v}
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLength
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Clt_CallRequestRaw) UnmarshalVT(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_CallRequestRaw: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_CallRequestRaw: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Input", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timings", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7795,101 +13256,18 @@ func (m *Clt_CallRequestRaw) UnmarshalVT(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Input == nil {
-				m.Input = &Clt_CallRequestRaw_Input{}
+			if m.Timings == nil {
+				m.Timings = &Clt_CallResponseRaw_Output_HttpResponse_Timings{}
 			}
-			if err := m.Input.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timings.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflow
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Reason = append(m.Reason, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skip(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLength
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.unknownFields = append(m.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflow
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Clt_CallResponseRaw_Output_HttpResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7899,29 +13277,31 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Error = string(dAtA[iNdEx:postIndex])
+			m.History = append(m.History, &Clt_CallResponseRaw_Output_HttpResponse_Redirect{})
+			if err := m.History[len(m.History)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StatusCode", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
 			}
-			m.StatusCode = 0
+			m.Retries = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7931,16 +13311,16 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.StatusCode |= uint32(b&0x7F) << shift
+				m.Retries |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Events", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7950,29 +13330,31 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
+			m.Events = append(m.Events, &Clt_CallResponseRaw_Output_HttpResponse_Event{})
+			if err := m.Events[len(m.Events)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Headers", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventsTruncated", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -7982,31 +13364,17 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Headers = append(m.Headers, &HeaderPair{})
-			if err := m.Headers[len(m.Headers)-1].UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Body", wireType)
+			m.EventsTruncated = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BodySize", wireType)
 			}
-			var byteLen int
+			m.BodySize = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8016,31 +13384,16 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.BodySize |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLength
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLength
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Body = append(m.Body[:0], dAtA[iNdEx:postIndex]...)
-			if m.Body == nil {
-				m.Body = []byte{}
-			}
-			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BodyDecoded", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BodySha256", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8050,41 +13403,29 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLength
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLength
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BodyDecoded == nil {
-				m.BodyDecoded = &structpb.Value{}
-			}
-			if unmarshal, ok := interface{}(m.BodyDecoded).(interface {
-				UnmarshalVT([]byte) error
-			}); ok {
-				if err := unmarshal.UnmarshalVT(dAtA[iNdEx:postIndex]); err != nil {
-					return err
-				}
-			} else {
-				if err := proto.Unmarshal(dAtA[iNdEx:postIndex], m.BodyDecoded); err != nil {
-					return err
-				}
-			}
+			m.BodySha256 = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 15:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ElapsedNs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BodyTruncated", wireType)
 			}
-			m.ElapsedNs = 0
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflow
@@ -8094,11 +13435,12 @@ func (m *Clt_CallResponseRaw_Output_HttpResponse) UnmarshalVT(dAtA []byte) error
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ElapsedNs |= int64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.BodyTruncated = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
@@ -11432,6 +16774,168 @@ func (m *EndpointJSON) UnmarshalVT(dAtA []byte) error {
 			}
 			m.Outputs[mapkey] = mapvalue
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperationId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperationId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tags", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLength
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLength
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tags = append(m.Tags, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deprecated", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Deprecated = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Skip", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Skip = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			m.Weight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Weight |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLatencyMs", wireType)
+			}
+			m.MaxLatencyMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLatencyMs |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Idempotent", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Idempotent = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skip(dAtA[iNdEx:])
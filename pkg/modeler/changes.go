@@ -0,0 +1,11 @@
+package modeler
+
+// SpecChange describes one difference between two versions of an API description
+type SpecChange struct {
+	// Breaking is set when clients of the older version may stop working
+	Breaking bool
+	// Where names the affected part of the API (e.g. an endpoint)
+	Where string
+	// What explains the change
+	What string
+}
@@ -1,5 +1,10 @@
 package modeler
 
+import (
+	"encoding/json"
+	"fmt"
+)
+
 // NoSuchRefError represents a schema path that is not found
 type NoSuchRefError struct {
 	ref string
@@ -15,3 +20,54 @@ func (e *NoSuchRefError) Error() string {
 func NewNoSuchRefError(ref string) *NoSuchRefError {
 	return &NoSuchRefError{ref}
 }
+
+// ValidationError describes where and why a value does not validate a schema
+type ValidationError struct {
+	// Pointer is the JSON pointer to the offending part of the value
+	Pointer string `json:"pointer"`
+	// Keyword is the schema keyword that failed (e.g. required, enum)
+	Keyword string `json:"keyword"`
+	// SchemaRef is the $ref of the closest named schema, if any
+	SchemaRef string `json:"schema_ref,omitempty"`
+	// SchemaPath is the JSON pointer to the failing keyword, from SchemaRef
+	SchemaPath string `json:"schema_path"`
+	// Value is the offending part of the value
+	Value interface{} `json:"value"`
+	// Description is a human readable explanation
+	Description string `json:"description"`
+}
+
+var _ error = (*ValidationError)(nil)
+
+func (e *ValidationError) Error() string {
+	return e.where() + ": " + e.Description
+}
+
+func (e *ValidationError) where() string {
+	if e.Pointer == "" {
+		return "(root)"
+	}
+	return e.Pointer
+}
+
+// SchemaLocation points to the failing keyword
+func (e *ValidationError) SchemaLocation() string {
+	return e.SchemaRef + e.SchemaPath
+}
+
+// Lines renders the error on a few lines: first is the summary
+func (e *ValidationError) Lines() []string {
+	value, err := json.Marshal(e.Value)
+	if err != nil {
+		value = []byte(fmt.Sprintf("%v", e.Value))
+	}
+	const maxValueLen = 120
+	if len(value) > maxValueLen {
+		value = append(value[:maxValueLen:maxValueLen], []byte("…")...)
+	}
+	return []string{
+		e.Error(),
+		fmt.Sprintf("  keyword %q at %s", e.Keyword, e.SchemaLocation()),
+		fmt.Sprintf("  value: %s", value),
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"io"
+	"math/rand"
 
 	"go.starlark.net/starlark"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -13,7 +14,7 @@ import (
 )
 
 var (
-	ErrUnparsablePayload = errors.New("unparsable piped payload")
+	ErrUnparsablePayload = errors.New("unparsable payload")
 	ErrNoSuchSchema      = errors.New("no such schema")
 )
 
@@ -41,9 +42,20 @@ type Interface interface { // TODO models.Modeler
 	// FilterEndpoints restricts which API endpoints are considered
 	FilterEndpoints(criteria []string) ([]uint32, error)
 
-	ValidateAgainstSchema(ref string, data []byte) error
-	Validate(uint32, *structpb.Value) []string
+	// ValidateAgainstSchema validates JSON data against the schema at ref
+	ValidateAgainstSchema(ref string, data []byte) ([]*ValidationError, error)
+	// ExpandRef completes a schema name (e.g. Pet) into an absolute $ref
+	ExpandRef(name string) string
+	// Validate checks a value against the schema with the given SID
+	Validate(uint32, *structpb.Value) []*ValidationError
+	// GenerateFromSchema produces a random value that validates against ref
+	GenerateFromSchema(ref string, rnd *rand.Rand) (interface{}, error)
+
+	// DiffAgainst lists changes made since the given older spec file
+	DiffAgainst(ctx context.Context, oldFile string) ([]SpecChange, error)
 
 	// NewCaller is called before making each call
 	NewCaller(ctx context.Context, call *fm.Srv_Call, shower progresser.Shower) Caller
+	// ResetSession forgets what calls of a test share (e.g. cookies)
+	ResetSession()
 }
@@ -2,6 +2,8 @@ package openapiv3
 
 import (
 	"fmt"
+	"strings"
+	"time"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 )
@@ -15,6 +17,7 @@ func (m *oa3) callerChecks() []namedLambda {
 	return []namedLambda{
 		{"connection to server", m.checkConn},
 		{"code < 500", m.checkNot5XX},
+		{"latency", m.checkMaxLatency},
 		//TODO: when decoupling modeler/caller move these to modeler
 		{"HTTP code", m.checkHTTPCode},
 		//TODO: check media type matches spec here (Content-Type: application/json) https://pkg.go.dev/net/http#DetectContentType https://github.com/gabriel-vasile/mimetype
@@ -42,6 +45,21 @@ func (m *oa3) checkNot5XX() (s, skipped string, f []string) {
 	return
 }
 
+func (m *oa3) checkMaxLatency() (s, skipped string, f []string) {
+	maxLatencyMs := m.tcap.endpoint.GetMaxLatencyMs()
+	if maxLatencyMs == 0 {
+		skipped = "no " + extMaxLatencyMs + " for this endpoint"
+		return
+	}
+	elapsed := time.Duration(m.tcap.repProto.ElapsedNs)
+	if max := time.Duration(maxLatencyMs) * time.Millisecond; elapsed > max {
+		f = append(f, fmt.Sprintf("response took %s, more than %s", elapsed, max))
+		return
+	}
+	s = "response came in time"
+	return
+}
+
 func (m *oa3) checkHTTPCode() (s, skipped string, f []string) {
 	if m.tcap.matchedHTTPCode {
 		s = "HTTP code checked"
@@ -53,10 +71,18 @@ func (m *oa3) checkHTTPCode() (s, skipped string, f []string) {
 }
 
 func (m *oa3) checkValidJSONResponse() (s, skipped string, f []string) {
+	if m.tcap.repStreamed {
+		return m.checkValidJSONEvents()
+	}
+
 	if len(m.tcap.repProto.Body) == 0 {
 		skipped = "response body is empty"
 		return
 	}
+	if m.tcap.repProto.BodyTruncated {
+		skipped = "response body is larger than max_body_bytes"
+		return
+	}
 
 	if m.tcap.repBodyDecodeErr != nil {
 		f = append(f, m.tcap.repBodyDecodeErr.Error())
@@ -72,14 +98,76 @@ func (m *oa3) checkValidatesJSONSchema() (s, skipped string, f []string) {
 		skipped = "no JSON Schema specified for response"
 		return
 	}
+	if m.tcap.repStreamed {
+		return m.checkEventsValidateJSONSchema()
+	}
 	if len(m.tcap.repProto.Body) == 0 {
 		skipped = "response body is empty"
 		return
 	}
+	if m.tcap.repProto.BodyTruncated {
+		skipped = "response body is larger than max_body_bytes"
+		return
+	}
 	if errs := m.vald.Validate(m.tcap.matchedSID, m.tcap.repProto.BodyDecoded); len(errs) != 0 {
-		f = errs
+		f = append(f, fmt.Sprintf("response does not validate JSON Schema (%d errors)", len(errs)))
+		for _, e := range errs {
+			f = append(f, strings.Join(e.Lines(), "\n"))
+		}
 		return
 	}
 	s = "response validates JSON Schema"
 	return
 }
+
+func (m *oa3) checkValidJSONEvents() (s, skipped string, f []string) {
+	events := m.tcap.repProto.GetEvents()
+	if len(events) == 0 {
+		skipped = "no events were received"
+		return
+	}
+
+	for i, ev := range events {
+		if ev.GetDataDecoded() == nil {
+			f = append(f, fmt.Sprintf("event #%d is not valid JSON: %q", i, ev.GetData()))
+		}
+	}
+	if len(f) != 0 {
+		return
+	}
+
+	s = fmt.Sprintf("all %d events are valid JSON", len(events))
+	return
+}
+
+func (m *oa3) checkEventsValidateJSONSchema() (s, skipped string, f []string) {
+	events := m.tcap.repProto.GetEvents()
+	if len(events) == 0 {
+		skipped = "no events were received"
+		return
+	}
+
+	validated := 0
+	for i, ev := range events {
+		if ev.GetDataDecoded() == nil {
+			continue
+		}
+		validated++
+		if errs := m.vald.Validate(m.tcap.matchedSID, ev.GetDataDecoded()); len(errs) != 0 {
+			f = append(f, fmt.Sprintf("event #%d does not validate JSON Schema (%d errors)", i, len(errs)))
+			for _, e := range errs {
+				f = append(f, strings.Join(e.Lines(), "\n"))
+			}
+		}
+	}
+	if len(f) != 0 {
+		return
+	}
+	if validated == 0 {
+		skipped = "no event is valid JSON"
+		return
+	}
+
+	s = fmt.Sprintf("%d events validate JSON Schema", validated)
+	return
+}
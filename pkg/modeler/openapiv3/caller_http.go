@@ -3,12 +3,12 @@ package openapiv3
 import (
 	"bytes"
 	"context"
-	"crypto/tls"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
-	"net"
 	"net/http"
+	"net/http/httptrace"
 	"net/http/httputil"
 	"net/url"
 	"os"
@@ -29,6 +29,7 @@ import (
 var (
 	headerAuthorization    = http.CanonicalHeaderKey("Authorization")
 	headerContentLength    = http.CanonicalHeaderKey("Content-Length")
+	headerContentType      = http.CanonicalHeaderKey("Content-Type")
 	headerHost             = http.CanonicalHeaderKey("Host")
 	headerTransferEncoding = http.CanonicalHeaderKey("Transfer-Encoding")
 	headerUserAgent        = http.CanonicalHeaderKey("User-Agent")
@@ -41,6 +42,15 @@ var (
 
 type tCapHTTP struct {
 	shower              progresser.Shower
+	transport           http.RoundTripper
+	timeout             time.Duration
+	closeIdleConns      bool
+	maxRedirects        int
+	jar                 http.CookieJar
+	pacing              *pacing
+	retries             int
+	stream              streamLimits
+	maxBodyBytes        int64
 	buildHTTPRequestErr error
 	doErr               error
 
@@ -52,10 +62,13 @@ type tCapHTTP struct {
 	checks []namedLambda
 
 	httpReq          *http.Request
+	hopReq           *http.Request
+	history          []*fm.Clt_CallResponseRaw_Output_HttpResponse_Redirect
 	repProto         *fm.Clt_CallResponseRaw_Output_HttpResponse
 	repBodyDecodeErr error
+	repStreamed      bool
 
-	// TODO: pick from these and timings
+	// TODO: pick from these
 	// %{content_type} shows the Content-Type of the requested document, if there was any.
 	// %{filename_effective} shows the ultimate filename that curl writes out to. This is only meaningful if curl is told to write to a file with the --remote-name or --output option. It's most useful in combination with the --remote-header-name option.
 	// %{ftp_entry_path} shows the initial path curl ended up in when logging on to the remote FTP server.
@@ -88,8 +101,17 @@ type tCapHTTP struct {
 // NewCaller creates a single-use modeler.Caller from a modeler.Interface instance.
 func (m *oa3) NewCaller(ctx context.Context, msg *fm.Srv_Call, shower progresser.Shower) modeler.Caller {
 	m.tcap = &tCapHTTP{
-		shower:   shower,
-		endpoint: m.vald.Spec.Endpoints[msg.GetEID()].GetJson(),
+		shower:    shower,
+		transport: m.roundTripper,
+		timeout:   m.transport.timeout,
+		// NOTE: HTTP/2 transports do not honor DisableKeepAlives
+		closeIdleConns: m.transport.disableKeepAlives,
+		maxRedirects:   m.maxRedirects,
+		jar:            m.jar,
+		pacing:         m.pacing,
+		stream:         m.stream,
+		maxBodyBytes:   m.maxBodyBytes,
+		endpoint:       m.vald.Spec.Endpoints[msg.GetEID()].GetJson(),
 	}
 	m.tcap.httpReq, m.tcap.buildHTTPRequestErr = m.buildHTTPRequest(ctx, msg)
 	m.tcap.checks = m.callerChecks()
@@ -125,6 +147,11 @@ func (m *oa3) buildHTTPRequest(ctx context.Context, msg *fm.Srv_Call) (req *http
 	r.Header.Set(headerUserAgent, ctx.Value(ctxvalues.XUserAgent).(string))
 
 	if host := m.pb.Host; host != "" {
+		if host, err = expandHost(ctx, host); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
 		var configured *url.URL
 		if configured, err = url.ParseRequestURI(host); err != nil {
 			log.Println("[ERR]", err)
@@ -141,6 +168,27 @@ func (m *oa3) buildHTTPRequest(ctx context.Context, msg *fm.Srv_Call) (req *http
 	return
 }
 
+// expandHost replaces ${KEY}s in host with values exported by resetters
+func expandHost(ctx context.Context, host string) (expanded string, err error) {
+	if !strings.Contains(host, "$") {
+		expanded = host
+		return
+	}
+
+	var env map[string]string
+	if exported, ok := ctx.Value(ctxvalues.XResetterEnv).(func() map[string]string); ok {
+		env = exported()
+	}
+	expanded = os.Expand(host, func(key string) string {
+		value, ok := env[key]
+		if !ok && err == nil {
+			err = fmt.Errorf("host %q references %s but no resetter exported it", host, key)
+		}
+		return value
+	})
+	return
+}
+
 // RequestProto returns call input as used by the client
 func (c *tCapHTTP) RequestProto() (i *fm.Clt_CallRequestRaw) {
 	i = &fm.Clt_CallRequestRaw{}
@@ -234,7 +282,16 @@ func (c *tCapHTTP) Do(ctx context.Context) {
 
 	var rep []byte
 	var r *http.Response
-	if r, c.doErr = (&http.Client{Transport: c}).Do(c.httpReq); c.doErr != nil {
+	client := &http.Client{
+		Transport:     c,
+		CheckRedirect: c.checkRedirect,
+		Jar:           c.jar,
+		Timeout:       c.timeout,
+	}
+	if c.closeIdleConns {
+		defer c.closeIdleConnections()
+	}
+	if r, c.doErr = client.Do(c.httpReq); c.doErr != nil {
 		rep = []byte(fmt.Sprintf("HTTP error: %s", c.doErr.Error()))
 	} else {
 		r.Body.Close()
@@ -250,36 +307,98 @@ func (c *tCapHTTP) Do(ctx context.Context) {
 	return
 }
 
+// checkRedirect follows at most maxRedirects redirects then
+// hands back the last response received.
+func (c *tCapHTTP) checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) > c.maxRedirects {
+		return http.ErrUseLastResponse
+	}
+	return nil
+}
+
+func (c *tCapHTTP) closeIdleConnections() {
+	if t, ok := c.transport.(interface{ CloseIdleConnections() }); ok {
+		t.CloseIdleConnections()
+	}
+}
+
 func (c *tCapHTTP) RoundTrip(req *http.Request) (rep *http.Response, err error) {
-	// TODO: stricter/smaller timeouts https://pkg.go.dev/github.com/asecurityteam/transport#Option
-	t := http.DefaultTransport.(*http.Transport).Clone()
-	t.Proxy = func(req *http.Request) (*url.URL, error) {
-		// TODO: snap the envs that ProxyFromEnvironment reads
-		log.Println("[NFO] HTTP proxying is work in progress...")
-		return nil, nil
+	if prev := c.repProto; prev != nil && prev.Error == "" {
+		// Following a redirect
+		c.history = append(c.history, &fm.Clt_CallResponseRaw_Output_HttpResponse_Redirect{
+			Method:     c.hopReq.Method,
+			Url:        c.hopReq.URL.String(),
+			StatusCode: prev.StatusCode,
+			Reason:     prev.Reason,
+			Headers:    prev.Headers,
+			ElapsedNs:  prev.ElapsedNs,
+		})
 	}
-	t.DialContext = (&net.Dialer{
-		Timeout:   30 * time.Second,
-		KeepAlive: 30 * time.Second,
-		DualStack: true,
-	}).DialContext
-	// t.ForceAttemptHTTP2 = true
-	t.MaxIdleConns = 100
-	t.IdleConnTimeout = 90 * time.Second
-	t.TLSHandshakeTimeout = 10 * time.Second
-	t.ExpectContinueTimeout = 1 * time.Second
-	t.TLSClientConfig = &tls.Config{InsecureSkipVerify: os.Getenv("FUZZYMONKEY_SSL_NO_VERIFY") == "1"}
-
-	start := time.Now()
-	rep, err = t.RoundTrip(req)
+	c.hopReq = req
+
+	for {
+		if rep, err = c.roundTripOnce(req); err != nil {
+			return
+		}
+
+		delay, retry := c.pacing.retryDelay(rep, c.retries, c.endpoint.GetIdempotent())
+		if !retry {
+			return
+		}
+		c.retries++
+		log.Printf("[NFO] got %d: retry #%d in %s", rep.StatusCode, c.retries, delay)
+
+		if req, err = rewound(req); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if err = sleep(req.Context(), delay); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+}
+
+// rewound copies a request so it can be sent again
+func rewound(req *http.Request) (*http.Request, error) {
+	r := req.Clone(req.Context())
+	if req.Body != nil && req.Body != http.NoBody {
+		if req.GetBody == nil {
+			return nil, errors.New("request body cannot be sent again")
+		}
+		body, err := req.GetBody()
+		if err != nil {
+			return nil, err
+		}
+		r.Body = body
+	}
+	return r, nil
+}
+
+func (c *tCapHTTP) roundTripOnce(req *http.Request) (rep *http.Response, err error) {
+	release, err := c.pacing.acquire(req.Context())
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	defer release()
+
+	timings := newHTTPTimings()
+	req = req.WithContext(httptrace.WithClientTrace(req.Context(), timings.clientTrace()))
+
+	rep, err = c.transport.RoundTrip(req)
 	c.repProto = &fm.Clt_CallResponseRaw_Output_HttpResponse{
-		ElapsedNs: time.Since(start).Nanoseconds(),
+		ElapsedNs: time.Since(timings.start).Nanoseconds(),
+		History:   c.history,
+		Retries:   uint32(c.retries),
 	}
 	if err != nil {
 		c.repProto.Error = err.Error()
+		c.repProto.Timings = timings.toProto()
 		return
 	}
-	err = c.responseToProto(rep)
+	err = c.responseToProto(rep, timings.start)
+	c.repProto.Timings = timings.toProto()
 	return
 }
 
@@ -293,7 +412,13 @@ func (c *tCapHTTP) ResponseProto() *fm.Clt_CallResponseRaw {
 			}}}
 }
 
-func (c *tCapHTTP) responseToProto(r *http.Response) (err error) {
+func (c *tCapHTTP) responseToProto(r *http.Response, start time.Time) (err error) {
+	// Forget about previous hops
+	c.repBodyDecodeErr = nil
+	c.repStreamed = false
+	c.matchedOutputID = 0
+	c.matchedHTTPCode = false
+
 	c.repProto.StatusCode = uint32(r.StatusCode)
 	c.repProto.Reason = r.Status
 
@@ -321,8 +446,24 @@ func (c *tCapHTTP) responseToProto(r *http.Response) (err error) {
 		})
 	}
 
-	if r.Body != nil {
-		if c.repProto.Body, err = ioutil.ReadAll(r.Body); err != nil {
+	if r.Body != nil && isStreamMediaType(r.Header.Get(headerContentType)) {
+		c.repStreamed = true
+		c.repProto.Body, c.repProto.Events, c.repProto.EventsTruncated, c.repProto.BodyTruncated, err =
+			c.stream.readEvents(r, start, c.maxBodyBytes)
+		if err != nil {
+			return
+		}
+		// NOTE: a truncated stream's body may already be closed
+		if e := r.Body.Close(); e != nil && !c.repProto.EventsTruncated {
+			err = e
+			log.Println("[ERR]", err)
+			return
+		}
+		c.repProto.BodySize = int64(len(c.repProto.Body))
+		c.repProto.BodySha256 = digestOf(c.repProto.Body)
+		r.Body = ioutil.NopCloser(bytes.NewReader(c.repProto.Body))
+	} else if r.Body != nil {
+		if c.repProto.Body, c.repProto.BodySize, c.repProto.BodySha256, err = readCappedBody(r.Body, c.maxBodyBytes); err != nil {
 			log.Println("[ERR]", err)
 			return
 		}
@@ -333,7 +474,10 @@ func (c *tCapHTTP) responseToProto(r *http.Response) (err error) {
 		r.Body = ioutil.NopCloser(bytes.NewReader(c.repProto.Body))
 
 		var x structpb.Value
-		if e := protojson.Unmarshal(c.repProto.Body, &x); e != nil {
+		if c.repProto.BodySize > int64(len(c.repProto.Body)) {
+			log.Printf("[NFO] kept %dB of a %dB response body", len(c.repProto.Body), c.repProto.BodySize)
+			c.repProto.BodyTruncated = true
+		} else if e := protojson.Unmarshal(c.repProto.Body, &x); e != nil {
 			log.Println("[NFO] response body could not be decoded:", e)
 			c.repBodyDecodeErr = e
 		} else {
@@ -357,11 +501,6 @@ func (c *tCapHTTP) responseToProto(r *http.Response) (err error) {
 		c.matchedHTTPCode = true
 	}()
 
-	// TODO? redirects with Response *Response
-	// Response is the redirect response which caused this request
-	// to be created. This field is only populated during client
-	// redirects.
-
 	// TODO? TLS *tls.ConnectionState
 	// TLS contains information about the TLS connection on which the
 	// response was received. It is nil for unencrypted responses.
@@ -0,0 +1,46 @@
+package openapiv3
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+)
+
+// readCappedBody reads r whole but keeps at most max bytes of it,
+// or all of it when max is zero.
+func readCappedBody(r io.Reader, max int64) (body []byte, size int64, digest string, err error) {
+	h := sha256.New()
+	kept := &capWriter{max: max}
+	if size, err = io.Copy(io.MultiWriter(h, kept), r); err != nil {
+		return
+	}
+	body = kept.buf.Bytes()
+	digest = hex.EncodeToString(h.Sum(nil))
+	return
+}
+
+func digestOf(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+// capWriter discards what is written past max bytes
+type capWriter struct {
+	buf bytes.Buffer
+	max int64
+}
+
+func (w *capWriter) Write(p []byte) (int, error) {
+	keep := p
+	if w.max != 0 {
+		if room := w.max - int64(w.buf.Len()); room < int64(len(p)) {
+			if room < 0 {
+				room = 0
+			}
+			keep = p[:room]
+		}
+	}
+	w.buf.Write(keep)
+	return len(p), nil
+}
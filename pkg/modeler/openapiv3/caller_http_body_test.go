@@ -0,0 +1,64 @@
+package openapiv3
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadCappedBody(t *testing.T) {
+	data := bytes.Repeat([]byte{0x00, 0xff, 0x42}, 1000)
+	sum := sha256.Sum256(data)
+	digest := hex.EncodeToString(sum[:])
+
+	for max, kept := range map[int64]int{
+		0:    len(data),
+		10:   10,
+		3000: len(data),
+		5000: len(data),
+	} {
+		body, size, d, err := readCappedBody(bytes.NewReader(data), max)
+		require.NoError(t, err)
+		require.Equal(t, data[:kept], body, max)
+		require.EqualValues(t, len(data), size)
+		require.Equal(t, digest, d)
+		require.Equal(t, digest, digestOf(data))
+	}
+}
+
+func TestCallerCapsLargeBodies(t *testing.T) {
+	png := append([]byte("\x89PNG\r\n\x1a\n"), bytes.Repeat([]byte{0xde, 0xad}, 4096)...)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerContentType, "image/png")
+		w.Write(png)
+	}))
+	defer srv.Close()
+
+	m := &oa3{maxBodyBytes: 1024}
+	var err error
+	m.roundTripper, err = m.transport.newTransport()
+	require.NoError(t, err)
+
+	rep := m.doFakeCall(t, srv.URL)
+	require.EqualValues(t, http.StatusOK, rep.GetStatusCode())
+	require.True(t, rep.GetBodyTruncated())
+	require.Equal(t, png[:1024], rep.GetBody())
+	require.EqualValues(t, len(png), rep.GetBodySize())
+	require.Equal(t, digestOf(png), rep.GetBodySha256())
+	require.Nil(t, rep.GetBodyDecoded())
+
+	_, skipped, f := m.checkValidJSONResponse()
+	require.Equal(t, "response body is larger than max_body_bytes", skipped)
+	require.Empty(t, f)
+
+	m.maxBodyBytes = 0
+	rep = m.doFakeCall(t, srv.URL)
+	require.False(t, rep.GetBodyTruncated())
+	require.Equal(t, png, rep.GetBody())
+	require.EqualValues(t, len(png), rep.GetBodySize())
+}
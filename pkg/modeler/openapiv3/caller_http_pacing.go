@@ -0,0 +1,130 @@
+package openapiv3
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// pacing is shared by all calls to the same SUT
+type pacing struct {
+	// interval between two requests being sent, zero when not rate limited
+	interval time.Duration
+	mu       sync.Mutex
+	next     time.Time
+
+	// inFlight holds a token per request being sent, nil when unbounded
+	inFlight chan struct{}
+
+	maxRetries   int
+	maxRetryWait time.Duration
+}
+
+func newPacing(rateLimit float64, maxInFlight, maxRetries int, maxRetryWait time.Duration) *pacing {
+	p := &pacing{
+		maxRetries:   maxRetries,
+		maxRetryWait: maxRetryWait,
+	}
+	if rateLimit > 0 {
+		p.interval = time.Duration(float64(time.Second) / rateLimit)
+	}
+	if maxInFlight > 0 {
+		p.inFlight = make(chan struct{}, maxInFlight)
+	}
+	return p
+}
+
+// acquire blocks until a request may be sent.
+// The returned func must be called once the response was read.
+func (p *pacing) acquire(ctx context.Context) (release func(), err error) {
+	release = func() {}
+	if p == nil {
+		return
+	}
+	if p.inFlight != nil {
+		select {
+		case p.inFlight <- struct{}{}:
+			release = func() { <-p.inFlight }
+		case <-ctx.Done():
+			err = ctx.Err()
+			return
+		}
+	}
+
+	if p.interval != 0 {
+		p.mu.Lock()
+		now := time.Now()
+		at := p.next
+		if at.Before(now) {
+			at = now
+		}
+		p.next = at.Add(p.interval)
+		p.mu.Unlock()
+
+		if err = sleep(ctx, at.Sub(now)); err != nil {
+			release()
+			release = func() {}
+		}
+	}
+	return
+}
+
+// retryDelay returns how long to wait before sending the request again,
+// or false if it should not be retried.
+// A 503 may come after the request was processed so only idempotent
+// requests are retried then.
+func (p *pacing) retryDelay(rep *http.Response, retries int, idempotent bool) (time.Duration, bool) {
+	if p == nil || retries >= p.maxRetries {
+		return 0, false
+	}
+	switch rep.StatusCode {
+	case http.StatusTooManyRequests:
+	case http.StatusServiceUnavailable:
+		if !idempotent {
+			return 0, false
+		}
+	default:
+		return 0, false
+	}
+	delay, ok := parseRetryAfter(rep.Header.Get("Retry-After"), time.Now())
+	if !ok || delay > p.maxRetryWait {
+		return 0, false
+	}
+	return delay, true
+}
+
+// parseRetryAfter reads either delay-seconds or an HTTP-date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	value = strings.TrimSpace(value)
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.ParseUint(value, 10, 32); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	at, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+	if delay := at.Sub(now); delay > 0 {
+		return delay, true
+	}
+	return 0, true
+}
+
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,176 @@
+package openapiv3
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2026, 10, 19, 12, 0, 0, 0, time.UTC)
+	for value, expected := range map[string]time.Duration{
+		"0":                             0,
+		" 120 ":                         2 * time.Minute,
+		"Mon, 19 Oct 2026 12:00:30 GMT": 30 * time.Second,
+		"Mon, 19 Oct 2026 11:00:00 GMT": 0,
+	} {
+		delay, ok := parseRetryAfter(value, now)
+		require.True(t, ok, value)
+		require.Equal(t, expected, delay, value)
+	}
+	for _, value := range []string{"", "-1", "soon", "1.5"} {
+		_, ok := parseRetryAfter(value, now)
+		require.False(t, ok, value)
+	}
+}
+
+func newThrottlingServer(throttled int, retryAfter string) (*httptest.Server, *int32) {
+	var count int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if body, err := io.ReadAll(r.Body); err != nil || string(body) != `{"a":1}` {
+			// Retried requests must be sent whole again
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if n := atomic.AddInt32(&count, 1); int(n) <= throttled {
+			if retryAfter != "" {
+				w.Header().Set("Retry-After", retryAfter)
+			}
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	return srv, &count
+}
+
+func (m *oa3) doFakePost(t *testing.T, url string) *fm.Clt_CallResponseRaw_Output_HttpResponse {
+	req, err := http.NewRequest(http.MethodPost, url, strings.NewReader(`{"a":1}`))
+	require.NoError(t, err)
+	return m.doFakeRequest(t, req)
+}
+
+func TestCallerRetriesAfterThrottling(t *testing.T) {
+	srv, count := newThrottlingServer(2, "0")
+	defer srv.Close()
+
+	m := &oa3{pacing: newPacing(0, 0, 0, time.Minute)}
+	var err error
+	m.roundTripper, err = m.transport.newTransport()
+	require.NoError(t, err)
+
+	rep := m.doFakePost(t, srv.URL)
+	require.EqualValues(t, http.StatusTooManyRequests, rep.GetStatusCode())
+	require.Zero(t, rep.GetRetries())
+	require.EqualValues(t, 1, atomic.LoadInt32(count))
+
+	atomic.StoreInt32(count, 0)
+	m.pacing = newPacing(0, 0, 1, time.Minute)
+	rep = m.doFakePost(t, srv.URL)
+	require.EqualValues(t, http.StatusTooManyRequests, rep.GetStatusCode())
+	require.EqualValues(t, 1, rep.GetRetries())
+
+	atomic.StoreInt32(count, 0)
+	m.pacing = newPacing(0, 0, 5, time.Minute)
+	rep = m.doFakePost(t, srv.URL)
+	require.EqualValues(t, http.StatusOK, rep.GetStatusCode())
+	require.EqualValues(t, 2, rep.GetRetries())
+	require.EqualValues(t, 3, atomic.LoadInt32(count))
+}
+
+func TestCallerDoesNotRetryWithoutRetryAfter(t *testing.T) {
+	for _, retryAfter := range []string{"", "3600"} {
+		srv, count := newThrottlingServer(1, retryAfter)
+		m := &oa3{pacing: newPacing(0, 0, 5, time.Minute)}
+		var err error
+		m.roundTripper, err = m.transport.newTransport()
+		require.NoError(t, err)
+
+		rep := m.doFakePost(t, srv.URL)
+		require.EqualValues(t, http.StatusTooManyRequests, rep.GetStatusCode())
+		require.Zero(t, rep.GetRetries())
+		require.EqualValues(t, 1, atomic.LoadInt32(count))
+		srv.Close()
+	}
+}
+
+func TestRetryDelayOnlyRetries503sOfIdempotentCalls(t *testing.T) {
+	p := newPacing(0, 0, 1, time.Minute)
+	rep := func(code int) *http.Response {
+		return &http.Response{StatusCode: code, Header: http.Header{"Retry-After": {"1"}}}
+	}
+
+	for _, idempotent := range []bool{false, true} {
+		delay, ok := p.retryDelay(rep(http.StatusTooManyRequests), 0, idempotent)
+		require.True(t, ok)
+		require.Equal(t, time.Second, delay)
+	}
+
+	_, ok := p.retryDelay(rep(http.StatusServiceUnavailable), 0, false)
+	require.False(t, ok)
+	_, ok = p.retryDelay(rep(http.StatusServiceUnavailable), 0, true)
+	require.True(t, ok)
+	_, ok = p.retryDelay(rep(http.StatusServiceUnavailable), 1, true)
+	require.False(t, ok)
+	_, ok = p.retryDelay(rep(http.StatusInternalServerError), 0, true)
+	require.False(t, ok)
+}
+
+func TestPacingRateLimit(t *testing.T) {
+	p := newPacing(50, 0, 0, 0)
+	start := time.Now()
+	for i := 0; i < 6; i++ {
+		release, err := p.acquire(context.Background())
+		require.NoError(t, err)
+		release()
+	}
+	// First request goes out right away, the next 5 are 20ms apart
+	require.GreaterOrEqual(t, time.Since(start), 100*time.Millisecond)
+}
+
+func TestPacingMaxInFlight(t *testing.T) {
+	p := newPacing(0, 2, 0, 0)
+	var inFlight, maxSeen int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			release, err := p.acquire(context.Background())
+			require.NoError(t, err)
+			defer release()
+			n := atomic.AddInt32(&inFlight, 1)
+			for {
+				seen := atomic.LoadInt32(&maxSeen)
+				if n <= seen || atomic.CompareAndSwapInt32(&maxSeen, seen, n) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			atomic.AddInt32(&inFlight, -1)
+		}()
+	}
+	wg.Wait()
+	require.EqualValues(t, 2, maxSeen)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r1, err := p.acquire(ctx)
+	require.NoError(t, err)
+	r2, err := p.acquire(ctx)
+	require.NoError(t, err)
+	cancel()
+	_, err = p.acquire(ctx)
+	require.ErrorIs(t, err, context.Canceled)
+	r1()
+	r2()
+}
@@ -0,0 +1,172 @@
+package openapiv3
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const mimeEventStream = "text/event-stream"
+
+type event = fm.Clt_CallResponseRaw_Output_HttpResponse_Event
+
+// streamLimits bounds how much of a streaming response is read
+type streamLimits struct {
+	// maxEvents is zero when unbounded
+	maxEvents int
+	// maxDuration is zero when unbounded
+	maxDuration time.Duration
+}
+
+// isStreamMediaType tells whether a media type (or a Content-Type value)
+// denotes a stream of events rather than a single document.
+func isStreamMediaType(contentType string) bool {
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return false
+	}
+	switch mediaType {
+	case mimeEventStream,
+		"application/x-ndjson",
+		"application/ndjson",
+		"application/jsonl",
+		"application/stream+json":
+		return true
+	default:
+		return false
+	}
+}
+
+// readEvents reads a streaming body until it ends or a limit is hit.
+// It returns the bytes read (at most maxBytes unless zero)
+// and the events decoded out of them.
+func (l streamLimits) readEvents(r *http.Response, start time.Time, maxBytes int64) (
+	body []byte, events []*event, truncated, bodyTruncated bool, err error,
+) {
+	var expired int32
+	if l.maxDuration != 0 {
+		timer := time.AfterFunc(l.maxDuration, func() {
+			atomic.StoreInt32(&expired, 1)
+			// Unblocks any pending Read
+			r.Body.Close()
+		})
+		defer timer.Stop()
+	}
+
+	// Reading one byte past maxBytes tells a body was cut
+	kept := &capWriter{max: maxBytes}
+	src := io.Reader(r.Body)
+	var limited *io.LimitedReader
+	if maxBytes != 0 {
+		limited = &io.LimitedReader{R: r.Body, N: maxBytes + 1}
+		src = limited
+	}
+	lines := bufio.NewReader(io.TeeReader(src, kept))
+	var parse func(line string) *event
+	if mediaType, _, _ := mime.ParseMediaType(r.Header.Get(headerContentType)); mediaType == mimeEventStream {
+		parse = newSSEParser()
+	} else {
+		parse = parseNDJSONLine
+	}
+
+	for {
+		line, e := lines.ReadString('\n')
+		if e != nil && limited != nil && limited.N == 0 {
+			// Drops the line that was cut
+			log.Printf("[NFO] stopped reading stream after %dB", maxBytes)
+			truncated, bodyTruncated = true, true
+			break
+		}
+		if e == nil || (errors.Is(e, io.EOF) && line != "") {
+			line = strings.TrimSuffix(strings.TrimSuffix(line, "\n"), "\r")
+			if ev := parse(line); ev != nil {
+				ev.ElapsedNs = time.Since(start).Nanoseconds()
+				decodeEventData(ev)
+				events = append(events, ev)
+				if l.maxEvents != 0 && len(events) >= l.maxEvents {
+					log.Printf("[NFO] stopped reading stream after %d events", len(events))
+					truncated = true
+					break
+				}
+			}
+		}
+		if e != nil {
+			if atomic.LoadInt32(&expired) == 1 {
+				log.Printf("[NFO] stopped reading stream after %s", l.maxDuration)
+				truncated = true
+			} else if !errors.Is(e, io.EOF) {
+				log.Println("[ERR]", e)
+				err = e
+			}
+			break
+		}
+	}
+	body = kept.buf.Bytes()
+	return
+}
+
+func parseNDJSONLine(line string) *event {
+	if strings.TrimSpace(line) == "" {
+		return nil
+	}
+	return &event{Data: line}
+}
+
+// newSSEParser follows https://html.spec.whatwg.org/multipage/server-sent-events.html#event-stream-interpretation
+// A non-nil event is returned when a blank line dispatches it.
+func newSSEParser() func(line string) *event {
+	var lastID, name string
+	var data []string
+	hasData := false
+	return func(line string) *event {
+		if line == "" {
+			defer func() { name, data, hasData = "", nil, false }()
+			if !hasData {
+				return nil
+			}
+			return &event{
+				Id:    lastID,
+				Event: name,
+				Data:  strings.Join(data, "\n"),
+			}
+		}
+		if strings.HasPrefix(line, ":") {
+			// Comment
+			return nil
+		}
+		field, value, _ := strings.Cut(line, ":")
+		value = strings.TrimPrefix(value, " ")
+		switch field {
+		case "event":
+			name = value
+		case "data":
+			data = append(data, value)
+			hasData = true
+		case "id":
+			if !strings.ContainsRune(value, 0) {
+				lastID = value
+			}
+		}
+		return nil
+	}
+}
+
+func decodeEventData(ev *event) {
+	var x structpb.Value
+	if err := protojson.Unmarshal([]byte(ev.Data), &x); err != nil {
+		log.Println("[NFO] event data could not be decoded:", err)
+		return
+	}
+	ev.DataDecoded = &x
+}
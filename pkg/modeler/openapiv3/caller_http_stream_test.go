@@ -0,0 +1,226 @@
+package openapiv3
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+func TestIsStreamMediaType(t *testing.T) {
+	for _, ct := range []string{
+		"text/event-stream",
+		"text/event-stream; charset=utf-8",
+		"application/x-ndjson",
+		"application/jsonl",
+	} {
+		require.True(t, isStreamMediaType(ct), ct)
+	}
+	for _, ct := range []string{"", "application/json", "text/plain", ";;"} {
+		require.False(t, isStreamMediaType(ct), ct)
+	}
+}
+
+func TestSSEParser(t *testing.T) {
+	parse := newSSEParser()
+	var events []*event
+	for _, line := range []string{
+		": a comment",
+		"retry: 1000",
+		"id: 1",
+		"event: created",
+		"data: {",
+		`data:"a": 1}`,
+		"",
+		"",
+		"data: no id nor name",
+		"",
+		"id",
+		"event: empty",
+		"",
+	} {
+		if ev := parse(line); ev != nil {
+			events = append(events, ev)
+		}
+	}
+	require.Len(t, events, 2)
+	require.Equal(t, "1", events[0].GetId())
+	require.Equal(t, "created", events[0].GetEvent())
+	require.Equal(t, "{\n\"a\": 1}", events[0].GetData())
+	require.Equal(t, "1", events[1].GetId(), "last event ID carries over")
+	require.Equal(t, "", events[1].GetEvent())
+	require.Equal(t, "no id nor name", events[1].GetData())
+}
+
+// newStreamServer writes count events every interval then hangs up,
+// or never does when count is negative.
+func newStreamServer(contentType string, count int, interval time.Duration) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerContentType, contentType)
+		w.WriteHeader(http.StatusOK)
+		for i := 0; count < 0 || i < count; i++ {
+			if contentType == mimeEventStream {
+				fmt.Fprintf(w, "id: %d\ndata: {\"n\": %d}\n\n", i, i)
+			} else {
+				fmt.Fprintf(w, "{\"n\": %d}\n", i)
+			}
+			w.(http.Flusher).Flush()
+			select {
+			case <-r.Context().Done():
+				return
+			case <-time.After(interval):
+			}
+		}
+	}))
+}
+
+func TestCallerReadsWholeStream(t *testing.T) {
+	for _, contentType := range []string{mimeEventStream, "application/x-ndjson"} {
+		srv := newStreamServer(contentType, 3, time.Millisecond)
+
+		m := &oa3{stream: streamLimits{maxEvents: 10, maxDuration: time.Minute}}
+		var err error
+		m.roundTripper, err = m.transport.newTransport()
+		require.NoError(t, err)
+
+		rep := m.doFakeCall(t, srv.URL)
+		require.EqualValues(t, http.StatusOK, rep.GetStatusCode())
+		require.False(t, rep.GetEventsTruncated())
+		require.Len(t, rep.GetEvents(), 3, contentType)
+		require.NotEmpty(t, rep.GetBody())
+		require.Nil(t, rep.GetBodyDecoded())
+		require.True(t, m.tcap.repStreamed)
+		for i, ev := range rep.GetEvents() {
+			require.EqualValues(t, i, ev.GetDataDecoded().GetStructValue().GetFields()["n"].GetNumberValue())
+			require.Greater(t, ev.GetElapsedNs(), int64(0))
+		}
+		srv.Close()
+	}
+}
+
+func TestCallerStopsReadingStreamAtLimits(t *testing.T) {
+	srv := newStreamServer(mimeEventStream, -1, time.Millisecond)
+	defer srv.Close()
+
+	m := &oa3{stream: streamLimits{maxEvents: 5, maxDuration: time.Minute}}
+	var err error
+	m.roundTripper, err = m.transport.newTransport()
+	require.NoError(t, err)
+
+	rep := m.doFakeCall(t, srv.URL)
+	require.True(t, rep.GetEventsTruncated())
+	require.Len(t, rep.GetEvents(), 5)
+
+	m.stream = streamLimits{maxDuration: 50 * time.Millisecond}
+	start := time.Now()
+	rep = m.doFakeCall(t, srv.URL)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.True(t, rep.GetEventsTruncated())
+	require.NotEmpty(t, rep.GetEvents())
+}
+
+func TestStreamedEventsChecks(t *testing.T) {
+	m, err := lintSpec(t, `
+openapi: 3.0.0
+info: {title: stream, version: 0.0.1}
+paths:
+  /feed:
+    get:
+      responses:
+        '200':
+          description: OK
+          content:
+            text/event-stream:
+              schema:
+                type: object
+                required: [n]
+                properties:
+                  n: {type: integer}
+`)
+	require.NoError(t, err)
+	var endpoint *fm.EndpointJSON
+	for _, e := range m.vald.Spec.Endpoints {
+		endpoint = e.GetJson()
+	}
+	SID := endpoint.GetOutputs()[200]
+	require.NotZero(t, SID)
+
+	decoded := func(data string) *event {
+		ev := &event{Data: data}
+		decodeEventData(ev)
+		return ev
+	}
+	m.tcap = &tCapHTTP{
+		endpoint:    endpoint,
+		matchedSID:  SID,
+		repStreamed: true,
+		repProto:    &fm.Clt_CallResponseRaw_Output_HttpResponse{},
+	}
+
+	_, skipped, _ := m.checkValidJSONResponse()
+	require.Equal(t, "no events were received", skipped)
+
+	m.tcap.repProto.Events = []*event{decoded(`{"n": 1}`), decoded(`{"n": 2}`)}
+	s, _, f := m.checkValidJSONResponse()
+	require.Equal(t, "all 2 events are valid JSON", s)
+	require.Empty(t, f)
+	s, _, f = m.checkValidatesJSONSchema()
+	require.Equal(t, "2 events validate JSON Schema", s)
+	require.Empty(t, f)
+
+	m.tcap.repProto.Events = append(m.tcap.repProto.Events, decoded(`ping`), decoded(`{"n": "3"}`))
+	_, _, f = m.checkValidJSONResponse()
+	require.Equal(t, []string{`event #2 is not valid JSON: "ping"`}, f)
+	_, _, f = m.checkValidatesJSONSchema()
+	require.NotEmpty(t, f)
+	require.Equal(t, "event #3 does not validate JSON Schema (1 errors)", f[0])
+}
+
+func TestDecodeEventData(t *testing.T) {
+	ev := &event{Data: `[1, "a"]`}
+	decodeEventData(ev)
+	require.Equal(t, "a", ev.GetDataDecoded().GetListValue().GetValues()[1].GetStringValue())
+
+	ev = &event{Data: `not json`}
+	decodeEventData(ev)
+	require.Nil(t, ev.GetDataDecoded())
+}
+
+func TestCallerCapsStreamedBodies(t *testing.T) {
+	srv := newStreamServer("application/x-ndjson", -1, time.Millisecond)
+	defer srv.Close()
+
+	// Unbounded stream limits
+	m := &oa3{maxBodyBytes: 64}
+	var err error
+	m.roundTripper, err = m.transport.newTransport()
+	require.NoError(t, err)
+
+	start := time.Now()
+	rep := m.doFakeCall(t, srv.URL)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.True(t, rep.GetEventsTruncated())
+	require.True(t, rep.GetBodyTruncated())
+	require.Len(t, rep.GetBody(), 64)
+	require.EqualValues(t, 64, rep.GetBodySize())
+	// `{"n": 0}\n` to `{"n": 6}\n` fit in 63 bytes, the 8th line is cut
+	require.Len(t, rep.GetEvents(), 7)
+
+	// A single line larger than the cap
+	huge := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(headerContentType, "application/x-ndjson")
+		fmt.Fprintf(w, "\"%s\"\n", strings.Repeat("a", 1<<20))
+	}))
+	defer huge.Close()
+
+	rep = m.doFakeCall(t, huge.URL)
+	require.True(t, rep.GetBodyTruncated())
+	require.Len(t, rep.GetBody(), 64)
+	require.Empty(t, rep.GetEvents())
+}
@@ -0,0 +1,178 @@
+package openapiv3
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser/ci"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/runtime/ctxvalues"
+)
+
+func newSessionServer() *httptest.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login", func(w http.ResponseWriter, r *http.Request) {
+		http.SetCookie(w, &http.Cookie{Name: "session", Value: "s3cr3t", Path: "/"})
+		http.Redirect(w, r, "/hop", http.StatusFound)
+	})
+	mux.HandleFunc("/hop", func(w http.ResponseWriter, r *http.Request) {
+		http.Redirect(w, r, "/me", http.StatusMovedPermanently)
+	})
+	mux.HandleFunc("/me", func(w http.ResponseWriter, r *http.Request) {
+		if _, err := r.Cookie("session"); err != nil {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	return httptest.NewServer(mux)
+}
+
+func (m *oa3) doFakeCall(t *testing.T, url string) *fm.Clt_CallResponseRaw_Output_HttpResponse {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	return m.doFakeRequest(t, req)
+}
+
+func (m *oa3) doFakeRequest(t *testing.T, req *http.Request) *fm.Clt_CallResponseRaw_Output_HttpResponse {
+	m.tcap = &tCapHTTP{
+		shower:       &ci.Progresser{},
+		transport:    m.roundTripper,
+		maxRedirects: m.maxRedirects,
+		jar:          m.jar,
+		pacing:       m.pacing,
+		stream:       m.stream,
+		maxBodyBytes: m.maxBodyBytes,
+		httpReq:      req,
+		endpoint:     &fm.EndpointJSON{},
+	}
+	m.tcap.Do(context.Background())
+	require.NoError(t, m.tcap.doErr)
+	return m.tcap.ResponseProto().GetOutput().GetHttpResponse()
+}
+
+func TestCallerRedirectsAreOptIn(t *testing.T) {
+	srv := newSessionServer()
+	defer srv.Close()
+
+	m := &oa3{cookies: true}
+	var err error
+	m.roundTripper, err = m.transport.newTransport()
+	require.NoError(t, err)
+	m.ResetSession()
+
+	rep := m.doFakeCall(t, srv.URL+"/login")
+	require.EqualValues(t, http.StatusFound, rep.GetStatusCode())
+	require.Empty(t, rep.GetHistory())
+
+	m.maxRedirects = 1
+	rep = m.doFakeCall(t, srv.URL+"/login")
+	require.EqualValues(t, http.StatusMovedPermanently, rep.GetStatusCode())
+	require.Len(t, rep.GetHistory(), 1)
+
+	m.maxRedirects = 10
+	rep = m.doFakeCall(t, srv.URL+"/login")
+	require.EqualValues(t, http.StatusOK, rep.GetStatusCode())
+	history := rep.GetHistory()
+	require.Len(t, history, 2)
+	require.Equal(t, srv.URL+"/login", history[0].GetUrl())
+	require.EqualValues(t, http.StatusFound, history[0].GetStatusCode())
+	require.Equal(t, srv.URL+"/hop", history[1].GetUrl())
+	require.EqualValues(t, http.StatusMovedPermanently, history[1].GetStatusCode())
+}
+
+func TestCallerCookieJarIsPerTest(t *testing.T) {
+	srv := newSessionServer()
+	defer srv.Close()
+
+	m := &oa3{cookies: true}
+	var err error
+	m.roundTripper, err = m.transport.newTransport()
+	require.NoError(t, err)
+	m.ResetSession()
+
+	rep := m.doFakeCall(t, srv.URL+"/me")
+	require.EqualValues(t, http.StatusUnauthorized, rep.GetStatusCode())
+	rep = m.doFakeCall(t, srv.URL+"/login")
+	require.EqualValues(t, http.StatusFound, rep.GetStatusCode())
+	rep = m.doFakeCall(t, srv.URL+"/me")
+	require.EqualValues(t, http.StatusOK, rep.GetStatusCode())
+
+	m.ResetSession()
+	rep = m.doFakeCall(t, srv.URL+"/me")
+	require.EqualValues(t, http.StatusUnauthorized, rep.GetStatusCode())
+
+	m.cookies = false
+	m.ResetSession()
+	rep = m.doFakeCall(t, srv.URL+"/login")
+	require.EqualValues(t, http.StatusFound, rep.GetStatusCode())
+	rep = m.doFakeCall(t, srv.URL+"/me")
+	require.EqualValues(t, http.StatusUnauthorized, rep.GetStatusCode())
+}
+
+func TestCallerHostExpandsResetterExports(t *testing.T) {
+	ctx := context.WithValue(context.Background(), ctxvalues.XResetterEnv, func() map[string]string {
+		return map[string]string{"PORT": "36718"}
+	})
+
+	host, err := expandHost(ctx, "http://localhost:${PORT}")
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:36718", host)
+
+	host, err = expandHost(context.Background(), "http://localhost:3000")
+	require.NoError(t, err)
+	require.Equal(t, "http://localhost:3000", host)
+
+	_, err = expandHost(ctx, "http://${HOST}:${PORT}")
+	require.EqualError(t, err, `host "http://${HOST}:${PORT}" references HOST but no resetter exported it`)
+}
+
+func TestCallerRedirectsForgetPreviousHops(t *testing.T) {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/moved", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Location", "/here")
+		w.WriteHeader(http.StatusFound)
+		fmt.Fprint(w, "{not JSON")
+	})
+	mux.HandleFunc("/here", func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `{"ok": true}`)
+	})
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	m := &oa3{maxRedirects: 1}
+	var err error
+	m.roundTripper, err = m.transport.newTransport()
+	require.NoError(t, err)
+	m.ResetSession()
+
+	req, err := http.NewRequest(http.MethodGet, srv.URL+"/moved", nil)
+	require.NoError(t, err)
+	m.tcap = &tCapHTTP{
+		shower:       &ci.Progresser{},
+		transport:    m.roundTripper,
+		maxRedirects: m.maxRedirects,
+		pacing:       m.pacing,
+		httpReq:      req,
+		endpoint:     &fm.EndpointJSON{Outputs: map[uint32]uint32{http.StatusFound: 42}},
+	}
+	m.tcap.Do(context.Background())
+	require.NoError(t, m.tcap.doErr)
+
+	rep := m.tcap.ResponseProto()
+	require.EqualValues(t, http.StatusOK, rep.GetOutput().GetHttpResponse().GetStatusCode())
+	require.Len(t, rep.GetOutput().GetHttpResponse().GetHistory(), 1)
+	require.Zero(t, rep.GetOutputId())
+	require.Zero(t, m.tcap.matchedSID)
+
+	_, _, f := m.checkHTTPCode()
+	require.Equal(t, []string{"unexpected HTTP code '200'"}, f)
+	s, _, f := m.checkValidJSONResponse()
+	require.Empty(t, f)
+	require.Equal(t, "response is valid JSON", s)
+}
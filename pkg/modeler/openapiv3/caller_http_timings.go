@@ -0,0 +1,83 @@
+package openapiv3
+
+import (
+	"crypto/tls"
+	"net/http/httptrace"
+	"sync"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+// httpTimings records when the phases of a request happened.
+// Hooks may be called concurrently (e.g. when dialing many addresses).
+type httpTimings struct {
+	mu sync.Mutex
+
+	start                    time.Time
+	dnsStart, dnsDone        time.Time
+	connectStart, connectEnd time.Time
+	tlsStart, tlsDone        time.Time
+	firstByte                time.Time
+
+	reused                bool
+	localAddr, remoteAddr string
+}
+
+func newHTTPTimings() *httpTimings {
+	return &httpTimings{start: time.Now()}
+}
+
+func (t *httpTimings) now(at *time.Time, onlyFirst bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if onlyFirst && !at.IsZero() {
+		return
+	}
+	*at = time.Now()
+}
+
+func (t *httpTimings) clientTrace() *httptrace.ClientTrace {
+	return &httptrace.ClientTrace{
+		DNSStart:          func(httptrace.DNSStartInfo) { t.now(&t.dnsStart, true) },
+		DNSDone:           func(httptrace.DNSDoneInfo) { t.now(&t.dnsDone, false) },
+		ConnectStart:      func(_, _ string) { t.now(&t.connectStart, true) },
+		ConnectDone:       func(_, _ string, _ error) { t.now(&t.connectEnd, false) },
+		TLSHandshakeStart: func() { t.now(&t.tlsStart, true) },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { t.now(&t.tlsDone, false) },
+		GotConn: func(info httptrace.GotConnInfo) {
+			t.mu.Lock()
+			defer t.mu.Unlock()
+			t.reused = info.Reused
+			if conn := info.Conn; conn != nil {
+				t.localAddr = conn.LocalAddr().String()
+				t.remoteAddr = conn.RemoteAddr().String()
+			}
+		},
+		GotFirstResponseByte: func() { t.now(&t.firstByte, true) },
+	}
+}
+
+func since(start, end time.Time) int64 {
+	if start.IsZero() || end.IsZero() {
+		return 0
+	}
+	return end.Sub(start).Nanoseconds()
+}
+
+// toProto is to be called once the response body was read
+func (t *httpTimings) toProto() *fm.Clt_CallResponseRaw_Output_HttpResponse_Timings {
+	end := time.Now()
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return &fm.Clt_CallResponseRaw_Output_HttpResponse_Timings{
+		DnsNs:      since(t.dnsStart, t.dnsDone),
+		ConnectNs:  since(t.connectStart, t.connectEnd),
+		TlsNs:      since(t.tlsStart, t.tlsDone),
+		TtfbNs:     since(t.start, t.firstByte),
+		TransferNs: since(t.firstByte, end),
+		ReusedConn: t.reused,
+		LocalAddr:  t.localAddr,
+		RemoteAddr: t.remoteAddr,
+	}
+}
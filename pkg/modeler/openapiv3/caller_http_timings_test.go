@@ -0,0 +1,45 @@
+package openapiv3
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+func TestCallerRecordsTimings(t *testing.T) {
+	srv := httptest.NewTLSServer(hello)
+	defer srv.Close()
+
+	o := &transportOptions{insecureSkipVerify: true}
+	require.NoError(t, o.check())
+	transport, err := o.newTransport()
+	require.NoError(t, err)
+
+	c := &tCapHTTP{transport: transport, endpoint: &fm.EndpointJSON{}}
+	for i := 0; i < 2; i++ {
+		req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+		require.NoError(t, err)
+		_, err = c.RoundTrip(req)
+		require.NoError(t, err)
+
+		timings := c.repProto.GetTimings()
+		require.NotNil(t, timings)
+		require.Equal(t, srv.Listener.Addr().String(), timings.GetRemoteAddr())
+		require.NotEmpty(t, timings.GetLocalAddr())
+		require.NotZero(t, timings.GetTtfbNs())
+		require.LessOrEqual(t, timings.GetTtfbNs(), c.repProto.GetElapsedNs())
+		if i == 0 {
+			require.False(t, timings.GetReusedConn())
+			require.NotZero(t, timings.GetConnectNs())
+			require.NotZero(t, timings.GetTlsNs())
+		} else {
+			require.True(t, timings.GetReusedConn())
+			require.Zero(t, timings.GetConnectNs())
+			require.Zero(t, timings.GetTlsNs())
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package openapiv3
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+)
+
+// DiffAgainst lists changes made since the given older spec file
+func (m *oa3) DiffAgainst(ctx context.Context, oldFile string) (changes []modeler.SpecChange, err error) {
+	old := &oa3{
+		name: m.name,
+		pb:   &fm.Clt_Fuzz_Model_OpenAPIv3{File: oldFile},
+	}
+	log.Printf("[NFO] linting older spec %q", oldFile)
+	if err = old.Lint(ctx, false); err != nil {
+		return
+	}
+
+	changes = diffSpecs(old.vald, m.vald)
+	log.Printf("[NFO] found %d changes since %q", len(changes), oldFile)
+	return
+}
+
+type specDiffer struct {
+	changes  []modeler.SpecChange
+	old, new *validator
+	where    string
+}
+
+func (d *specDiffer) breaking(format string, a ...interface{}) {
+	d.changes = append(d.changes, modeler.SpecChange{
+		Breaking: true,
+		Where:    d.where,
+		What:     fmt.Sprintf(format, a...),
+	})
+}
+
+func (d *specDiffer) nonBreaking(format string, a ...interface{}) {
+	d.changes = append(d.changes, modeler.SpecChange{
+		Where: d.where,
+		What:  fmt.Sprintf(format, a...),
+	})
+}
+
+func diffSpecs(old, new *validator) []modeler.SpecChange {
+	d := &specDiffer{old: old, new: new}
+
+	olds, news := old.endpointsByRoute(), new.endpointsByRoute()
+	routes := make([]string, 0, len(olds)+len(news))
+	for route := range olds {
+		routes = append(routes, route)
+	}
+	for route := range news {
+		if _, ok := olds[route]; !ok {
+			routes = append(routes, route)
+		}
+	}
+	sort.Strings(routes)
+
+	for _, route := range routes {
+		d.where = route
+		oldE, inOld := olds[route]
+		newE, inNew := news[route]
+		switch {
+		case !inNew:
+			d.breaking("removed endpoint")
+		case !inOld:
+			d.nonBreaking("added endpoint")
+		default:
+			d.diffInputs(oldE.GetInputs(), newE.GetInputs())
+			d.diffOutputs(oldE.GetOutputs(), newE.GetOutputs())
+		}
+	}
+	return d.changes
+}
+
+func (vald *validator) endpointsByRoute() map[string]*fm.EndpointJSON {
+	routes := make(map[string]*fm.EndpointJSON, len(vald.Spec.Endpoints))
+	for _, endpoint := range vald.Spec.Endpoints {
+		e := endpoint.GetJson()
+		routes[e.GetMethod().String()+" "+pathToOA3(e.GetPathPartials())] = e
+	}
+	return routes
+}
+
+func inputName(param *fm.ParamJSON) string {
+	if isInputBody(param) {
+		return "request body"
+	}
+	return fmt.Sprintf("%s parameter %q", param.GetKind(), param.GetName())
+}
+
+func (d *specDiffer) diffInputs(oldInputs, newInputs []*fm.ParamJSON) {
+	olds := make(map[string]*fm.ParamJSON, len(oldInputs))
+	for _, param := range oldInputs {
+		olds[inputName(param)] = param
+	}
+
+	seen := make(map[string]struct{}, len(newInputs))
+	for _, newParam := range newInputs {
+		name := inputName(newParam)
+		seen[name] = struct{}{}
+		oldParam, ok := olds[name]
+		switch {
+		case !ok && newParam.GetIsRequired():
+			d.breaking("newly required %s", name)
+			continue
+		case !ok:
+			d.nonBreaking("added optional %s", name)
+			continue
+		case newParam.GetIsRequired() && !oldParam.GetIsRequired():
+			d.breaking("newly required %s", name)
+		case !newParam.GetIsRequired() && oldParam.GetIsRequired():
+			d.nonBreaking("%s is now optional", name)
+		}
+
+		oldSchema := d.old.inlined(oldParam.GetSID())
+		newSchema := d.new.inlined(newParam.GetSID())
+		if canonicalJSON(oldSchema) == canonicalJSON(newSchema) {
+			continue
+		}
+		// Inputs break clients when they accept less
+		if !d.narrowed(name, "narrowed", "newly required", oldSchema, newSchema) {
+			d.nonBreaking("changed schema of %s", name)
+		}
+	}
+
+	names := make([]string, 0, len(olds))
+	for name := range olds {
+		if _, ok := seen[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		d.nonBreaking("removed %s", name)
+	}
+}
+
+func (d *specDiffer) diffOutputs(oldOutputs, newOutputs map[uint32]sid) {
+	codes := make([]uint32, 0, len(oldOutputs)+len(newOutputs))
+	for code := range oldOutputs {
+		codes = append(codes, code)
+	}
+	for code := range newOutputs {
+		if _, ok := oldOutputs[code]; !ok {
+			codes = append(codes, code)
+		}
+	}
+	sort.Slice(codes, func(i, j int) bool { return codes[i] < codes[j] })
+
+	for _, code := range codes {
+		status := statusCodeToOA3(code)
+		oldSID, inOld := oldOutputs[code]
+		newSID, inNew := newOutputs[code]
+		switch {
+		case !inNew:
+			d.breaking("removed status code %s", status)
+		case !inOld:
+			d.nonBreaking("added status code %s", status)
+		default:
+			oldSchema, newSchema := schemaJSON{}, schemaJSON{}
+			if oldSID != 0 {
+				oldSchema = d.old.inlined(oldSID)
+			}
+			if newSID != 0 {
+				newSchema = d.new.inlined(newSID)
+			}
+			if canonicalJSON(oldSchema) == canonicalJSON(newSchema) {
+				continue
+			}
+			// Outputs break clients when they may hold more: i.e. when old is narrower than new
+			name := "response " + status
+			breaks := d.narrowed(name, "widened", "no longer required", newSchema, oldSchema)
+			for _, at := range removedProperties("", oldSchema, newSchema) {
+				breaks = true
+				d.breaking("removed property of %s%s", name, at)
+			}
+			if !breaks {
+				d.nonBreaking("changed response schema of status code %s", status)
+			}
+		}
+	}
+}
+
+// narrowed reports as breaking where new accepts less than old
+func (d *specDiffer) narrowed(name, narrowed, required string, old, new schemaJSON) (found bool) {
+	for _, at := range narrowedEnums("", old, new) {
+		found = true
+		d.breaking("%s enum of %s%s", narrowed, name, at)
+	}
+	for _, at := range narrowedTypes("", old, new) {
+		found = true
+		d.breaking("%s type of %s%s", narrowed, name, at)
+	}
+	for _, at := range narrowedRequired("", old, new) {
+		found = true
+		d.breaking("%s %s%s", required, name, at)
+	}
+	for _, keyword := range boundsKeywords {
+		for _, at := range narrowedBound(keyword)("", old, new) {
+			found = true
+			d.breaking("%s %s of %s%s", narrowed, keyword, name, at)
+		}
+	}
+	return
+}
+
+func statusCodeToOA3(code uint32) string {
+	for xxx, i := range xxx2uint32 {
+		if i == code {
+			return xxx
+		}
+	}
+	return fmt.Sprintf("%d", code)
+}
+
+// inlined returns the Go representation of a schema with its $refs
+// replaced by the schemas they point to (recursion stops at cycles).
+func (vald *validator) inlined(SID sid) schemaJSON {
+	var sm schemap
+	sm = vald.Spec.Schemas.GetJson()
+	return vald.inline(sm, sm.toGo(SID), make(map[string]struct{})).(schemaJSON)
+}
+
+func (vald *validator) inline(sm schemap, v interface{}, seen map[string]struct{}) interface{} {
+	switch x := v.(type) {
+	case schemaJSON:
+		if ref, ok := x["$ref"].(string); ok && len(x) == 1 {
+			if _, ok := seen[ref]; ok {
+				return x
+			}
+			seen[ref] = struct{}{}
+			defer delete(seen, ref)
+			return vald.inline(sm, sm.toGo(vald.Refs[ref]), seen)
+		}
+		s := make(schemaJSON, len(x))
+		for k, vv := range x {
+			s[k] = vald.inline(sm, vv, seen)
+		}
+		return s
+	case []schemaJSON:
+		ss := make([]schemaJSON, 0, len(x))
+		for _, vv := range x {
+			ss = append(ss, vald.inline(sm, vv, seen).(schemaJSON))
+		}
+		return ss
+	default:
+		return v
+	}
+}
+
+func canonicalJSON(v interface{}) string {
+	// NOTE: encoding/json sorts map keys
+	blob, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(blob)
+}
+
+// narrowedEnums lists locations where values accepted by old are no longer
+// accepted by new's enums.
+func narrowedEnums(at string, old, new schemaJSON) (ats []string) {
+	if newEnum, ok := new["enum"].([]interface{}); ok {
+		allowed := make(map[string]struct{}, len(newEnum))
+		for _, v := range newEnum {
+			allowed[canonicalJSON(v)] = struct{}{}
+		}
+		if oldEnum, ok := old["enum"].([]interface{}); ok {
+			for _, v := range oldEnum {
+				if _, ok := allowed[canonicalJSON(v)]; !ok {
+					ats = append(ats, at)
+					break
+				}
+			}
+		} else {
+			ats = append(ats, at)
+		}
+	}
+	ats = append(ats, forEachSubschema(at, old, new, narrowedEnums)...)
+	return
+}
+
+// narrowedTypes lists locations where types accepted by old are no longer
+// accepted by new.
+func narrowedTypes(at string, old, new schemaJSON) (ats []string) {
+	if newTypes, ok := new["type"].([]string); ok {
+		oldTypes, _ := old["type"].([]string)
+		if len(oldTypes) == 0 {
+			ats = append(ats, at)
+		}
+		for _, t := range oldTypes {
+			if !slices.Contains(newTypes, t) {
+				ats = append(ats, at)
+				break
+			}
+		}
+	}
+	ats = append(ats, forEachSubschema(at, old, new, narrowedTypes)...)
+	return
+}
+
+// narrowedRequired lists properties that new requires but old did not.
+func narrowedRequired(at string, old, new schemaJSON) (ats []string) {
+	oldRequired, _ := old["required"].([]string)
+	newRequired, _ := new["required"].([]string)
+	for _, name := range newRequired {
+		if !slices.Contains(oldRequired, name) {
+			ats = append(ats, at+"."+name)
+		}
+	}
+	ats = append(ats, forEachSubschema(at, old, new, narrowedRequired)...)
+	return
+}
+
+// boundsKeywords lists the keywords narrowedBound knows to compare
+var boundsKeywords = []string{
+	"minimum", "maximum", "exclusiveMinimum", "exclusiveMaximum", "multipleOf",
+	"minLength", "maxLength", "pattern",
+	"minItems", "maxItems", "uniqueItems",
+	"minProperties", "maxProperties",
+}
+
+// narrowedBound returns a func listing locations where new sets keyword
+// so that values accepted by old no longer are.
+// Patterns and multipleOf are only compared for equality.
+func narrowedBound(keyword string) func(string, schemaJSON, schemaJSON) []string {
+	var f func(at string, old, new schemaJSON) []string
+	f = func(at string, old, new schemaJSON) (ats []string) {
+		if newBound, ok := new[keyword]; ok {
+			oldBound, ok := old[keyword]
+			if !ok || tighter(keyword, oldBound, newBound) {
+				ats = append(ats, at)
+			}
+		}
+		ats = append(ats, forEachSubschema(at, old, new, f)...)
+		return
+	}
+	return f
+}
+
+func tighter(keyword string, old, new interface{}) bool {
+	switch o := old.(type) {
+	case bool:
+		return new.(bool) && !o
+	case uint64:
+		if strings.HasPrefix(keyword, "min") {
+			return new.(uint64) > o
+		}
+		return new.(uint64) < o
+	case float64:
+		switch keyword {
+		case "minimum":
+			return new.(float64) > o
+		case "maximum":
+			return new.(float64) < o
+		}
+	}
+	return old != new
+}
+
+// removedProperties lists properties old described and new does not.
+func removedProperties(at string, old, new schemaJSON) (ats []string) {
+	if oldProps, ok := old["properties"].(schemaJSON); ok {
+		newProps, _ := new["properties"].(schemaJSON)
+		names := make([]string, 0, len(oldProps))
+		for name := range oldProps {
+			if _, ok := newProps[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			ats = append(ats, at+"."+name)
+		}
+	}
+	ats = append(ats, forEachSubschema(at, old, new, removedProperties)...)
+	return
+}
+
+func forEachSubschema(at string, old, new schemaJSON, f func(string, schemaJSON, schemaJSON) []string) (ats []string) {
+	if newProps, ok := new["properties"].(schemaJSON); ok {
+		if oldProps, ok := old["properties"].(schemaJSON); ok {
+			names := make([]string, 0, len(newProps))
+			for name := range newProps {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				if oldProp, ok := oldProps[name].(schemaJSON); ok {
+					ats = append(ats, f(at+"."+name, oldProp, newProps[name].(schemaJSON))...)
+				}
+			}
+		}
+	}
+	if newItems, ok := new["items"].([]schemaJSON); ok && len(newItems) != 0 {
+		if oldItems, ok := old["items"].([]schemaJSON); ok && len(oldItems) != 0 {
+			ats = append(ats, f(at+"[]", oldItems[0], newItems[0])...)
+		}
+	}
+	return
+}
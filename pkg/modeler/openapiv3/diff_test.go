@@ -0,0 +1,133 @@
+package openapiv3
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+)
+
+func TestDiffAgainst(t *testing.T) {
+	docPath := filepath.Join("testdata", "specs", "openapi3", "v3.0.0_petstore.yaml")
+	blob, err := os.ReadFile(docPath)
+	require.NoError(t, err)
+
+	newDocPath := filepath.Join(t.TempDir(), "new.yaml")
+	newBlob := strings.NewReplacer(
+		// Newly required param
+		"required: false", "required: true",
+		// Narrowed enum of a param
+		"            type: string\n      responses:", "            type: string\n            enum: [a, b]\n      responses:",
+		// Narrowed enum in a response schema: safe for clients
+		"enum: [null, false, 42.42, {a: [good]}]", "enum: [null, false]",
+		// Removed endpoint
+		"    post:\n      summary: Create a pet", "    put:\n      summary: Create a pet",
+	).Replace(string(blob))
+	err = os.WriteFile(newDocPath, []byte(newBlob), 0644)
+	require.NoError(t, err)
+
+	m := &oa3{pb: &fm.Clt_Fuzz_Model_OpenAPIv3{File: newDocPath}}
+	err = m.Lint(context.Background(), false)
+	require.NoError(t, err)
+
+	changes, err := m.DiffAgainst(context.Background(), docPath)
+	require.NoError(t, err)
+	require.Equal(t, []modeler.SpecChange{
+		{Breaking: true, Where: "GET /v1/pets", What: `newly required query parameter "limit"`},
+		{Breaking: false, Where: "GET /v1/pets", What: "changed response schema of status code 200"},
+		{Breaking: true, Where: "GET /v1/pets/{petId}", What: `narrowed enum of path parameter "petId"`},
+		{Breaking: false, Where: "GET /v1/pets/{petId}", What: "changed response schema of status code 200"},
+		{Breaking: true, Where: "POST /v1/pets", What: "removed endpoint"},
+		{Breaking: false, Where: "PUT /v1/pets", What: "added endpoint"},
+	}, changes)
+
+	changes, err = m.DiffAgainst(context.Background(), newDocPath)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+}
+
+const specWithBodies = `
+openapi: 3.0.0
+info: {title: bodies, version: 0.0.1}
+paths:
+  /pets:
+    post:
+      requestBody:
+        required: true
+        content:
+          application/json:
+            schema:
+              type: object
+              required: [name]
+              properties:
+                name: {type: string, maxLength: 64}
+                age: {type: integer, minimum: 0}
+                tag: {type: string}
+                code: {type: string}
+      responses:
+        '201':
+          description: Created
+          content:
+            application/json:
+              schema:
+                type: object
+                required: [id, name]
+                properties:
+                  id: {type: integer}
+                  name: {type: string}
+                  kind: {type: string, enum: [cat, dog]}
+                  color: {type: string}
+`
+
+func TestDiffClassifiesSchemaChanges(t *testing.T) {
+	oldDocPath := filepath.Join(t.TempDir(), "old.yaml")
+	err := os.WriteFile(oldDocPath, []byte(specWithBodies), 0644)
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		from, to string
+		expected modeler.SpecChange
+	}{
+		// Inputs break when they accept less
+		{"required: [name]\n", "required: [name, tag]\n",
+			modeler.SpecChange{Breaking: true, What: "newly required request body.tag"}},
+		{"maxLength: 64", "maxLength: 32",
+			modeler.SpecChange{Breaking: true, What: "narrowed maxLength of request body.name"}},
+		{"minimum: 0", "minimum: 1",
+			modeler.SpecChange{Breaking: true, What: "narrowed minimum of request body.age"}},
+		{"code: {type: string}", "code: {type: string, pattern: '^[A-Z]+$'}",
+			modeler.SpecChange{Breaking: true, What: "narrowed pattern of request body.code"}},
+		// Inputs accepting more do not
+		{"minimum: 0", "minimum: -1",
+			modeler.SpecChange{What: "changed schema of request body"}},
+		// Outputs break when they may hold more
+		{"enum: [cat, dog]", "enum: [cat, dog, fish]",
+			modeler.SpecChange{Breaking: true, What: "widened enum of response 201.kind"}},
+		{"id: {type: integer}", "id: {}",
+			modeler.SpecChange{Breaking: true, What: "widened type of response 201.id"}},
+		{"required: [id, name]", "required: [id]",
+			modeler.SpecChange{Breaking: true, What: "no longer required response 201.name"}},
+		{"                  color: {type: string}\n", "",
+			modeler.SpecChange{Breaking: true, What: "removed property of response 201.color"}},
+		// Outputs holding less do not
+		{"enum: [cat, dog]", "enum: [cat]",
+			modeler.SpecChange{What: "changed response schema of status code 201"}},
+	} {
+		t.Run(test.to, func(t *testing.T) {
+			require.Contains(t, specWithBodies, test.from)
+			m, err := lintSpec(t, strings.Replace(specWithBodies, test.from, test.to, 1))
+			require.NoError(t, err)
+
+			changes, err := m.DiffAgainst(context.Background(), oldDocPath)
+			require.NoError(t, err)
+			test.expected.Where = "POST /pets"
+			require.Equal(t, []modeler.SpecChange{test.expected}, changes)
+		})
+	}
+}
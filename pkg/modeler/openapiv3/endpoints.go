@@ -0,0 +1,123 @@
+package openapiv3
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const (
+	selectorOperationID = "op:"
+	selectorTag         = "tag:"
+	selectorIs          = "is:"
+	selectorDeprecated  = selectorIs + "deprecated"
+	selectorNot         = "!"
+)
+
+// endpointSelector matches an endpoint or its one-line description
+type endpointSelector func(e *fm.EndpointJSON, desc string) bool
+
+// newEndpointSelector understands op:OPERATION_ID, tag:TAG and is:deprecated.
+// Anything else is a regexp matched against the endpoint's description.
+func newEndpointSelector(selector string) (endpointSelector, error) {
+	switch {
+	case selector == selectorDeprecated:
+		return func(e *fm.EndpointJSON, _ string) bool { return e.GetDeprecated() }, nil
+
+	case strings.HasPrefix(selector, selectorIs):
+		return nil, fmt.Errorf("unknown selector %q (did you mean %q?)", selector, selectorDeprecated)
+
+	case strings.HasPrefix(selector, selectorOperationID):
+		op := strings.TrimPrefix(selector, selectorOperationID)
+		if op == "" {
+			return nil, fmt.Errorf("missing operationId in %q", selector)
+		}
+		return func(e *fm.EndpointJSON, _ string) bool { return e.GetOperationId() == op }, nil
+
+	case strings.HasPrefix(selector, selectorTag):
+		tag := strings.TrimPrefix(selector, selectorTag)
+		if tag == "" {
+			return nil, fmt.Errorf("missing tag in %q", selector)
+		}
+		return func(e *fm.EndpointJSON, _ string) bool {
+			for _, t := range e.GetTags() {
+				if t == tag {
+					return true
+				}
+			}
+			return false
+		}, nil
+
+	default:
+		return newRegexpSelector(selector)
+	}
+}
+
+func newRegexpSelector(pattern string) (endpointSelector, error) {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	return func(_ *fm.EndpointJSON, desc string) bool { return re.MatchString(desc) }, nil
+}
+
+// checkEndpointSelectors ensures selectors given to the `endpoints` kwarg parse
+func checkEndpointSelectors(selectors []string) error {
+	for _, selector := range selectors {
+		if _, err := newEndpointSelector(strings.TrimPrefix(selector, selectorNot)); err != nil {
+			return fmt.Errorf("bad selector in endpoints: %v", err)
+		}
+	}
+	return nil
+}
+
+// selectEndpoints keeps endpoints matching any of the selectors
+// then drops those matching any of the !-prefixed ones.
+func (vald *validator) selectEndpoints(all map[eid]string, selectors []string) error {
+	var includes, excludes []string
+	for _, selector := range selectors {
+		if strings.HasPrefix(selector, selectorNot) {
+			excludes = append(excludes, strings.TrimPrefix(selector, selectorNot))
+		} else {
+			includes = append(includes, selector)
+		}
+	}
+
+	if len(includes) != 0 {
+		kept := make(map[eid]struct{}, len(all))
+		for _, selector := range includes {
+			sel, err := newEndpointSelector(selector)
+			if err != nil {
+				log.Println("[ERR]", err)
+				return err
+			}
+			matched := false
+			for eid, desc := range all {
+				if sel(vald.Spec.Endpoints[eid].GetJson(), desc) {
+					kept[eid] = struct{}{}
+					matched = true
+				}
+			}
+			if !matched {
+				err := fmt.Errorf("endpoints: %s did not match any endpoints", selector)
+				log.Println("[ERR]", err)
+				return err
+			}
+		}
+		for eid := range all {
+			if _, ok := kept[eid]; !ok {
+				delete(all, eid)
+			}
+		}
+	}
+
+	for _, selector := range excludes {
+		if err := vald.filterEndpointsBy(all, false, selector); err != nil {
+			return err
+		}
+	}
+	return nil
+}
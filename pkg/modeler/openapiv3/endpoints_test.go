@@ -0,0 +1,133 @@
+package openapiv3
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const specWithOperations = `
+openapi: 3.0.0
+info: {title: operations, version: 0.0.1}
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      tags: [pets]
+      responses:
+        '200': {description: OK}
+    post:
+      operationId: createPet
+      tags: [pets, billing]
+      responses:
+        '201': {description: Created}
+  /pets/{id}:
+    parameters:
+    - {name: id, in: path, required: true, schema: {type: string}}
+    delete:
+      operationId: deletePet
+      deprecated: true
+      tags: [pets]
+      responses:
+        '204': {description: Deleted}
+  /invoices:
+    get:
+      operationId: listInvoices
+      tags: [billing]
+      responses:
+        '200': {description: OK}
+`
+
+func lintWithEndpoints(t *testing.T, endpoints []string) (*oa3, error) {
+	docPath := filepath.Join(t.TempDir(), "spec.yml")
+	err := os.WriteFile(docPath, []byte(specWithOperations), 0644)
+	require.NoError(t, err)
+
+	m := &oa3{
+		pb:        &fm.Clt_Fuzz_Model_OpenAPIv3{File: docPath},
+		endpoints: endpoints,
+	}
+	err = m.Lint(context.Background(), false)
+	return m, err
+}
+
+func operationIDs(t *testing.T, m *oa3, args ...string) []string {
+	eids, err := m.FilterEndpoints(args)
+	require.NoError(t, err)
+	ops := make([]string, 0, len(eids))
+	for _, eid := range eids {
+		ops = append(ops, m.vald.Spec.Endpoints[eid].GetJson().GetOperationId())
+	}
+	sort.Strings(ops)
+	return ops
+}
+
+func TestEndpointsKeepOperationMetadata(t *testing.T) {
+	m, err := lintWithEndpoints(t, nil)
+	require.NoError(t, err)
+	for _, endpoint := range m.vald.Spec.Endpoints {
+		e := endpoint.GetJson()
+		switch e.GetOperationId() {
+		case "createPet":
+			require.Equal(t, []string{"pets", "billing"}, e.GetTags())
+			require.False(t, e.GetDeprecated())
+		case "deletePet":
+			require.Equal(t, []string{"pets"}, e.GetTags())
+			require.True(t, e.GetDeprecated())
+		}
+	}
+}
+
+func TestFilterEndpointsBySelectors(t *testing.T) {
+	m, err := lintWithEndpoints(t, nil)
+	require.NoError(t, err)
+
+	require.Equal(t, []string{"createPet", "deletePet", "listInvoices", "listPets"},
+		operationIDs(t, m))
+	require.Equal(t, []string{"createPet"},
+		operationIDs(t, m, "--only=op:createPet"))
+	require.Equal(t, []string{"createPet", "listInvoices"},
+		operationIDs(t, m, "--only=tag:billing"))
+	require.Equal(t, []string{"createPet", "listInvoices", "listPets"},
+		operationIDs(t, m, "--except=is:deprecated"))
+	require.Equal(t, []string{"createPet", "deletePet", "listInvoices", "listPets"},
+		operationIDs(t, m, "--except=deprecated"), "still a regexp")
+	require.Equal(t, []string{"listInvoices"},
+		operationIDs(t, m, "--only=tag:billing", "--except=op:createPet"))
+	require.Equal(t, []string{"listPets"},
+		operationIDs(t, m, "--only", "GET", "--except", "invoices"))
+
+	_, err = m.FilterEndpoints([]string{"--only=op:nope"})
+	require.EqualError(t, err, "op:nope did not match any endpoints")
+}
+
+func TestEndpointsKwarg(t *testing.T) {
+	m, err := lintWithEndpoints(t, []string{"tag:pets", "!is:deprecated"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"createPet", "listPets"}, operationIDs(t, m))
+	require.Equal(t, []string{"createPet"}, operationIDs(t, m, "--only=POST"))
+
+	m, err = lintWithEndpoints(t, []string{"!tag:billing"})
+	require.NoError(t, err)
+	require.Equal(t, []string{"deletePet", "listPets"}, operationIDs(t, m))
+
+	_, err = lintWithEndpoints(t, []string{"op:createPet", "tag:shipping"})
+	require.EqualError(t, err, "endpoints: tag:shipping did not match any endpoints")
+}
+
+func TestCheckEndpointSelectors(t *testing.T) {
+	require.NoError(t, checkEndpointSelectors([]string{"op:a", "!tag:b", "is:deprecated", "deprecated", "^GET"}))
+	require.EqualError(t, checkEndpointSelectors([]string{"op:"}),
+		`bad selector in endpoints: missing operationId in "op:"`)
+	require.EqualError(t, checkEndpointSelectors([]string{"!tag:"}),
+		`bad selector in endpoints: missing tag in "tag:"`)
+	require.EqualError(t, checkEndpointSelectors([]string{"!is:old"}),
+		`bad selector in endpoints: unknown selector "is:old" (did you mean "is:deprecated"?)`)
+	require.Error(t, checkEndpointSelectors([]string{"("}))
+}
@@ -0,0 +1,81 @@
+package openapiv3
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const (
+	extPrefix       = "x-monkey-"
+	extSkip         = extPrefix + "skip"
+	extWeight       = extPrefix + "weight" // only read by the server, which picks calls
+	extMaxLatencyMs = extPrefix + "max-latency-ms"
+	extIdempotent   = extPrefix + "idempotent"
+)
+
+// extensionsFromOA3 reads an operation's x-monkey-* extensions into e
+func extensionsFromOA3(docOp *openapi3.Operation, e *fm.EndpointJSON) error {
+	e.Idempotent = isIdempotentMethod(e.GetMethod())
+
+	names := make([]string, 0, len(docOp.Extensions))
+	for name := range docOp.Extensions {
+		if strings.HasPrefix(name, extPrefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		value := docOp.Extensions[name]
+		var err error
+		switch name {
+		case extSkip:
+			e.Skip, err = extBool(name, value)
+		case extWeight:
+			e.Weight, err = extPositiveInt(name, value)
+		case extMaxLatencyMs:
+			e.MaxLatencyMs, err = extPositiveInt(name, value)
+		case extIdempotent:
+			e.Idempotent, err = extBool(name, value)
+		default:
+			err = fmt.Errorf("unknown extension %s", name)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func extBool(name string, value interface{}) (bool, error) {
+	b, ok := value.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s must be a boolean, got: %v", name, value)
+	}
+	return b, nil
+}
+
+func extPositiveInt(name string, value interface{}) (uint32, error) {
+	// NOTE: numbers are decoded from JSON
+	f, ok := value.(float64)
+	if !ok || f < 1 || f > math.MaxUint32 || f != math.Trunc(f) {
+		return 0, fmt.Errorf("%s must be a positive integer, got: %v", name, value)
+	}
+	return uint32(f), nil
+}
+
+// isIdempotentMethod follows RFC 9110 section 9.2.2
+func isIdempotentMethod(method fm.EndpointJSON_Method) bool {
+	switch method {
+	case fm.EndpointJSON_POST, fm.EndpointJSON_PATCH, fm.EndpointJSON_CONNECT:
+		return false
+	default:
+		return true
+	}
+}
@@ -0,0 +1,145 @@
+package openapiv3
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const specWithExtensions = `
+openapi: 3.0.0
+info: {title: extensions, version: 0.0.1}
+paths:
+  /pets:
+    get:
+      operationId: listPets
+      x-monkey-weight: 10
+      x-monkey-max-latency-ms: 250
+      responses:
+        '200': {description: OK}
+    post:
+      operationId: createPet
+      responses:
+        '201': {description: Created}
+    put:
+      operationId: replacePets
+      x-monkey-idempotent: false
+      responses:
+        '200': {description: OK}
+  /pets/{id}:
+    parameters:
+    - {name: id, in: path, required: true, schema: {type: string}}
+    patch:
+      operationId: updatePet
+      x-monkey-idempotent: true
+      responses:
+        '200': {description: OK}
+    delete:
+      operationId: deletePet
+      x-monkey-skip: true
+      responses:
+        '204': {description: Deleted}
+`
+
+func lintSpec(t *testing.T, spec string) (*oa3, error) {
+	docPath := filepath.Join(t.TempDir(), "spec.yml")
+	err := os.WriteFile(docPath, []byte(spec), 0644)
+	require.NoError(t, err)
+
+	m := &oa3{pb: &fm.Clt_Fuzz_Model_OpenAPIv3{File: docPath}}
+	err = m.Lint(context.Background(), false)
+	return m, err
+}
+
+func TestExtensionsAreReadIntoIR(t *testing.T) {
+	m, err := lintSpec(t, specWithExtensions)
+	require.NoError(t, err)
+
+	byOp := make(map[string]*fm.EndpointJSON)
+	for _, endpoint := range m.vald.Spec.Endpoints {
+		byOp[endpoint.GetJson().GetOperationId()] = endpoint.GetJson()
+	}
+	require.Len(t, byOp, 5)
+
+	require.EqualValues(t, 10, byOp["listPets"].GetWeight())
+	require.EqualValues(t, 250, byOp["listPets"].GetMaxLatencyMs())
+	require.True(t, byOp["listPets"].GetIdempotent())
+	require.False(t, byOp["listPets"].GetSkip())
+
+	require.Zero(t, byOp["createPet"].GetWeight())
+	require.Zero(t, byOp["createPet"].GetMaxLatencyMs())
+	require.False(t, byOp["createPet"].GetIdempotent())
+
+	require.False(t, byOp["replacePets"].GetIdempotent())
+	require.True(t, byOp["updatePet"].GetIdempotent())
+	require.True(t, byOp["deletePet"].GetSkip())
+}
+
+func TestSkippedEndpointsAreNotSelected(t *testing.T) {
+	m, err := lintSpec(t, specWithExtensions)
+	require.NoError(t, err)
+	require.Equal(t, []string{"createPet", "listPets", "replacePets", "updatePet"},
+		operationIDs(t, m))
+
+	_, err = m.FilterEndpoints([]string{"--only=op:deletePet"})
+	require.EqualError(t, err, "op:deletePet did not match any endpoints")
+}
+
+func TestMalformedExtensionsFailLint(t *testing.T) {
+	for ext, expected := range map[string]string{
+		"x-monkey-skip: yes please":     "x-monkey-skip must be a boolean, got: yes please",
+		"x-monkey-skip: 1":              "x-monkey-skip must be a boolean, got: 1",
+		"x-monkey-weight: heavy":        "x-monkey-weight must be a positive integer, got: heavy",
+		"x-monkey-weight: 0":            "x-monkey-weight must be a positive integer, got: 0",
+		"x-monkey-weight: 1.5":          "x-monkey-weight must be a positive integer, got: 1.5",
+		"x-monkey-max-latency-ms: -100": "x-monkey-max-latency-ms must be a positive integer, got: -100",
+		"x-monkey-idempotent: 'true'":   "x-monkey-idempotent must be a boolean, got: true",
+		"x-monkey-weigth: 2":            "unknown extension x-monkey-weigth",
+	} {
+		spec := `
+openapi: 3.0.0
+info: {title: extensions, version: 0.0.1}
+paths:
+  /pets:
+    get:
+      ` + ext + `
+      x-other-vendor: whatever
+      responses:
+        '200': {description: OK}
+`
+		_, err := lintSpec(t, spec)
+		require.EqualError(t, err, "GET /pets: "+expected, ext)
+	}
+}
+
+func TestCheckMaxLatency(t *testing.T) {
+	m := &oa3{tcap: &tCapHTTP{
+		endpoint: &fm.EndpointJSON{},
+		repProto: &fm.Clt_CallResponseRaw_Output_HttpResponse{
+			ElapsedNs: (300 * time.Millisecond).Nanoseconds(),
+		},
+	}}
+
+	s, skipped, f := m.checkMaxLatency()
+	require.Empty(t, s)
+	require.Equal(t, "no x-monkey-max-latency-ms for this endpoint", skipped)
+	require.Empty(t, f)
+
+	m.tcap.endpoint.MaxLatencyMs = 500
+	s, skipped, f = m.checkMaxLatency()
+	require.Equal(t, "response came in time", s)
+	require.Empty(t, skipped)
+	require.Empty(t, f)
+
+	m.tcap.endpoint.MaxLatencyMs = 250
+	s, skipped, f = m.checkMaxLatency()
+	require.Empty(t, s)
+	require.Empty(t, skipped)
+	require.Equal(t, []string{"response took 300ms, more than 250ms"}, f)
+}
@@ -0,0 +1,457 @@
+package openapiv3
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"math"
+	"math/rand"
+	"net"
+	"regexp/syntax"
+	"slices"
+	"sort"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/protovalue"
+)
+
+const (
+	// generateAttempts bounds how many candidates are tried per value
+	generateAttempts = 100
+	// generateMaxDepth is the nesting after which only required data is added
+	generateMaxDepth = 6
+	// generateSpread is how far above their minimum sizes & lengths may go
+	generateSpread = 8
+	// generateNumberRange is the range numbers are picked from, lacking bounds
+	generateNumberRange = 1000
+)
+
+const generateAlphabet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ0123456789"
+
+func (vald *validator) generateFromSchema(absRef string, rnd *rand.Rand) (value interface{}, err error) {
+	SID, ok := vald.Refs[absRef]
+	if !ok {
+		err = modeler.NewNoSuchRefError(absRef)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	schema, err := vald.compiledSchema(SID)
+	if err != nil {
+		return
+	}
+
+	g := &generator{sm: vald.Spec.Schemas.GetJson(), rnd: rnd}
+	for attempt := 1; attempt <= generateAttempts; attempt++ {
+		value = g.generate(SID, 0)
+
+		var res *gojsonschema.Result
+		if res, err = schema.Validate(gojsonschema.NewGoLoader(value)); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if res.Valid() {
+			log.Printf("[DBG] generated a value for %q after %d attempts", absRef, attempt)
+			return
+		}
+		log.Printf("[DBG] attempt #%d: generated %+.100v: %v", attempt, value, res.Errors())
+	}
+	value = nil
+	err = fmt.Errorf("could not generate a value valid against %q (tried %d times)", absRef, generateAttempts)
+	log.Println("[ERR]", err)
+	return
+}
+
+// generator produces values that (very likely) validate against a schema.
+// Candidates are checked against the actual validator by the caller,
+// which means combinations such as oneOf or not can be found by retrying.
+type generator struct {
+	sm  schemap
+	rnd *rand.Rand
+}
+
+func (g *generator) schema(SID sid) *fm.Schema_JSON {
+	for {
+		refOrSchema := g.sm[SID]
+		ptr := refOrSchema.GetPtr()
+		if ptr == nil {
+			return refOrSchema.GetSchema()
+		}
+		SID = ptr.GetSID()
+	}
+}
+
+func (g *generator) generate(SID sid, depth int) interface{} {
+	s := g.schema(SID)
+
+	if enum := s.GetEnum(); len(enum) != 0 {
+		return protovalue.ToGo(enum[g.rnd.Intn(len(enum))])
+	}
+
+	switch {
+	case len(s.GetAllOf()) != 0:
+		return g.generateAllOf(s, depth)
+	case len(s.GetOneOf()) != 0:
+		of := s.GetOneOf()
+		return g.generate(of[g.rnd.Intn(len(of))], depth)
+	case len(s.GetAnyOf()) != 0:
+		of := s.GetAnyOf()
+		return g.generate(of[g.rnd.Intn(len(of))], depth)
+	}
+
+	switch g.pickType(s) {
+	case fm.Schema_JSON_null:
+		return nil
+	case fm.Schema_JSON_boolean:
+		return g.rnd.Intn(2) == 0
+	case fm.Schema_JSON_integer:
+		return g.generateInteger(s)
+	case fm.Schema_JSON_number:
+		return g.generateNumber(s)
+	case fm.Schema_JSON_string:
+		return g.generateString(s)
+	case fm.Schema_JSON_array:
+		return g.generateArray(s, depth)
+	case fm.Schema_JSON_object:
+		return g.generateObject(s, depth)
+	default:
+		panic("unreachable")
+	}
+}
+
+func (g *generator) pickType(s *fm.Schema_JSON) fm.Schema_JSON_Type {
+	types := make([]fm.Schema_JSON_Type, 0, len(s.GetTypes()))
+	for _, t := range s.GetTypes() {
+		if t != fm.Schema_JSON_UNKNOWN && t != fm.Schema_JSON_any {
+			types = append(types, t)
+		}
+	}
+	if len(types) == 0 {
+		switch {
+		case len(s.GetProperties()) != 0 || len(s.GetRequired()) != 0:
+			return fm.Schema_JSON_object
+		case len(s.GetItems()) != 0:
+			return fm.Schema_JSON_array
+		case s.GetFormat() != "" || s.GetPattern() != "":
+			return fm.Schema_JSON_string
+		}
+		types = []fm.Schema_JSON_Type{
+			fm.Schema_JSON_boolean,
+			fm.Schema_JSON_integer,
+			fm.Schema_JSON_string,
+		}
+	}
+	if len(types) > 1 {
+		// Prefer non-null values
+		for i, t := range types {
+			if t == fm.Schema_JSON_null && g.rnd.Intn(4) != 0 {
+				types = append(types[:i:i], types[i+1:]...)
+				break
+			}
+		}
+	}
+	return types[g.rnd.Intn(len(types))]
+}
+
+func (g *generator) generateAllOf(s *fm.Schema_JSON, depth int) interface{} {
+	var merged map[string]interface{}
+	var last interface{}
+	for _, SID := range s.GetAllOf() {
+		last = g.generate(SID, depth)
+		if obj, ok := last.(map[string]interface{}); ok {
+			if merged == nil {
+				merged = make(map[string]interface{}, len(obj))
+			}
+			for k, v := range obj {
+				merged[k] = v
+			}
+		}
+	}
+	if merged != nil {
+		return merged
+	}
+	return last
+}
+
+func (g *generator) bounds(s *fm.Schema_JSON) (lo, hi float64) {
+	lo, hi = -generateNumberRange, generateNumberRange
+	switch {
+	case s.GetHasMinimum() && s.GetHasMaximum():
+		lo, hi = s.GetMinimum(), s.GetMaximum()
+	case s.GetHasMinimum():
+		lo, hi = s.GetMinimum(), s.GetMinimum()+generateNumberRange
+	case s.GetHasMaximum():
+		lo, hi = s.GetMaximum()-generateNumberRange, s.GetMaximum()
+	}
+	return
+}
+
+func (g *generator) generateInteger(s *fm.Schema_JSON) interface{} {
+	lo, hi := g.bounds(s)
+	min, max := int64(math.Ceil(lo)), int64(math.Floor(hi))
+	if s.GetExclusiveMinimum() && float64(min) == lo {
+		min++
+	}
+	if s.GetExclusiveMaximum() && float64(max) == hi {
+		max--
+	}
+	switch s.GetFormat() {
+	case "int32":
+		if min < math.MinInt32 {
+			min = math.MinInt32
+		}
+		if max > math.MaxInt32 {
+			max = math.MaxInt32
+		}
+	}
+
+	if mulOf := s.GetTranslatedMultipleOf() + 1.0; mulOf != 1.0 && mulOf == math.Trunc(mulOf) {
+		m := int64(mulOf)
+		kMin, kMax := int64(math.Ceil(float64(min)/mulOf)), int64(math.Floor(float64(max)/mulOf))
+		if kMax < kMin {
+			return float64(kMin * m)
+		}
+		return float64((kMin + g.rnd.Int63n(kMax-kMin+1)) * m)
+	}
+
+	if max < min {
+		return float64(min)
+	}
+	// Note: encoding/json & gojsonschema work with float64s
+	return float64(min + g.rnd.Int63n(max-min+1))
+}
+
+func (g *generator) generateNumber(s *fm.Schema_JSON) interface{} {
+	lo, hi := g.bounds(s)
+	if mulOf := s.GetTranslatedMultipleOf() + 1.0; mulOf != 1.0 {
+		kMin, kMax := math.Ceil(lo/mulOf), math.Floor(hi/mulOf)
+		if s.GetExclusiveMinimum() && kMin*mulOf == lo {
+			kMin++
+		}
+		if s.GetExclusiveMaximum() && kMax*mulOf == hi {
+			kMax--
+		}
+		if kMax < kMin {
+			return kMin * mulOf
+		}
+		return (kMin + float64(g.rnd.Int63n(int64(kMax-kMin)+1))) * mulOf
+	}
+	// Note: exclusive bounds are (almost surely) never hit
+	return lo + g.rnd.Float64()*(hi-lo)
+}
+
+func (g *generator) length(min, max uint64, hasMax bool) int {
+	if !hasMax || max > min+generateSpread {
+		max = min + generateSpread
+	}
+	if max < min {
+		return int(min)
+	}
+	return int(min) + g.rnd.Intn(int(max-min)+1)
+}
+
+func (g *generator) generateString(s *fm.Schema_JSON) interface{} {
+	if pattern := s.GetPattern(); pattern != "" {
+		if str, err := g.generateFromPattern(pattern); err == nil {
+			return str
+		}
+	}
+
+	switch s.GetFormat() {
+	case "date-time":
+		return g.randomTime().Format(time.RFC3339)
+	case "date":
+		return g.randomTime().Format("2006-01-02")
+	case "time":
+		return g.randomTime().Format("15:04:05Z07:00")
+	case "email":
+		return g.randomString(1+g.rnd.Intn(8), generateAlphabet) + "@example.com"
+	case "hostname":
+		return g.randomString(1+g.rnd.Intn(8), "abcdefghijklmnopqrstuvwxyz") + ".example.com"
+	case "uri", "url":
+		return "https://example.com/" + g.randomString(g.rnd.Intn(8), generateAlphabet)
+	case "uuid":
+		b := make([]byte, 16)
+		g.rnd.Read(b)
+		b[6] = (b[6] & 0x0f) | 0x40
+		b[8] = (b[8] & 0x3f) | 0x80
+		return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+	case "ipv4":
+		return net.IPv4(byte(g.rnd.Intn(256)), byte(g.rnd.Intn(256)), byte(g.rnd.Intn(256)), byte(g.rnd.Intn(256))).String()
+	case "ipv6":
+		b := make(net.IP, net.IPv6len)
+		g.rnd.Read(b)
+		return b.String()
+	case "byte":
+		b := make([]byte, g.length(s.GetMinLength()*3/4, s.GetMaxLength()*3/4, s.GetHasMaxLength()))
+		g.rnd.Read(b)
+		return base64.StdEncoding.EncodeToString(b)
+	}
+
+	n := g.length(s.GetMinLength(), s.GetMaxLength(), s.GetHasMaxLength())
+	return g.randomString(n, generateAlphabet)
+}
+
+func (g *generator) randomTime() time.Time {
+	const someDecades = 30 * 365 * 24 * time.Hour
+	since := time.Date(2000, time.January, 1, 0, 0, 0, 0, time.UTC)
+	return since.Add(time.Duration(g.rnd.Int63n(int64(someDecades)))).Truncate(time.Second)
+}
+
+func (g *generator) randomString(n int, alphabet string) string {
+	var b strings.Builder
+	for i := 0; i < n; i++ {
+		b.WriteByte(alphabet[g.rnd.Intn(len(alphabet))])
+	}
+	return b.String()
+}
+
+func (g *generator) generateFromPattern(pattern string) (string, error) {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return "", err
+	}
+	var b strings.Builder
+	g.writeRegexp(&b, re.Simplify())
+	return b.String(), nil
+}
+
+func (g *generator) writeRegexp(b *strings.Builder, re *syntax.Regexp) {
+	switch re.Op {
+	case syntax.OpLiteral:
+		for _, r := range re.Rune {
+			if re.Flags&syntax.FoldCase != 0 && g.rnd.Intn(2) == 0 {
+				r = unicode.SimpleFold(r)
+			}
+			b.WriteRune(r)
+		}
+	case syntax.OpCharClass:
+		// Rune holds pairs of inclusive ranges
+		var total int
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			total += int(re.Rune[i+1]-re.Rune[i]) + 1
+		}
+		if total == 0 {
+			return
+		}
+		pick := g.rnd.Intn(total)
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			size := int(re.Rune[i+1]-re.Rune[i]) + 1
+			if pick < size {
+				b.WriteRune(re.Rune[i] + rune(pick))
+				return
+			}
+			pick -= size
+		}
+	case syntax.OpAnyChar, syntax.OpAnyCharNotNL:
+		b.WriteByte(generateAlphabet[g.rnd.Intn(len(generateAlphabet))])
+	case syntax.OpCapture:
+		g.writeRegexp(b, re.Sub[0])
+	case syntax.OpConcat:
+		for _, sub := range re.Sub {
+			g.writeRegexp(b, sub)
+		}
+	case syntax.OpAlternate:
+		g.writeRegexp(b, re.Sub[g.rnd.Intn(len(re.Sub))])
+	case syntax.OpStar, syntax.OpPlus, syntax.OpQuest, syntax.OpRepeat:
+		min, max := re.Min, re.Max
+		switch re.Op {
+		case syntax.OpStar:
+			min, max = 0, -1
+		case syntax.OpPlus:
+			min, max = 1, -1
+		case syntax.OpQuest:
+			min, max = 0, 1
+		}
+		if max < 0 || max > min+generateSpread {
+			max = min + generateSpread
+		}
+		for n := min + g.rnd.Intn(max-min+1); n > 0; n-- {
+			g.writeRegexp(b, re.Sub[0])
+		}
+	default:
+		// Anchors, word boundaries, empty matches: nothing to write
+	}
+}
+
+func (g *generator) generateArray(s *fm.Schema_JSON, depth int) interface{} {
+	n := int(s.GetMinItems())
+	if depth < generateMaxDepth {
+		n = g.length(s.GetMinItems(), s.GetMaxItems(), s.GetHasMaxItems())
+	}
+	items := s.GetItems()
+
+	arr := make([]interface{}, 0, n)
+	seen := make(map[string]struct{}, n)
+	for attempt := 0; len(arr) < n && attempt < generateAttempts; attempt++ {
+		// SID 0 maps to no schema, so to any value
+		var itemSID sid
+		if len(items) != 0 {
+			itemSID = items[0]
+		}
+		item := g.generate(itemSID, depth+1)
+		if s.GetUniqueItems() {
+			key := canonicalJSON(item)
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+		}
+		arr = append(arr, item)
+	}
+	return arr
+}
+
+func (g *generator) generateObject(s *fm.Schema_JSON, depth int) interface{} {
+	props := s.GetProperties()
+	names := make([]string, 0, len(props))
+	for name := range props {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	obj := make(map[string]interface{}, len(names))
+	for _, name := range s.GetRequired() {
+		if SID, ok := props[name]; ok {
+			obj[name] = g.generate(SID, depth+1)
+		} else {
+			obj[name] = g.randomString(g.rnd.Intn(generateSpread), generateAlphabet)
+		}
+	}
+	if depth < generateMaxDepth {
+		for _, name := range names {
+			if _, ok := obj[name]; !ok && g.rnd.Intn(2) == 0 {
+				obj[name] = g.generate(props[name], depth+1)
+			}
+		}
+	}
+	for _, name := range names {
+		if uint64(len(obj)) >= s.GetMinProperties() {
+			break
+		}
+		if _, ok := obj[name]; !ok {
+			obj[name] = g.generate(props[name], depth+1)
+		}
+	}
+	for s.GetHasMaxProperties() && uint64(len(obj)) > s.GetMaxProperties() {
+		dropped := false
+		for _, name := range names {
+			if _, ok := obj[name]; ok && !slices.Contains(s.GetRequired(), name) {
+				delete(obj, name)
+				dropped = true
+				break
+			}
+		}
+		if !dropped {
+			break
+		}
+	}
+	return obj
+}
@@ -0,0 +1,124 @@
+package openapiv3
+
+import (
+	"context"
+	"encoding/json"
+	"math/rand"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/protovalue"
+)
+
+func TestGenerateFromSchemaOfSpecs(t *testing.T) {
+	pattern := filepath.Join("testdata", "specs", "openapi3", "*.*")
+	matches, err := filepath.Glob(pattern)
+	require.NoError(t, err)
+	require.NotEmpty(t, matches)
+	for _, docPath := range matches {
+		t.Run(docPath, func(t *testing.T) {
+			m := &oa3{pb: &fm.Clt_Fuzz_Model_OpenAPIv3{File: docPath}}
+			err := m.Lint(context.Background(), false)
+			require.NoError(t, err)
+
+			rnd := rand.New(rand.NewSource(42))
+			for absRef := range m.vald.Refs {
+				for i := 0; i < 10; i++ {
+					value, err := m.GenerateFromSchema(absRef, rnd)
+					require.NoError(t, err)
+					errs := m.Validate(m.vald.Refs[absRef], protovalue.FromGo(value))
+					require.Empty(t, errs)
+				}
+			}
+
+			_, err = m.GenerateFromSchema("#/components/schemas/NoSuchThing", rnd)
+			require.IsType(t, &modeler.NoSuchRefError{}, err)
+		})
+	}
+}
+
+func TestGenerateFromSchemaKeywords(t *testing.T) {
+	const doc = `
+openapi: 3.0.0
+info: {title: generated, version: 0.0.1}
+paths: {}
+components:
+  schemas:
+    Pattern: {type: string, pattern: '^[A-Z]{3}-[0-9]{2,4}(x|yz)?$'}
+    Formats:
+      type: object
+      required: [at, on, email, id, ip, url, blob]
+      properties:
+        at: {type: string, format: date-time}
+        on: {type: string, format: date}
+        email: {type: string, format: email}
+        id: {type: string, format: uuid}
+        ip: {type: string, format: ipv4}
+        url: {type: string, format: uri}
+        blob: {type: string, format: byte}
+    Bounded:
+      type: object
+      required: [i, n, s, a]
+      properties:
+        i: {type: integer, minimum: 10, maximum: 20, exclusiveMinimum: true, multipleOf: 3}
+        n: {type: number, minimum: -0.5, maximum: 0.5}
+        s: {type: string, minLength: 5, maxLength: 6}
+        a: {type: array, items: {type: integer, minimum: 0, maximum: 9}, minItems: 3, maxItems: 5, uniqueItems: true}
+    Nullable: {type: string, nullable: true, enum: [a, b, null]}
+    Composed:
+      allOf:
+      - $ref: '#/components/schemas/Bounded'
+      - type: object
+        required: [extra]
+        properties:
+          extra:
+            oneOf:
+            - {type: string, pattern: '^[a-f0-9]{8}$'}
+            - {type: boolean}
+    Tree:
+      type: object
+      required: [children]
+      properties:
+        children:
+          type: array
+          items: {$ref: '#/components/schemas/Tree'}
+`
+	docPath := filepath.Join(t.TempDir(), "spec.yml")
+	err := os.WriteFile(docPath, []byte(doc), 0644)
+	require.NoError(t, err)
+
+	m := &oa3{pb: &fm.Clt_Fuzz_Model_OpenAPIv3{File: docPath}}
+	err = m.Lint(context.Background(), false)
+	require.NoError(t, err)
+	require.Len(t, m.vald.Refs, 6)
+
+	for absRef := range m.vald.Refs {
+		t.Run(absRef, func(t *testing.T) {
+			rnd := rand.New(rand.NewSource(42))
+			var first []byte
+			for i := 0; i < 20; i++ {
+				value, err := m.GenerateFromSchema(absRef, rnd)
+				require.NoError(t, err)
+				errs := m.Validate(m.vald.Refs[absRef], protovalue.FromGo(value))
+				require.Empty(t, errs)
+				if i == 0 {
+					data, err := json.Marshal(value)
+					require.NoError(t, err)
+					first = data
+				}
+			}
+
+			t.Logf("same seed, same values")
+			value, err := m.GenerateFromSchema(absRef, rand.New(rand.NewSource(42)))
+			require.NoError(t, err)
+			data, err := json.Marshal(value)
+			require.NoError(t, err)
+			require.JSONEq(t, string(first), string(data))
+		})
+	}
+}
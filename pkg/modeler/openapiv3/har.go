@@ -0,0 +1,554 @@
+package openapiv3
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	openapi_v3 "github.com/google/gnostic/openapiv3"
+)
+
+// A HAR (HTTP Archive) file as exported by browsers' devtools or proxies.
+// See http://www.softwareishard.com/blog/har-12-spec/
+type harFile struct {
+	Log struct {
+		Entries []*harEntry `json:"entries"`
+	} `json:"log"`
+}
+
+type harEntry struct {
+	Request struct {
+		Method   string `json:"method"`
+		URL      string `json:"url"`
+		PostData *struct {
+			MimeType string `json:"mimeType"`
+			Text     string `json:"text"`
+		} `json:"postData"`
+	} `json:"request"`
+	Response struct {
+		Status  int `json:"status"`
+		Content struct {
+			MimeType string `json:"mimeType"`
+			Text     string `json:"text"`
+			Encoding string `json:"encoding"`
+		} `json:"content"`
+	} `json:"response"`
+}
+
+// minDistinctToTemplate is how many distinct values a path segment must take
+// (all else being equal) to be considered a path parameter.
+const minDistinctToTemplate = 3
+
+// minHexIDLen is how long a hexadecimal path segment must be to look like
+// an ID (e.g. an object ID or a hash) rather than a word such as "cafe".
+const minHexIDLen = 8
+
+var (
+	reNumID = regexp.MustCompile(`^[0-9]+$`)
+	reHexID = regexp.MustCompile(fmt.Sprintf(`^[0-9a-fA-F]{%d,}$`, minHexIDLen))
+	reUUID  = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	reWords = regexp.MustCompile(`[a-zA-Z0-9]+`)
+)
+
+// InferFromHAR builds a starter OpenAPIv3 spec out of recorded HTTP traffic.
+// Also returns the most used base URL.
+func InferFromHAR(r io.Reader, title string) (spec []byte, host string, err error) {
+	var har harFile
+	if err = json.NewDecoder(r).Decode(&har); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	log.Printf("[NFO] read %d HAR entries", len(har.Log.Entries))
+
+	var entries []*harEntry
+	var urls []*url.URL
+	hosts := make(map[string]int)
+	for _, entry := range har.Log.Entries {
+		var u *url.URL
+		if u, err = url.Parse(entry.Request.URL); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		entries = append(entries, entry)
+		urls = append(urls, u)
+		hosts[u.Scheme+"://"+u.Host]++
+	}
+	if len(entries) == 0 {
+		err = fmt.Errorf("no requests recorded in HAR")
+		log.Println("[ERR]", err)
+		return
+	}
+	for h, count := range hosts {
+		if count > hosts[host] || (count == hosts[host] && h < host) {
+			host = h
+		}
+	}
+
+	templates := clusterPaths(urls)
+
+	ops := make(map[string]*harOperation)
+	for i, entry := range entries {
+		if u := urls[i]; u.Scheme+"://"+u.Host == host {
+			tmpl := templates[u.Path]
+			key := strings.ToLower(entry.Request.Method) + " " + tmpl.path()
+			op, ok := ops[key]
+			if !ok {
+				op = newHarOperation(entry.Request.Method, tmpl)
+				ops[key] = op
+			}
+			op.add(entry, u)
+		}
+	}
+	if skipped := len(entries) - hosts[host]; skipped != 0 {
+		log.Printf("[NFO] skipped %d HAR entries for hosts other than %s", skipped, host)
+	}
+
+	doc := &openapi3.T{
+		OpenAPI: "3.0.0",
+		Info: &openapi3.Info{
+			Title:   title,
+			Version: "0.0.1",
+		},
+		Servers:    openapi3.Servers{{URL: host}},
+		Paths:      openapi3.NewPaths(),
+		Components: &openapi3.Components{Schemas: make(openapi3.Schemas)},
+	}
+
+	keys := make([]string, 0, len(ops))
+	for key := range ops {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	opIDs := make(map[string]struct{}, len(keys))
+	for _, key := range keys {
+		op := ops[key]
+		opID := op.operationID()
+		for i := 2; ; i++ {
+			if _, ok := opIDs[opID]; !ok {
+				break
+			}
+			opID = fmt.Sprintf("%s%d", op.operationID(), i)
+		}
+		opIDs[opID] = struct{}{}
+
+		path := op.tmpl.path()
+		item := doc.Paths.Value(path)
+		if item == nil {
+			item = &openapi3.PathItem{}
+			doc.Paths.Set(path, item)
+		}
+		item.SetOperation(op.method, op.toOA3(opID, doc.Components.Schemas))
+	}
+
+	var blob []byte
+	if blob, err = json.Marshal(doc); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	var pretty *openapi_v3.Document
+	if pretty, err = openapi_v3.ParseDocument(blob); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	spec, err = pretty.YAMLValue("Inferred from recorded traffic: please review!")
+	return
+}
+
+// pathTemplate is a URL path split on slashes where path parameters are named
+type pathTemplate struct {
+	segments []string
+	params   map[int]string
+}
+
+func (pt *pathTemplate) path() string {
+	segments := make([]string, 0, len(pt.segments))
+	for i, segment := range pt.segments {
+		if name, ok := pt.params[i]; ok {
+			segment = "{" + name + "}"
+		}
+		segments = append(segments, segment)
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// clusterPaths maps each URL path to a template, guessing which segments are
+// parameters: ID-looking ones and those that vary across otherwise-equal paths.
+func clusterPaths(urls []*url.URL) map[string]*pathTemplate {
+	templates := make(map[string]*pathTemplate, len(urls))
+	for _, u := range urls {
+		if _, ok := templates[u.Path]; ok {
+			continue
+		}
+		tmpl := &pathTemplate{
+			segments: strings.Split(strings.Trim(u.Path, "/"), "/"),
+			params:   make(map[int]string),
+		}
+		for i, segment := range tmpl.segments {
+			if isIDLike(segment) {
+				tmpl.params[i] = ""
+			}
+		}
+		templates[u.Path] = tmpl
+	}
+
+	for changed := true; changed; {
+		changed = false
+		siblings := make(map[string]map[string][]*pathTemplate)
+		for _, tmpl := range templates {
+			for i := range tmpl.segments {
+				if _, ok := tmpl.params[i]; ok {
+					continue
+				}
+				blanked := &pathTemplate{segments: tmpl.segments, params: map[int]string{i: ""}}
+				for j := range tmpl.params {
+					blanked.params[j] = ""
+				}
+				key := strconv.Itoa(i) + blanked.path()
+				if siblings[key] == nil {
+					siblings[key] = make(map[string][]*pathTemplate)
+				}
+				siblings[key][tmpl.segments[i]] = append(siblings[key][tmpl.segments[i]], tmpl)
+			}
+		}
+		for key, values := range siblings {
+			if len(values) < minDistinctToTemplate {
+				continue
+			}
+			i, _ := strconv.Atoi(key[:strings.IndexByte(key, '/')])
+			for _, tmpls := range values {
+				for _, tmpl := range tmpls {
+					tmpl.params[i] = ""
+					changed = true
+				}
+			}
+		}
+	}
+
+	for _, tmpl := range templates {
+		names := make(map[string]struct{}, len(tmpl.params))
+		for i := range tmpl.segments {
+			if _, ok := tmpl.params[i]; !ok {
+				continue
+			}
+			name := "id"
+			if _, isParam := tmpl.params[i-1]; i > 0 && !isParam {
+				name = lowerFirst(camelCase(strings.TrimSuffix(tmpl.segments[i-1], "s"))) + "Id"
+			}
+			uniq := name
+			for n := 2; ; n++ {
+				if _, ok := names[uniq]; !ok {
+					break
+				}
+				uniq = fmt.Sprintf("%s%d", name, n)
+			}
+			names[uniq] = struct{}{}
+			tmpl.params[i] = uniq
+		}
+	}
+	return templates
+}
+
+// isIDLike tells whether a path segment looks like an identifier:
+// a number, a UUID or a long enough hexadecimal string with a digit.
+func isIDLike(segment string) bool {
+	return reNumID.MatchString(segment) ||
+		reUUID.MatchString(segment) ||
+		(reHexID.MatchString(segment) && strings.ContainsAny(segment, "0123456789"))
+}
+
+type harOperation struct {
+	method  string
+	tmpl    *pathTemplate
+	samples int
+
+	pathParams map[string]*inferredSchema
+	query      map[string]*inferredSchema
+	queried    map[string]int // requests carrying each query parameter
+	body       *inferredSchema
+	responses  map[int]*inferredSchema
+}
+
+func newHarOperation(method string, tmpl *pathTemplate) *harOperation {
+	return &harOperation{
+		method:     strings.ToUpper(method),
+		tmpl:       tmpl,
+		pathParams: make(map[string]*inferredSchema),
+		query:      make(map[string]*inferredSchema),
+		queried:    make(map[string]int),
+		body:       &inferredSchema{},
+		responses:  make(map[int]*inferredSchema),
+	}
+}
+
+func (op *harOperation) add(entry *harEntry, u *url.URL) {
+	op.samples++
+
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	for i, name := range op.tmpl.params {
+		if op.pathParams[name] == nil {
+			op.pathParams[name] = &inferredSchema{}
+		}
+		op.pathParams[name].addString(segments[i])
+	}
+
+	for name, values := range u.Query() {
+		if op.query[name] == nil {
+			op.query[name] = &inferredSchema{}
+		}
+		op.queried[name]++
+		for _, value := range values {
+			op.query[name].addString(value)
+		}
+	}
+
+	if data := entry.Request.PostData; data != nil && isJSONMime(data.MimeType) {
+		var v interface{}
+		if err := json.Unmarshal([]byte(data.Text), &v); err == nil {
+			op.body.add(v)
+		}
+	}
+
+	rep := op.responses[entry.Response.Status]
+	if rep == nil {
+		rep = &inferredSchema{}
+		op.responses[entry.Response.Status] = rep
+	}
+	if content := entry.Response.Content; content.Encoding == "" && isJSONMime(content.MimeType) {
+		var v interface{}
+		if err := json.Unmarshal([]byte(content.Text), &v); err == nil {
+			rep.add(v)
+		}
+	}
+}
+
+func isJSONMime(mime string) bool {
+	return strings.HasPrefix(mime, mimeJSON) || strings.Contains(strings.SplitN(mime, ";", 2)[0], "+json")
+}
+
+func (op *harOperation) operationID() string {
+	var b strings.Builder
+	b.WriteString(strings.ToLower(op.method))
+	var by []string
+	for i, segment := range op.tmpl.segments {
+		if name, ok := op.tmpl.params[i]; ok {
+			by = append(by, camelCase(name))
+			continue
+		}
+		b.WriteString(camelCase(segment))
+	}
+	if len(by) != 0 {
+		b.WriteString("By")
+		b.WriteString(strings.Join(by, "And"))
+	}
+	return b.String()
+}
+
+func (op *harOperation) toOA3(opID string, components openapi3.Schemas) *openapi3.Operation {
+	docOp := openapi3.NewOperation()
+	docOp.OperationID = opID
+	docOp.Summary = fmt.Sprintf("Seen %d times", op.samples)
+
+	names := make([]string, 0, len(op.pathParams))
+	for name := range op.pathParams {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		param := openapi3.NewPathParameter(name)
+		param.Schema = op.pathParams[name].toOA3().NewRef()
+		docOp.AddParameter(param)
+	}
+
+	names = names[:0]
+	for name := range op.query {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		param := openapi3.NewQueryParameter(name)
+		param.Required = op.queried[name] == op.samples
+		param.Schema = op.query[name].toOA3().NewRef()
+		docOp.AddParameter(param)
+	}
+
+	if op.body.samples != 0 {
+		name := camelCase(opID) + "Request"
+		components[name] = op.body.toOA3().NewRef()
+		docOp.RequestBody = &openapi3.RequestBodyRef{Value: openapi3.NewRequestBody().
+			WithRequired(op.body.samples == op.samples).
+			WithJSONSchemaRef(openapi3.NewSchemaRef(oa3ComponentsSchemas+name, nil))}
+	}
+
+	docOp.Responses = openapi3.NewResponses()
+	docOp.Responses.Delete("default")
+	codes := make([]int, 0, len(op.responses))
+	for code := range op.responses {
+		codes = append(codes, code)
+	}
+	sort.Ints(codes)
+	for _, code := range codes {
+		rep := openapi3.NewResponse().WithDescription("Seen without a JSON body")
+		if op.responses[code].samples != 0 {
+			rep.WithDescription(fmt.Sprintf("Seen %d times", op.responses[code].samples))
+			name := fmt.Sprintf("%sResponse%d", camelCase(opID), code)
+			components[name] = op.responses[code].toOA3().NewRef()
+			rep.WithContent(openapi3.NewContentWithJSONSchemaRef(openapi3.NewSchemaRef(oa3ComponentsSchemas+name, nil)))
+		}
+		docOp.AddResponse(code, rep)
+	}
+	return docOp
+}
+
+// inferredSchema accumulates JSON values and describes them as a schema
+type inferredSchema struct {
+	samples int
+
+	nulls, bools, integers, numbers, strings, arrays, objects int
+
+	dateTimes, uuids int
+
+	items *inferredSchema
+
+	props     map[string]*inferredSchema
+	propNames []string
+}
+
+func (is *inferredSchema) addString(s string) {
+	switch {
+	case s == "true" || s == "false":
+		is.add(s == "true")
+	default:
+		if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+			is.add(float64(i))
+		} else if f, err := strconv.ParseFloat(s, 64); err == nil {
+			is.add(f)
+		} else {
+			is.add(s)
+		}
+	}
+}
+
+func (is *inferredSchema) add(v interface{}) {
+	is.samples++
+	switch x := v.(type) {
+	case nil:
+		is.nulls++
+	case bool:
+		is.bools++
+	case float64:
+		if x == float64(int64(x)) {
+			is.integers++
+		} else {
+			is.numbers++
+		}
+	case string:
+		is.strings++
+		if _, err := time.Parse(time.RFC3339, x); err == nil {
+			is.dateTimes++
+		}
+		if reUUID.MatchString(x) {
+			is.uuids++
+		}
+	case []interface{}:
+		is.arrays++
+		if is.items == nil {
+			is.items = &inferredSchema{}
+		}
+		for _, item := range x {
+			is.items.add(item)
+		}
+	case map[string]interface{}:
+		is.objects++
+		if is.props == nil {
+			is.props = make(map[string]*inferredSchema, len(x))
+		}
+		for name, prop := range x {
+			if is.props[name] == nil {
+				is.props[name] = &inferredSchema{}
+				is.propNames = append(is.propNames, name)
+			}
+			is.props[name].add(prop)
+		}
+	}
+}
+
+func (is *inferredSchema) toOA3() *openapi3.Schema {
+	var schemas []*openapi3.Schema
+	if is.bools != 0 {
+		schemas = append(schemas, openapi3.NewBoolSchema())
+	}
+	switch {
+	case is.numbers != 0:
+		schemas = append(schemas, openapi3.NewFloat64Schema())
+	case is.integers != 0:
+		schemas = append(schemas, openapi3.NewIntegerSchema())
+	}
+	if is.strings != 0 {
+		s := openapi3.NewStringSchema()
+		switch is.strings {
+		case is.dateTimes:
+			s.Format = "date-time"
+		case is.uuids:
+			s.Format = "uuid"
+		}
+		schemas = append(schemas, s)
+	}
+	if is.arrays != 0 {
+		s := openapi3.NewArraySchema()
+		s.Items = openapi3.NewSchema().NewRef()
+		if is.items != nil && is.items.samples != 0 {
+			s.Items = is.items.toOA3().NewRef()
+		}
+		schemas = append(schemas, s)
+	}
+	if is.objects != 0 {
+		s := openapi3.NewObjectSchema()
+		sort.Strings(is.propNames)
+		for _, name := range is.propNames {
+			prop := is.props[name]
+			s.Properties[name] = prop.toOA3().NewRef()
+			if prop.samples == is.objects {
+				s.Required = append(s.Required, name)
+			}
+		}
+		schemas = append(schemas, s)
+	}
+
+	var schema *openapi3.Schema
+	switch len(schemas) {
+	case 0:
+		schema = openapi3.NewSchema()
+	case 1:
+		schema = schemas[0]
+	default:
+		schema = openapi3.NewAnyOfSchema(schemas...)
+	}
+	if is.nulls != 0 {
+		schema.Nullable = true
+	}
+	return schema
+}
+
+func camelCase(s string) string {
+	var b strings.Builder
+	for _, word := range reWords.FindAllString(s, -1) {
+		b.WriteString(strings.ToUpper(word[:1]))
+		b.WriteString(word[1:])
+	}
+	return b.String()
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,105 @@
+package openapiv3
+
+import (
+	"bytes"
+	"log"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInferFromHAR(t *testing.T) {
+	har := `{"log":{"entries":[
+{"request":{"method":"GET","url":"https://api.example.com/posts?limit=10"},
+ "response":{"status":200,"content":{"mimeType":"application/json","text":"[{\"id\":1,\"at\":\"2020-01-01T00:00:00Z\"},{\"id\":2,\"at\":\"2020-01-01T00:00:00Z\",\"tags\":[\"x\"]}]"}}},
+{"request":{"method":"GET","url":"https://api.example.com/posts/1"},
+ "response":{"status":200,"content":{"mimeType":"application/json","text":"{\"id\":1,\"score\":null}"}}},
+{"request":{"method":"GET","url":"https://api.example.com/posts/2"},
+ "response":{"status":404,"content":{"mimeType":"text/plain","text":"nope"}}},
+{"request":{"method":"POST","url":"https://api.example.com/posts","postData":{"mimeType":"application/json","text":"{\"title\":\"c\"}"}},
+ "response":{"status":201,"content":{"mimeType":"application/json; charset=utf-8","text":"{\"id\":3,\"score\":1.5}"}}},
+{"request":{"method":"GET","url":"https://api.example.com/users/alice/profile"},"response":{"status":200,"content":{}}},
+{"request":{"method":"GET","url":"https://api.example.com/users/bob/profile"},"response":{"status":200,"content":{}}},
+{"request":{"method":"GET","url":"https://api.example.com/users/carol/profile"},"response":{"status":200,"content":{}}},
+{"request":{"method":"GET","url":"https://cdn.example.com/logo.png"},"response":{"status":200,"content":{}}}
+]}}`
+
+	spec, host, err := InferFromHAR(strings.NewReader(har), "recorded")
+	require.NoError(t, err)
+	require.Equal(t, "https://api.example.com", host)
+
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	require.NoError(t, err)
+	require.Equal(t, "recorded", doc.Info.Title)
+	require.ElementsMatch(t, []string{
+		"/posts",
+		"/posts/{postId}",
+		"/users/{userId}/profile",
+	}, doc.Paths.InMatchingOrder())
+
+	op := doc.Paths.Value("/posts").Get
+	require.Equal(t, "getPosts", op.OperationID)
+	require.Len(t, op.Parameters, 1)
+	require.Equal(t, "limit", op.Parameters[0].Value.Name)
+	require.True(t, op.Parameters[0].Value.Required)
+	require.True(t, op.Parameters[0].Value.Schema.Value.Type.Is(openapi3.TypeInteger))
+
+	items := doc.Components.Schemas["GetPostsResponse200"].Value.Items.Value
+	require.Equal(t, []string{"at", "id"}, items.Required)
+	require.Equal(t, "date-time", items.Properties["at"].Value.Format)
+	require.True(t, items.Properties["tags"].Value.Items.Value.Type.Is(openapi3.TypeString))
+
+	op = doc.Paths.Value("/posts/{postId}").Get
+	require.Equal(t, "getPostsByPostId", op.OperationID)
+	require.Equal(t, "postId", op.Parameters[0].Value.Name)
+	require.NotNil(t, op.Responses.Status(404))
+	require.Nil(t, op.Responses.Status(404).Value.Content)
+	require.True(t, doc.Components.Schemas["GetPostsByPostIdResponse200"].Value.Properties["score"].Value.Nullable)
+
+	op = doc.Paths.Value("/posts").Post
+	require.True(t, op.RequestBody.Value.Required)
+	require.Equal(t, "#/components/schemas/PostPostsRequest", op.RequestBody.Value.Content.Get(mimeJSON).Schema.Ref)
+	require.True(t, doc.Components.Schemas["PostPostsResponse201"].Value.Properties["score"].Value.Type.Is(openapi3.TypeNumber))
+
+	op = doc.Paths.Value("/users/{userId}/profile").Get
+	require.Equal(t, "getUsersProfileByUserId", op.OperationID)
+	require.True(t, op.Parameters[0].Value.Schema.Value.Type.Is(openapi3.TypeString))
+}
+
+func TestInferFromHARQueryAndHexWords(t *testing.T) {
+	har := `{"log":{"entries":[
+{"request":{"method":"GET","url":"https://api.example.com/cafe/menu?tag=a&tag=b"},"response":{"status":200,"content":{}}},
+{"request":{"method":"GET","url":"https://api.example.com/cafe/menu?page=2"},"response":{"status":200,"content":{}}},
+{"request":{"method":"GET","url":"https://api.example.com/beef/e2e"},"response":{"status":200,"content":{}}},
+{"request":{"method":"GET","url":"https://api.example.com/items/5f2b9c1a7e"},"response":{"status":200,"content":{}}},
+{"request":{"method":"GET","url":"https://cdn.example.com/logo.png"},"response":{"status":200,"content":{}}},
+{"request":{"method":"GET","url":"https://cdn.example.com/font.woff"},"response":{"status":200,"content":{}}}
+]}}`
+
+	var logs bytes.Buffer
+	log.SetOutput(&logs)
+	defer log.SetOutput(os.Stderr)
+
+	spec, host, err := InferFromHAR(strings.NewReader(har), "recorded")
+	require.NoError(t, err)
+	require.Equal(t, "https://api.example.com", host)
+	require.Contains(t, logs.String(), "[NFO] skipped 2 HAR entries for hosts other than https://api.example.com")
+
+	doc, err := openapi3.NewLoader().LoadFromData(spec)
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{
+		"/cafe/menu",
+		"/beef/e2e",
+		"/items/{itemId}",
+	}, doc.Paths.InMatchingOrder())
+
+	// A key repeated within one request still counts as seen once
+	op := doc.Paths.Value("/cafe/menu").Get
+	require.Len(t, op.Parameters, 2)
+	for _, param := range op.Parameters {
+		require.False(t, param.Value.Required, param.Value.Name)
+	}
+}
@@ -1,6 +1,7 @@
 package openapiv3
 
 import (
+	"fmt"
 	"log"
 	"sort"
 	"strconv"
@@ -20,7 +21,11 @@ const (
 func newSpecFromOA3(doc *openapi3.T) (vald *validator, err error) {
 	log.Println("[DBG] normalizing spec from OpenAPIv3")
 
-	docPaths, docSchemas := doc.Paths, doc.Components.Schemas
+	docPaths := doc.Paths
+	var docSchemas openapi3.Schemas
+	if doc.Components != nil {
+		docSchemas = doc.Components.Schemas
+	}
 	vald = newValidator(docPaths.Len(), len(docSchemas))
 	log.Println("[DBG] seeding schemas")
 	//TODO: use docPath as root of base
@@ -35,7 +40,11 @@ func newSpecFromOA3(doc *openapi3.T) (vald *validator, err error) {
 		return
 	}
 	log.Println("[DBG] going through endpoints")
-	vald.endpointsFromOA3(basePath, docPaths)
+	if err = vald.endpointsFromOA3(basePath, docPaths); err != nil {
+		log.Println("[ERR]", err)
+		as.ColorERR.Println(err)
+		return
+	}
 	return
 }
 
@@ -47,7 +56,7 @@ func (vald *validator) schemasFromOA3(docSchemas map[string]*openapi3.SchemaRef)
 	return vald.seed(oa3ComponentsSchemas, schemas)
 }
 
-func (vald *validator) endpointsFromOA3(basePath string, docPaths *openapi3.Paths) {
+func (vald *validator) endpointsFromOA3(basePath string, docPaths *openapi3.Paths) error {
 	paths := make([]string, 0, docPaths.Len())
 	for path := range docPaths.Map() {
 		paths = append(paths, path)
@@ -81,18 +90,24 @@ func (vald *validator) endpointsFromOA3(basePath string, docPaths *openapi3.Path
 			}
 			outputs := vald.outputsFromOA3(docOp.Responses)
 			method := methodFromOA3(docMethod)
+			endpoint := &fm.EndpointJSON{
+				Method:       method,
+				PathPartials: pathFromOA3(basePath, path),
+				Inputs:       inputs,
+				Outputs:      outputs,
+				OperationId:  docOp.OperationID,
+				Tags:         docOp.Tags,
+				Deprecated:   docOp.Deprecated,
+			}
+			if err := extensionsFromOA3(docOp, endpoint); err != nil {
+				return fmt.Errorf("%s %s: %v", docMethod, path, err)
+			}
 			vald.Spec.Endpoints[eid(i)] = &fm.Endpoint{
-				Endpoint: &fm.Endpoint_Json{
-					Json: &fm.EndpointJSON{
-						Method:       method,
-						PathPartials: pathFromOA3(basePath, path),
-						Inputs:       inputs,
-						Outputs:      outputs,
-					},
-				},
+				Endpoint: &fm.Endpoint_Json{Json: endpoint},
 			}
 		}
 	}
+	return nil
 }
 
 func (vald *validator) inputBodyFromOA3(inputs *[]*fm.ParamJSON, docReqBody *openapi3.RequestBodyRef) {
@@ -172,7 +187,12 @@ func (vald *validator) outputsFromOA3(docResponses *openapi3.Responses) (
 		}
 		//FIXME: handle .Ref
 		for mime, ct := range responseRef.Value.Content {
-			if mime == mimeJSON {
+			if _, ok := outputs[xxx]; ok && mime != mimeJSON {
+				// JSON has precedence
+				continue
+			}
+			// NOTE: schemas of streams describe each of their events
+			if mime == mimeJSON || isStreamMediaType(mime) {
 				docSchema := ct.Schema
 				if docSchema == nil {
 					outputs[xxx] = 0
@@ -150,6 +150,10 @@ func (sm schemap) endpointsToOA3(doc *openapi3.T, es map[eid]*fm.Endpoint) {
 			RequestBody: reqBody,
 			Parameters:  params,
 			Responses:   sm.outputsToOA3(endpoint.GetOutputs()),
+			OperationID: endpoint.GetOperationId(),
+			Tags:        endpoint.GetTags(),
+			Deprecated:  endpoint.GetDeprecated(),
+			Extensions:  extensionsToOA3(endpoint),
 		}
 		pathItem := &openapi3.PathItem{}
 		methodToOA3(endpoint.GetMethod(), op, pathItem)
@@ -158,6 +162,23 @@ func (sm schemap) endpointsToOA3(doc *openapi3.T, es map[eid]*fm.Endpoint) {
 	doc.Paths = openapi3.NewPaths(paths...)
 }
 
+func extensionsToOA3(endpoint *fm.EndpointJSON) map[string]interface{} {
+	exts := make(map[string]interface{})
+	if endpoint.GetSkip() {
+		exts[extSkip] = true
+	}
+	if weight := endpoint.GetWeight(); weight != 0 {
+		exts[extWeight] = float64(weight)
+	}
+	if maxLatencyMs := endpoint.GetMaxLatencyMs(); maxLatencyMs != 0 {
+		exts[extMaxLatencyMs] = float64(maxLatencyMs)
+	}
+	if idempotent := endpoint.GetIdempotent(); idempotent != isIdempotentMethod(endpoint.GetMethod()) {
+		exts[extIdempotent] = idempotent
+	}
+	return exts
+}
+
 func (sm schemap) inputBodyToOA3(inputs []*fm.ParamJSON) (reqBodyRef *openapi3.RequestBodyRef) {
 	if len(inputs) > 0 {
 		body := inputs[0]
@@ -12,6 +12,7 @@ import (
 	"github.com/getkin/kin-openapi/openapi3"
 	openapi_v3 "github.com/google/gnostic/openapiv3"
 
+	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 )
 
@@ -56,11 +57,48 @@ func (m *oa3) Lint(ctx context.Context, showSpec bool) (err error) {
 		return
 	}
 
+	if m.server != "" || len(m.serverVariables) != 0 {
+		log.Println("[NFO] selecting server")
+		var serverURL string
+		if serverURL, err = selectServer(doc.Servers, m.server, m.serverVariables); err != nil {
+			log.Println("[ERR]", err)
+			as.ColorERR.Println(err)
+			return
+		}
+		log.Printf("[NFO] selected server %q", serverURL)
+		doc.Servers = openapi3.Servers{{URL: serverURL}}
+
+		if m.pb.Host == "" {
+			if u, e := url.Parse(serverURL); e == nil && u.IsAbs() {
+				m.pb.Host = u.Scheme + "://" + u.Host
+			}
+		}
+	}
+
 	log.Println("[NFO] last validation pass")
 	if m.vald, err = newSpecFromOA3(doc); err != nil {
 		return
 	}
 
+	if len(m.endpoints) != 0 {
+		log.Println("[NFO] selecting endpoints")
+		if err = m.vald.selectEndpoints(m.vald.describeEndpoints(), m.endpoints); err != nil {
+			as.ColorERR.Println(err)
+			return
+		}
+	}
+
+	log.Println("[NFO] compiling schemas")
+	if err = m.vald.compile(); err != nil {
+		return
+	}
+
+	log.Println("[NFO] setting up HTTP transport")
+	if m.roundTripper, err = m.transport.newTransport(); err != nil {
+		return
+	}
+	m.ResetSession()
+
 	log.Println("[NFO] model is valid")
 	return
 }
@@ -1,10 +1,17 @@
 package openapiv3
 
 import (
+	"fmt"
 	"io"
 	"log"
+	"math/rand"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"time"
 
 	"go.starlark.net/starlark"
+	"golang.org/x/net/publicsuffix"
 	"google.golang.org/protobuf/types/known/structpb"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
@@ -18,14 +25,53 @@ const Name = "openapi3"
 // New instanciates a new model
 func New(kwargs []starlark.Tuple) (modeler.Interface, error) {
 	var lot struct {
-		name, file, host, headerAuthorization starlark.String
+		name, file, host, headerAuthorization  starlark.String
+		caBundle, clientCert, clientKey, proxy starlark.String
+		unixSocket, http2, server              starlark.String
+		serverVariables                        *starlark.Dict
+		endpoints                              *starlark.List
+		insecureSkipVerify                     bool
+		reuseConnections, cookies              bool
+		timeoutMs, followRedirects             int
+		rateLimit                              starlark.Value
+		maxInFlight, maxRetries                int
+		maxRetryWaitMs                         int
+		maxStreamEvents, maxStreamDurationMs   int
+		maxBodyBytes                           int
 	}
+	lot.reuseConnections = true
+	lot.cookies = true
+	lot.maxRetryWaitMs = 60 * 1000
+	lot.maxStreamEvents = 100
+	lot.maxStreamDurationMs = 10 * 1000
+	lot.maxBodyBytes = 10 << 20
 	if err := starlark.UnpackArgs(Name, nil, kwargs,
 		"name", &lot.name,
 		"file", &lot.file,
 		// NOTE: all args following an optional? are implicitly optional.
 		"host??", &lot.host,
 		"header_authorization??", &lot.headerAuthorization, //FIXME: drop
+		"ca_bundle??", &lot.caBundle,
+		"client_cert??", &lot.clientCert,
+		"client_key??", &lot.clientKey,
+		"insecure_skip_verify??", &lot.insecureSkipVerify,
+		"proxy??", &lot.proxy,
+		"unix_socket??", &lot.unixSocket,
+		"http2??", &lot.http2,
+		"reuse_connections??", &lot.reuseConnections,
+		"timeout_ms??", &lot.timeoutMs,
+		"follow_redirects??", &lot.followRedirects,
+		"cookies??", &lot.cookies,
+		"rate_limit??", &lot.rateLimit,
+		"max_in_flight??", &lot.maxInFlight,
+		"max_retries??", &lot.maxRetries,
+		"max_retry_wait_ms??", &lot.maxRetryWaitMs,
+		"server??", &lot.server,
+		"server_variables??", &lot.serverVariables,
+		"endpoints??", &lot.endpoints,
+		"max_stream_events??", &lot.maxStreamEvents,
+		"max_stream_duration_ms??", &lot.maxStreamDurationMs,
+		"max_body_bytes??", &lot.maxBodyBytes,
 	); err != nil {
 		log.Println("[ERR]", err)
 		return nil, err
@@ -40,12 +86,111 @@ func New(kwargs []starlark.Tuple) (modeler.Interface, error) {
 		return nil, err
 	}
 
+	var proxy *url.URL
+	if p := lot.proxy.GoString(); p != "" {
+		var err error
+		if proxy, err = url.ParseRequestURI(p); err != nil {
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+	}
+
+	var serverVariables map[string]string
+	if lot.serverVariables != nil {
+		serverVariables = make(map[string]string, lot.serverVariables.Len())
+		for _, kv := range lot.serverVariables.Items() {
+			k, okK := kv[0].(starlark.String)
+			v, okV := kv[1].(starlark.String)
+			if !okK || !okV {
+				err := fmt.Errorf("server_variables must map strings to strings, got: %s = %s", kv[0].String(), kv[1].String())
+				log.Println("[ERR]", err)
+				return nil, err
+			}
+			serverVariables[k.GoString()] = v.GoString()
+		}
+	}
+
+	var endpoints []string
+	if lot.endpoints != nil {
+		endpoints = make([]string, 0, lot.endpoints.Len())
+		for i := 0; i < lot.endpoints.Len(); i++ {
+			selector, ok := lot.endpoints.Index(i).(starlark.String)
+			if !ok {
+				err := fmt.Errorf("endpoints must be a list of strings, got: %s", lot.endpoints.Index(i).String())
+				log.Println("[ERR]", err)
+				return nil, err
+			}
+			endpoints = append(endpoints, selector.GoString())
+		}
+		if err := checkEndpointSelectors(endpoints); err != nil {
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+	}
+
+	var rateLimit float64
+	if lot.rateLimit != nil {
+		var ok bool
+		if rateLimit, ok = starlark.AsFloat(lot.rateLimit); !ok || rateLimit < 0 {
+			err := fmt.Errorf("rate_limit must be a positive amount of requests per second, got: %s", lot.rateLimit.String())
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+	}
+	for _, kv := range []struct {
+		name  string
+		value int
+	}{
+		{"follow_redirects", lot.followRedirects},
+		{"max_in_flight", lot.maxInFlight},
+		{"max_retries", lot.maxRetries},
+		{"max_retry_wait_ms", lot.maxRetryWaitMs},
+		{"max_stream_events", lot.maxStreamEvents},
+		{"max_stream_duration_ms", lot.maxStreamDurationMs},
+		{"max_body_bytes", lot.maxBodyBytes},
+	} {
+		if kv.value < 0 {
+			err := fmt.Errorf("%s must be positive, got: %d", kv.name, kv.value)
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+	}
+
 	// verify all
 
+	transport := transportOptions{
+		caBundle:           lot.caBundle.GoString(),
+		clientCert:         lot.clientCert.GoString(),
+		clientKey:          lot.clientKey.GoString(),
+		insecureSkipVerify: lot.insecureSkipVerify,
+		proxy:              proxy,
+		unixSocket:         lot.unixSocket.GoString(),
+		http2:              lot.http2.GoString(),
+		disableKeepAlives:  !lot.reuseConnections,
+		timeout:            time.Duration(lot.timeoutMs) * time.Millisecond,
+	}
+	if err := transport.check(); err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
 	// assemble
 
 	m := &oa3{
-		name: name,
+		name:            name,
+		transport:       transport,
+		server:          lot.server.GoString(),
+		serverVariables: serverVariables,
+		endpoints:       endpoints,
+		maxRedirects:    lot.followRedirects,
+		cookies:         lot.cookies,
+		pacing: newPacing(rateLimit, lot.maxInFlight,
+			lot.maxRetries, time.Duration(lot.maxRetryWaitMs)*time.Millisecond),
+		stream: streamLimits{
+			maxEvents:   lot.maxStreamEvents,
+			maxDuration: time.Duration(lot.maxStreamDurationMs) * time.Millisecond,
+		},
+		maxBodyBytes: int64(lot.maxBodyBytes),
 		pb: &fm.Clt_Fuzz_Model_OpenAPIv3{
 			File: lot.file.GoString(),
 			Host: lot.host.GoString(),
@@ -65,6 +210,19 @@ type oa3 struct {
 
 	vald *validator
 
+	server          string
+	serverVariables map[string]string
+	endpoints       []string
+
+	transport    transportOptions
+	roundTripper http.RoundTripper
+	maxRedirects int
+	cookies      bool
+	jar          http.CookieJar
+	pacing       *pacing
+	stream       streamLimits
+	maxBodyBytes int64
+
 	tcap *tCapHTTP
 }
 
@@ -87,19 +245,39 @@ func (m *oa3) InputsCount() int {
 
 // FilterEndpoints restricts which API endpoints are considered
 func (m *oa3) FilterEndpoints(args []string) ([]eid, error) {
-	return m.vald.filterEndpoints(args)
+	return m.vald.filterEndpoints(m.endpoints, args)
 }
 
-func (m *oa3) Validate(SID sid, data *structpb.Value) []string {
+// Validate checks a value against the schema with the given SID
+func (m *oa3) Validate(SID sid, data *structpb.Value) []*modeler.ValidationError {
 	return m.vald.Validate(SID, data)
 }
 
 // ValidateAgainstSchema tries to smash the data through the given keyhole
-func (m *oa3) ValidateAgainstSchema(absRef string, data []byte) error {
+func (m *oa3) ValidateAgainstSchema(absRef string, data []byte) ([]*modeler.ValidationError, error) {
 	return m.vald.validateAgainstSchema(absRef, data)
 }
 
+// ExpandRef completes a schema name (e.g. Pet) into an absolute $ref
+func (m *oa3) ExpandRef(name string) string {
+	return m.vald.expandRef(name)
+}
+
+// GenerateFromSchema produces a random value that validates against absRef
+func (m *oa3) GenerateFromSchema(absRef string, rnd *rand.Rand) (interface{}, error) {
+	return m.vald.generateFromSchema(absRef, rnd)
+}
+
 // WriteAbsoluteReferences pretty-prints the API's named types
 func (m *oa3) WriteAbsoluteReferences(w io.Writer) {
 	m.vald.writeAbsoluteReferences(w)
 }
+
+// ResetSession forgets cookies set by previous calls
+func (m *oa3) ResetSession() {
+	m.jar = nil
+	if m.cookies {
+		// NOTE: cookiejar.New never fails
+		m.jar, _ = cookiejar.New(&cookiejar.Options{PublicSuffixList: publicsuffix.List})
+	}
+}
@@ -0,0 +1,65 @@
+package openapiv3
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+)
+
+// selectServer picks a server by its description, its URL or its index
+// then substitutes its variables. Unset variables take their default value.
+func selectServer(servers openapi3.Servers, name string, vars map[string]string) (string, error) {
+	if len(servers) == 0 {
+		return "", fmt.Errorf("spec defines no servers to choose from")
+	}
+
+	server := servers[0]
+	if name != "" {
+		server = nil
+		for i, s := range servers {
+			if strings.EqualFold(s.Description, name) || s.URL == name || strconv.Itoa(i) == name {
+				server = s
+				break
+			}
+		}
+		if server == nil {
+			known := make([]string, 0, len(servers))
+			for i, s := range servers {
+				known = append(known, fmt.Sprintf("%d: %q (%s)", i, s.Description, s.URL))
+			}
+			return "", fmt.Errorf("no server matches %q, choose from:\n  %s", name, strings.Join(known, "\n  "))
+		}
+	}
+
+	for varName := range vars {
+		if _, ok := server.Variables[varName]; !ok {
+			return "", fmt.Errorf("server %q has no variable %q", server.URL, varName)
+		}
+	}
+
+	names := make([]string, 0, len(server.Variables))
+	for varName := range server.Variables {
+		names = append(names, varName)
+	}
+	sort.Strings(names)
+	uri := server.URL
+	for _, varName := range names {
+		svar := server.Variables[varName]
+		value, ok := vars[varName]
+		if !ok {
+			value = svar.Default
+		}
+		if len(svar.Enum) != 0 && !slices.Contains(svar.Enum, value) {
+			if !ok {
+				return "", fmt.Errorf("server variable %q defaults to %q which is not one of %q", varName, value, svar.Enum)
+			}
+			return "", fmt.Errorf("server variable %q must be one of %q, got: %q", varName, svar.Enum, value)
+		}
+		uri = strings.ReplaceAll(uri, "{"+varName+"}", value)
+	}
+	return uri, nil
+}
@@ -0,0 +1,104 @@
+package openapiv3
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const specWithServers = `
+openapi: 3.0.0
+info: {title: servers, version: 0.0.1}
+servers:
+- url: http://localhost:{port}/v1
+  description: local
+  variables:
+    port: {default: '8080'}
+- url: https://{env}.example.com/{version}
+  description: Staging
+  variables:
+    env: {default: staging, enum: [staging, preprod]}
+    version: {default: v2}
+- url: https://{region}.example.com
+  description: broken
+  variables:
+    region: {default: moon, enum: [eu, us]}
+paths:
+  /items:
+    get:
+      responses:
+        '200': {description: OK}
+`
+
+func lintWithServer(t *testing.T, host, server string, vars map[string]string) (*oa3, error) {
+	docPath := filepath.Join(t.TempDir(), "spec.yml")
+	err := os.WriteFile(docPath, []byte(specWithServers), 0644)
+	require.NoError(t, err)
+
+	m := &oa3{
+		pb:              &fm.Clt_Fuzz_Model_OpenAPIv3{File: docPath, Host: host},
+		server:          server,
+		serverVariables: vars,
+	}
+	err = m.Lint(context.Background(), false)
+	return m, err
+}
+
+func pathOf(m *oa3) string {
+	for _, endpoint := range m.vald.Spec.Endpoints {
+		return endpoint.GetJson().GetPathPartials()[0].GetPart()
+	}
+	return ""
+}
+
+func TestServerDefaultsToFirstOne(t *testing.T) {
+	m, err := lintWithServer(t, "", "", nil)
+	require.NoError(t, err)
+	require.Equal(t, "", m.pb.Host)
+	require.Equal(t, "/v1/items", pathOf(m))
+}
+
+func TestServerSelection(t *testing.T) {
+	for _, server := range []string{"staging", "1", "https://{env}.example.com/{version}"} {
+		m, err := lintWithServer(t, "", server, nil)
+		require.NoError(t, err)
+		require.Equal(t, "https://staging.example.com", m.pb.Host)
+		require.Equal(t, "/v2/items", pathOf(m))
+	}
+
+	m, err := lintWithServer(t, "", "Staging", map[string]string{"env": "preprod", "version": "v3"})
+	require.NoError(t, err)
+	require.Equal(t, "https://preprod.example.com", m.pb.Host)
+	require.Equal(t, "/v3/items", pathOf(m))
+
+	m, err = lintWithServer(t, "http://127.0.0.1:3000", "local", map[string]string{"port": "9090"})
+	require.NoError(t, err)
+	require.Equal(t, "http://127.0.0.1:3000", m.pb.Host, "host has precedence")
+	require.Equal(t, "/v1/items", pathOf(m))
+}
+
+func TestServerSelectionErrors(t *testing.T) {
+	_, err := lintWithServer(t, "", "prod", nil)
+	require.EqualError(t, err, `no server matches "prod", choose from:
+  0: "local" (http://localhost:{port}/v1)
+  1: "Staging" (https://{env}.example.com/{version})
+  2: "broken" (https://{region}.example.com)`)
+
+	_, err = lintWithServer(t, "", "staging", map[string]string{"env": "prod"})
+	require.EqualError(t, err, `server variable "env" must be one of ["staging" "preprod"], got: "prod"`)
+
+	_, err = lintWithServer(t, "", "", map[string]string{"env": "staging"})
+	require.EqualError(t, err, `server "http://localhost:{port}/v1" has no variable "env"`)
+
+	_, err = lintWithServer(t, "", "broken", nil)
+	require.EqualError(t, err, `server variable "region" defaults to "moon" which is not one of ["eu" "us"]`)
+
+	m, err := lintWithServer(t, "", "broken", map[string]string{"region": "eu"})
+	require.NoError(t, err)
+	require.Equal(t, "https://eu.example.com", m.pb.Host)
+}
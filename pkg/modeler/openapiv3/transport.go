@@ -0,0 +1,145 @@
+package openapiv3
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"golang.org/x/net/http2"
+)
+
+const (
+	http2Auto  = "auto"
+	http2Never = "never"
+	http2H2C   = "h2c"
+)
+
+// transportOptions configure how the caller reaches the SUT
+type transportOptions struct {
+	caBundle           string
+	clientCert         string
+	clientKey          string
+	insecureSkipVerify bool
+	proxy              *url.URL
+	unixSocket         string
+	http2              string
+	disableKeepAlives  bool
+	timeout            time.Duration
+}
+
+func (o *transportOptions) check() error {
+	switch o.http2 {
+	case "":
+		o.http2 = http2Auto
+	case http2Auto, http2Never, http2H2C:
+	default:
+		return fmt.Errorf("http2 must be one of %q, %q or %q, got: %q", http2Auto, http2Never, http2H2C, o.http2)
+	}
+	if (o.clientCert == "") != (o.clientKey == "") {
+		return errors.New("client_cert and client_key must be given together")
+	}
+	if o.http2 == http2H2C {
+		if o.proxy != nil {
+			return errors.New("proxy cannot be used with h2c")
+		}
+		if o.caBundle != "" || o.clientCert != "" || o.insecureSkipVerify {
+			return errors.New("h2c is cleartext: TLS options cannot be used with it")
+		}
+	}
+	if o.timeout < 0 {
+		return fmt.Errorf("timeout_ms must be positive, got: %d", o.timeout.Milliseconds())
+	}
+	return nil
+}
+
+func (o *transportOptions) tlsConfig() (cfg *tls.Config, err error) {
+	cfg = &tls.Config{
+		InsecureSkipVerify: o.insecureSkipVerify || os.Getenv("FUZZYMONKEY_SSL_NO_VERIFY") == "1",
+	}
+
+	if o.caBundle != "" {
+		var pem []byte
+		if pem, err = os.ReadFile(o.caBundle); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if cfg.RootCAs, err = x509.SystemCertPool(); err != nil {
+			log.Println("[NFO] no system cert pool:", err)
+			cfg.RootCAs = x509.NewCertPool()
+		}
+		if !cfg.RootCAs.AppendCertsFromPEM(pem) {
+			err = fmt.Errorf("no PEM certificates found in ca_bundle %q", o.caBundle)
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+
+	if o.clientCert != "" {
+		var cert tls.Certificate
+		if cert, err = tls.LoadX509KeyPair(o.clientCert, o.clientKey); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		cfg.Certificates = []tls.Certificate{cert}
+	}
+	return
+}
+
+func (o *transportOptions) dialContext() func(ctx context.Context, network, addr string) (net.Conn, error) {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	if o.unixSocket == "" {
+		return dialer.DialContext
+	}
+	return func(ctx context.Context, _, _ string) (net.Conn, error) {
+		return dialer.DialContext(ctx, "unix", o.unixSocket)
+	}
+}
+
+// newTransport builds the http.RoundTripper shared by all calls
+func (o *transportOptions) newTransport() (http.RoundTripper, error) {
+	if o.http2 == http2H2C {
+		dial := o.dialContext()
+		return &http2.Transport{
+			AllowHTTP: true,
+			DialTLSContext: func(ctx context.Context, network, addr string, _ *tls.Config) (net.Conn, error) {
+				return dial(ctx, network, addr)
+			},
+		}, nil
+	}
+
+	tlsConfig, err := o.tlsConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	// TODO: stricter/smaller timeouts https://pkg.go.dev/github.com/asecurityteam/transport#Option
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = func(req *http.Request) (*url.URL, error) {
+		// NOTE: environment variables are not read so runs stay reproducible
+		return o.proxy, nil
+	}
+	t.DialContext = o.dialContext()
+	t.ForceAttemptHTTP2 = o.http2 == http2Auto
+	if o.http2 == http2Never {
+		// A non-nil empty map disables HTTP/2
+		t.TLSNextProto = make(map[string]func(string, *tls.Conn) http.RoundTripper)
+	}
+	t.DisableKeepAlives = o.disableKeepAlives
+	t.MaxIdleConns = 100
+	t.IdleConnTimeout = 90 * time.Second
+	t.TLSHandshakeTimeout = 10 * time.Second
+	t.ExpectContinueTimeout = 1 * time.Second
+	t.TLSClientConfig = tlsConfig
+	return t, nil
+}
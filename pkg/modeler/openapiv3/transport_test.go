@@ -0,0 +1,105 @@
+package openapiv3
+
+import (
+	"encoding/pem"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"golang.org/x/net/http2"
+	"golang.org/x/net/http2/h2c"
+)
+
+func get(t *testing.T, rt http.RoundTripper, url string) (*http.Response, string) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	require.NoError(t, err)
+	rep, err := rt.RoundTrip(req)
+	require.NoError(t, err)
+	defer rep.Body.Close()
+	body, err := io.ReadAll(rep.Body)
+	require.NoError(t, err)
+	return rep, string(body)
+}
+
+var hello = http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+	_, _ = io.WriteString(w, r.Proto)
+})
+
+func TestTransportCABundle(t *testing.T) {
+	srv := httptest.NewTLSServer(hello)
+	defer srv.Close()
+
+	o := &transportOptions{}
+	require.NoError(t, o.check())
+	rt, err := o.newTransport()
+	require.NoError(t, err)
+	req, err := http.NewRequest(http.MethodGet, srv.URL, nil)
+	require.NoError(t, err)
+	_, err = rt.RoundTrip(req)
+	require.Error(t, err, "self-signed certificates are not trusted by default")
+
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	blob := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: srv.Certificate().Raw})
+	err = os.WriteFile(caBundle, blob, 0644)
+	require.NoError(t, err)
+
+	o = &transportOptions{caBundle: caBundle, http2: http2Never}
+	require.NoError(t, o.check())
+	rt, err = o.newTransport()
+	require.NoError(t, err)
+	_, proto := get(t, rt, srv.URL)
+	require.Equal(t, "HTTP/1.1", proto)
+
+	o = &transportOptions{insecureSkipVerify: true}
+	require.NoError(t, o.check())
+	rt, err = o.newTransport()
+	require.NoError(t, err)
+	_, proto = get(t, rt, srv.URL)
+	require.Equal(t, "HTTP/1.1", proto)
+}
+
+func TestTransportCABundleWithoutPEM(t *testing.T) {
+	caBundle := filepath.Join(t.TempDir(), "ca.pem")
+	err := os.WriteFile(caBundle, []byte("nope"), 0644)
+	require.NoError(t, err)
+
+	o := &transportOptions{caBundle: caBundle}
+	require.NoError(t, o.check())
+	_, err = o.newTransport()
+	require.EqualError(t, err, `no PEM certificates found in ca_bundle "`+caBundle+`"`)
+}
+
+func TestTransportUnixSocket(t *testing.T) {
+	sock := filepath.Join(t.TempDir(), "sut.sock")
+	l, err := net.Listen("unix", sock)
+	require.NoError(t, err)
+	srv := &http.Server{Handler: hello, ReadHeaderTimeout: time.Second}
+	go func() { _ = srv.Serve(l) }()
+	defer srv.Close()
+
+	o := &transportOptions{unixSocket: sock, disableKeepAlives: true}
+	require.NoError(t, o.check())
+	rt, err := o.newTransport()
+	require.NoError(t, err)
+	rep, proto := get(t, rt, "http://localhost/some/path")
+	require.Equal(t, http.StatusOK, rep.StatusCode)
+	require.Equal(t, "HTTP/1.1", proto)
+}
+
+func TestTransportH2C(t *testing.T) {
+	srv := httptest.NewServer(h2c.NewHandler(hello, &http2.Server{}))
+	defer srv.Close()
+
+	o := &transportOptions{http2: http2H2C}
+	require.NoError(t, o.check())
+	rt, err := o.newTransport()
+	require.NoError(t, err)
+	_, proto := get(t, rt, srv.URL)
+	require.Equal(t, "HTTP/2.0", proto)
+}
@@ -0,0 +1,139 @@
+package openapiv3
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+)
+
+// gojsonschema error types to the JSON Schema keywords they come from
+var keywordOfErrorType = map[string]string{
+	"additional_property_not_allowed": "additionalProperties",
+	"array_max_items":                 "maxItems",
+	"array_max_properties":            "maxProperties",
+	"array_min_items":                 "minItems",
+	"array_min_properties":            "minProperties",
+	"array_no_additional_items":       "additionalItems",
+	"condition_else":                  "else",
+	"condition_then":                  "then",
+	"invalid_property_name":           "propertyNames",
+	"invalid_property_pattern":        "patternProperties",
+	"invalid_type":                    "type",
+	"missing_dependency":              "dependencies",
+	"multiple_of":                     "multipleOf",
+	"number_all_of":                   "allOf",
+	"number_any_of":                   "anyOf",
+	"number_gt":                       "exclusiveMinimum",
+	"number_gte":                      "minimum",
+	"number_lt":                       "exclusiveMaximum",
+	"number_lte":                      "maximum",
+	"number_not":                      "not",
+	"number_one_of":                   "oneOf",
+	"string_gte":                      "minLength",
+	"string_lte":                      "maxLength",
+	"unique":                          "uniqueItems",
+}
+
+func (vald *validator) validationErrors(SID sid, res *gojsonschema.Result) []*modeler.ValidationError {
+	resErrs := res.Errors()
+	errs := make([]*modeler.ValidationError, 0, len(resErrs))
+	for _, e := range resErrs {
+		keyword := e.Type()
+		if kw, ok := keywordOfErrorType[keyword]; ok {
+			keyword = kw
+		}
+
+		var tokens []string
+		if ctx := e.Context(); ctx != nil {
+			// Delimit with an unlikely string as keys may contain dots
+			tokens = strings.Split(ctx.String("\x00"), "\x00")[1:] // Drops "(root)"
+		}
+
+		ref, path := vald.locate(SID, tokens)
+		errs = append(errs, &modeler.ValidationError{
+			Pointer:     jsonPointer(tokens),
+			Keyword:     keyword,
+			SchemaRef:   ref,
+			SchemaPath:  path + "/" + keyword,
+			Value:       e.Value(),
+			Description: e.Description(),
+		})
+	}
+	return errs
+}
+
+func jsonPointer(tokens []string) string {
+	var b strings.Builder
+	for _, token := range tokens {
+		b.WriteByte('/')
+		b.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return b.String()
+}
+
+// locate walks the schema down following the path to a part of a value.
+// Returns the closest named schema and the path to the subschema from there.
+func (vald *validator) locate(SID sid, tokens []string) (ref, path string) {
+	sm := vald.Spec.Schemas.GetJson()
+	follow := func(SID sid) sid {
+		for sm[SID].GetPtr() != nil {
+			SID = sm[SID].GetPtr().GetSID()
+		}
+		if r, ok := vald.refOfSID[SID]; ok {
+			ref, path = r, ""
+		}
+		return SID
+	}
+
+	SID = follow(SID)
+	for len(tokens) != 0 {
+		next, at, consumed := vald.childSchema(SID, tokens[0])
+		if at == "" {
+			break
+		}
+		if consumed {
+			tokens = tokens[1:]
+		}
+		path += at
+		SID = follow(next)
+	}
+	return
+}
+
+// childSchema finds the subschema for the given object key or array index,
+// or the composed subschema that leads to it.
+func (vald *validator) childSchema(SID sid, token string) (child sid, at string, consumed bool) {
+	sm := vald.Spec.Schemas.GetJson()
+	schema := sm[SID].GetSchema()
+	if SID, ok := schema.GetProperties()[token]; ok {
+		return SID, "/properties/" + jsonPointer([]string{token})[1:], true
+	}
+	if items := schema.GetItems(); len(items) != 0 {
+		if _, err := strconv.Atoi(token); err == nil {
+			return items[0], "/items", true
+		}
+	}
+
+	for _, composed := range []struct {
+		keyword string
+		SIDs    []sid
+	}{
+		{"allOf", schema.GetAllOf()},
+		{"anyOf", schema.GetAnyOf()},
+		{"oneOf", schema.GetOneOf()},
+	} {
+		for i, of := range composed.SIDs {
+			resolved := of
+			for sm[resolved].GetPtr() != nil {
+				resolved = sm[resolved].GetPtr().GetSID()
+			}
+			if _, at, _ := vald.childSchema(resolved, token); at != "" {
+				return of, "/" + composed.keyword + "/" + strconv.Itoa(i), false
+			}
+		}
+	}
+	return
+}
@@ -6,9 +6,10 @@ import (
 	"fmt"
 	"io"
 	"log"
-	"regexp"
 	"sort"
+	"strconv"
 	"strings"
+	"sync"
 
 	"github.com/xeipuuv/gojsonschema"
 	"google.golang.org/protobuf/types/known/structpb"
@@ -19,10 +20,20 @@ import (
 	"github.com/FuzzyMonkeyCo/monkey/pkg/protovalue"
 )
 
+// sidRefPrefix prefixes the refs under which every schema gets compiled
+const sidRefPrefix = "#/x-monkey/SIDs/"
+
 type validator struct {
 	Spec *fm.SpecIR
 	Refs map[string]sid
-	Refd *gojsonschema.SchemaLoader
+
+	// compiled caches schemas compiled by loader.
+	// The loader knows of all (named or not) schemas.
+	compiledMu sync.Mutex
+	compiled   map[sid]*gojsonschema.Schema
+	loader     *gojsonschema.SchemaLoader
+	// refOfSID maps SIDs of named schemas to their $ref
+	refOfSID map[sid]string
 }
 
 func newValidator(capaEndpoints, capaSchemas int) *validator {
@@ -32,7 +43,6 @@ func newValidator(capaEndpoints, capaSchemas int) *validator {
 			Endpoints: make(map[eid]*fm.Endpoint, capaEndpoints),
 			Schemas:   &fm.Schemas{Json: make(map[sid]*fm.RefOrSchemaJSON, capaSchemas)},
 		},
-		Refd: gojsonschema.NewSchemaLoader(),
 	}
 }
 
@@ -62,12 +72,6 @@ func (vald *validator) seed(base string, schemas schemasJSON) (err error) {
 		schema := schemas[name]
 		log.Printf("[DBG] seeding schema '%s'", absRef)
 
-		sl := gojsonschema.NewGoLoader(schema)
-		if err = vald.Refd.AddSchema(absRef, sl); err != nil {
-			log.Println("[ERR]", err)
-			return
-		}
-
 		sid := vald.ensureMapped("", schema)
 		if sid == 0 {
 			err = fmt.Errorf("unexpectedly empty SID of schema %q", absRef)
@@ -351,11 +355,11 @@ func (sm schemap) toGo(SID sid) (s schemaJSON) {
 	}
 	// "exclusiveMinimum"
 	if schemaExclusiveMinimum := schema.GetExclusiveMinimum(); schemaExclusiveMinimum {
-		s["exclusiveMin"] = schemaExclusiveMinimum
+		s["exclusiveMinimum"] = schemaExclusiveMinimum
 	}
 	// "exclusiveMaximum"
 	if schemaExclusiveMaximum := schema.GetExclusiveMaximum(); schemaExclusiveMaximum {
-		s["exclusiveMax"] = schemaExclusiveMaximum
+		s["exclusiveMaximum"] = schemaExclusiveMaximum
 	}
 	// "multipleOf"
 	if mulOf := schema.GetTranslatedMultipleOf(); mulOf != 0.0 {
@@ -385,7 +389,7 @@ func (sm schemap) toGo(SID sid) (s schemaJSON) {
 
 	// "minProperties"
 	if schemaMinProps := schema.GetMinProperties(); schemaMinProps != 0 {
-		s["minProps"] = schemaMinProps
+		s["minProperties"] = schemaMinProps
 	}
 	// "maxProperties"
 	if schema.GetHasMaxProperties() {
@@ -439,7 +443,7 @@ func (sm schemap) toGo(SID sid) (s schemaJSON) {
 	return
 }
 
-func (vald *validator) filterEndpoints(args []string) (eids []eid, err error) {
+func (vald *validator) filterEndpoints(selectors, args []string) (eids []eid, err error) {
 	// TODO? filter on 2nd, 3rd, ... -level schemas
 	// instead of just first level (ref A references B & C)
 
@@ -447,23 +451,12 @@ func (vald *validator) filterEndpoints(args []string) (eids []eid, err error) {
 	// https://github.com/kubernetes/kubernetes/blob/c0d9a0728ce5920f97fecab977be15636e57126b/staging/src/k8s.io/cli-runtime/pkg/genericclioptions/printers/jsonpath.go#L143
 	// https://github.com/kubernetes/kubernetes/blob/103813057c5ef6cc416e6fdb71515e90d98cd3a9/staging/src/k8s.io/cli-runtime/pkg/genericclioptions/printers/template.go#L85
 
-	const fmtMPIO = "%s\t%s\t%s ➜ %s"
 	total := len(vald.Spec.Endpoints)
-	all := make(map[eid]string, total)
-	for eid := range vald.Spec.Endpoints {
-		e := vald.Spec.Endpoints[eid].GetJson()
-		path := pathToOA3(e.PathPartials)
-		inputs := make([]sid, 0, len(e.Inputs))
-		for _, param := range e.Inputs {
-			inputs = append(inputs, param.SID)
-		}
-		ins := strings.Join(vald.refsFromSIDs(inputs), " | ")
-		outputs := make([]sid, 0, len(e.Outputs))
-		for _, SID := range e.Outputs {
-			outputs = append(outputs, SID)
-		}
-		outs := strings.Join(vald.refsFromSIDs(outputs), " | ")
-		all[eid] = fmt.Sprintf(fmtMPIO, e.Method, path, ins, outs)
+	all := vald.describeEndpoints()
+
+	if err = vald.selectEndpoints(all, selectors); err != nil {
+		// Error printed in main
+		return
 	}
 
 	{
@@ -478,7 +471,7 @@ func (vald *validator) filterEndpoints(args []string) (eids []eid, err error) {
 				l := len(p)
 				if len(arg) > l && p == arg[0:l] {
 					argz = append(argz, []string{p[0 : l-1], arg[l:]}...)
-					break outter
+					continue outter
 				}
 			}
 			argz = append(argz, arg)
@@ -491,17 +484,17 @@ func (vald *validator) filterEndpoints(args []string) (eids []eid, err error) {
 		i++
 		switch cmd {
 		case "--only":
-			err = filterEndpoints(all, true, args[i])
+			err = vald.filterEndpointsBy(all, true, args[i])
 		case "--except":
-			err = filterEndpoints(all, false, args[i])
+			err = vald.filterEndpointsBy(all, false, args[i])
 		case "--calls-with-input":
-			err = filterEndpoints(all, true, "^[^\t]+\t[^\t]+\t([^\t]*"+args[i]+"[^\t]*) ➜ [^$]*$")
+			err = vald.filterEndpointsMatching(all, true, "^[^\t]+\t[^\t]+\t([^\t]*"+args[i]+"[^\t]*) ➜ [^$]*$")
 		case "--calls-without-input":
-			err = filterEndpoints(all, false, "^[^\t]+\t[^\t]+\t([^\t]*"+args[i]+"[^\t]*) ➜ [^$]*$")
+			err = vald.filterEndpointsMatching(all, false, "^[^\t]+\t[^\t]+\t([^\t]*"+args[i]+"[^\t]*) ➜ [^$]*$")
 		case "--calls-with-output":
-			err = filterEndpoints(all, true, "^[^\t]+\t[^\t]+\t[^\t]* ➜ ([^\t]*"+args[i]+"[^\t]*)$")
+			err = vald.filterEndpointsMatching(all, true, "^[^\t]+\t[^\t]+\t[^\t]* ➜ ([^\t]*"+args[i]+"[^\t]*)$")
 		case "--calls-without-output":
-			err = filterEndpoints(all, false, "^[^\t]+\t[^\t]+\t[^\t]* ➜ ([^\t]*"+args[i]+"[^\t]*)$")
+			err = vald.filterEndpointsMatching(all, false, "^[^\t]+\t[^\t]+\t[^\t]* ➜ ([^\t]*"+args[i]+"[^\t]*)$")
 		default:
 			i--
 		}
@@ -533,16 +526,56 @@ func (vald *validator) filterEndpoints(args []string) (eids []eid, err error) {
 	return
 }
 
-func filterEndpoints(all map[eid]string, only bool, pattern string) (err error) {
-	var re *regexp.Regexp
-	if re, err = regexp.Compile(pattern); err != nil {
+// describeEndpoints summarizes endpoints as "METHOD\tPATH\tINPUTS ➜ OUTPUTS"
+// leaving out the ones marked x-monkey-skip.
+func (vald *validator) describeEndpoints() map[eid]string {
+	const fmtMPIO = "%s\t%s\t%s ➜ %s"
+	all := make(map[eid]string, len(vald.Spec.Endpoints))
+	for eid := range vald.Spec.Endpoints {
+		e := vald.Spec.Endpoints[eid].GetJson()
+		path := pathToOA3(e.PathPartials)
+		if e.GetSkip() {
+			log.Printf("[NFO] skipping %s %s as per %s", e.Method, path, extSkip)
+			continue
+		}
+		inputs := make([]sid, 0, len(e.Inputs))
+		for _, param := range e.Inputs {
+			inputs = append(inputs, param.SID)
+		}
+		ins := strings.Join(vald.refsFromSIDs(inputs), " | ")
+		outputs := make([]sid, 0, len(e.Outputs))
+		for _, SID := range e.Outputs {
+			outputs = append(outputs, SID)
+		}
+		outs := strings.Join(vald.refsFromSIDs(outputs), " | ")
+		all[eid] = fmt.Sprintf(fmtMPIO, e.Method, path, ins, outs)
+	}
+	return all
+}
+
+// filterEndpointsBy applies an --only or --except selector
+func (vald *validator) filterEndpointsBy(all map[eid]string, only bool, selector string) error {
+	sel, err := newEndpointSelector(selector)
+	if err != nil {
 		log.Println("[ERR]", err)
-		return
+		return err
 	}
+	return vald.filterEndpointsWith(all, only, selector, sel)
+}
 
+func (vald *validator) filterEndpointsMatching(all map[eid]string, only bool, pattern string) error {
+	sel, err := newRegexpSelector(pattern)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return err
+	}
+	return vald.filterEndpointsWith(all, only, pattern, sel)
+}
+
+func (vald *validator) filterEndpointsWith(all map[eid]string, only bool, pattern string, sel endpointSelector) (err error) {
 	onlyMatched := false
 	for eid, e := range all {
-		if re.MatchString(e) {
+		if sel(vald.Spec.Endpoints[eid].GetJson(), e) {
 			log.Println("[DBG]", pattern, "matched", e)
 			onlyMatched = true
 			if !only {
@@ -581,8 +614,16 @@ func (vald *validator) writeAbsoluteReferences(w io.Writer) {
 	}
 }
 
-func (vald *validator) validateAgainstSchema(absRef string, data []byte) (err error) {
-	if _, ok := vald.Refs[absRef]; !ok {
+func (vald *validator) expandRef(name string) string {
+	if _, ok := vald.Refs[name]; ok {
+		return name
+	}
+	return oa3ComponentsSchemas + name
+}
+
+func (vald *validator) validateAgainstSchema(absRef string, data []byte) (errs []*modeler.ValidationError, err error) {
+	SID, ok := vald.Refs[absRef]
+	if !ok {
 		err = modeler.NewNoSuchRefError(absRef)
 		log.Println("[ERR]", err)
 		return
@@ -591,78 +632,163 @@ func (vald *validator) validateAgainstSchema(absRef string, data []byte) (err er
 	var value interface{}
 	if err = json.Unmarshal(data, &value); err != nil {
 		log.Println("[ERR]", err)
+		err = fmt.Errorf("%w: %v", modeler.ErrUnparsablePayload, err)
 		return
 	}
 
-	// TODO: Compile errs on bad refs only, MUST do this step in `lint`
-	log.Printf("[NFO] compiling schema ref %q", absRef)
-	schema, err := vald.Refd.Compile(
-		gojsonschema.NewGoLoader(schemaJSON{"$ref": absRef}))
+	res, err := vald.validate(SID, value)
 	if err != nil {
-		log.Println("[ERR]", err)
 		return
 	}
 
-	log.Println("[NFO] validating payload against refs")
-	res, err := schema.Validate(gojsonschema.NewGoLoader(value))
+	errs = vald.validationErrors(SID, res)
+	log.Printf("[NFO] payload has %d validation errors", len(errs))
+	return
+}
+
+func (vald *validator) Validate(SID sid, data *structpb.Value) []*modeler.ValidationError {
+	toValidate := protovalue.ToGo(data)
+	log.Printf("[DBG] SID:%d -> %+.100v", SID, toValidate)
+
+	res, err := vald.validate(SID, toValidate)
 	if err != nil {
-		log.Println("[ERR]", err)
-		return
+		return []*modeler.ValidationError{{Keyword: "internal", Description: err.Error()}}
 	}
 
-	errs := res.Errors()
+	errs := vald.validationErrors(SID, res)
 	for _, e := range errs {
-		// ResultError interface
-		as.ColorERR.Println(e)
+		log.Printf("[ERR] %s (%s) value: %.100v", e, e.SchemaLocation(), e.Value)
 	}
-	if len(errs) > 0 {
+	return errs
+}
+
+func (vald *validator) validate(SID sid, value interface{}) (res *gojsonschema.Result, err error) {
+	schema, err := vald.compiledSchema(SID)
+	if err != nil {
+		return
+	}
+
+	log.Println("[NFO] validating payload against refs")
+	if res, err = schema.Validate(gojsonschema.NewGoLoader(value)); err != nil {
 		log.Println("[ERR]", err)
-		err = modeler.ErrUnparsablePayload
 	}
 	return
 }
 
-func (vald *validator) Validate(SID sid, data *structpb.Value) []string {
+// compile registers all schemas then compiles the ones values get validated
+// against: named schemas and responses' schemas.
+// Other schemas are compiled on first use.
+func (vald *validator) compile() (err error) {
+	vald.compiledMu.Lock()
+	err = vald.register()
+	vald.compiledMu.Unlock()
+	if err != nil {
+		return
+	}
+
+	for _, SID := range vald.Refs {
+		if _, err = vald.compiledSchema(SID); err != nil {
+			return
+		}
+	}
+	for _, endpoint := range vald.Spec.Endpoints {
+		for _, SID := range endpoint.GetJson().GetOutputs() {
+			if SID == 0 {
+				continue
+			}
+			if _, err = vald.compiledSchema(SID); err != nil {
+				return
+			}
+		}
+	}
+	log.Printf("[NFO] compiled %d schemas", len(vald.compiled))
+	return
+}
+
+// register makes every schema known to a new loader.
+// Must be called with compiledMu held.
+func (vald *validator) register() (err error) {
 	var sm schemap
 	sm = vald.Spec.Schemas.GetJson()
-	s := sm.toGo(SID)
 
-	toValidate := protovalue.ToGo(data)
-	log.Printf("[DBG] SID:%d -> %+.100v against %+.100v", SID, s, toValidate)
-
-	loader := gojsonschema.NewGoLoader(s)
-
-	log.Println("[NFO] compiling schema refs")
-	refd := gojsonschema.NewSchemaLoader()
-	for _, refOrSchema := range sm {
-		if ptr := refOrSchema.GetPtr(); ptr != nil {
-			SID, ref := ptr.GetSID(), ptr.GetRef()
-			s := sm.toGo(SID)
-			sl := gojsonschema.NewGoLoader(s)
-			if err := refd.AddSchema(ref, sl); err != nil {
-				log.Println("[ERR]", err)
-				return []string{err.Error()}
-			}
+	SIDs := make(sids, 0, len(sm))
+	for SID := range sm {
+		SIDs = append(SIDs, SID)
+	}
+	sort.Sort(SIDs)
+
+	log.Printf("[NFO] registering %d schemas", len(SIDs))
+	loader := gojsonschema.NewSchemaLoader()
+	refOfSID := make(map[sid]string)
+	for _, SID := range SIDs {
+		ref, s := sidRef(SID), sm.toGo(SID)
+		if ptr := sm[SID].GetPtr(); ptr != nil {
+			ref, s = ptr.GetRef(), sm.toGo(ptr.GetSID())
+			refOfSID[ptr.GetSID()] = ref
+		}
+		if err = loader.AddSchema(ref, gojsonschema.NewGoLoader(itemsAsSchema(s))); err != nil {
+			log.Println("[ERR]", err)
+			return
 		}
 	}
-	schema, err := refd.Compile(loader)
-	if err != nil {
-		log.Println("[ERR]", err)
-		return []string{err.Error()}
+	vald.loader = loader
+	vald.refOfSID = refOfSID
+	vald.compiled = make(map[sid]*gojsonschema.Schema, len(vald.Refs))
+	return
+}
+
+// itemsAsSchema rewrites "items" from a list of one schema to that schema
+// so all elements of an array get validated (and not just the first one).
+func itemsAsSchema(s schemaJSON) schemaJSON {
+	rewritten := make(schemaJSON, len(s))
+	for key, value := range s {
+		switch v := value.(type) {
+		case schemaJSON:
+			rewritten[key] = itemsAsSchema(v)
+		case []schemaJSON:
+			ss := make([]schemaJSON, 0, len(v))
+			for _, vv := range v {
+				ss = append(ss, itemsAsSchema(vv))
+			}
+			if key == "items" && len(ss) == 1 {
+				rewritten[key] = ss[0]
+			} else {
+				rewritten[key] = ss
+			}
+		default:
+			rewritten[key] = value
+		}
 	}
+	return rewritten
+}
 
-	log.Println("[NFO] validating payload against refs")
-	res, err := schema.Validate(gojsonschema.NewGoLoader(toValidate))
-	if err != nil {
-		log.Println("[ERR]", err)
-		return []string{err.Error()}
+func sidRef(SID sid) string {
+	return sidRefPrefix + strconv.FormatUint(uint64(SID), 10)
+}
+
+func (vald *validator) compiledSchema(SID sid) (schema *gojsonschema.Schema, err error) {
+	vald.compiledMu.Lock()
+	defer vald.compiledMu.Unlock()
+
+	if vald.loader == nil {
+		// e.g. validator built from a protobuf message
+		if err = vald.register(); err != nil {
+			return
+		}
+	}
+	if schema = vald.compiled[SID]; schema != nil {
+		return
 	}
 
-	errors := res.Errors()
-	errs := make([]string, 0, len(errors))
-	for _, e := range errors {
-		errs = append(errs, e.String())
-		log.Printf("[ERR] value: %s", e.Value())
+	ref := sidRef(SID)
+	if ptr := vald.Spec.Schemas.GetJson()[SID].GetPtr(); ptr != nil {
+		ref = ptr.GetRef()
 	}
-	return errs
+	log.Printf("[DBG] compiling schema SID:%d %q", SID, ref)
+	if schema, err = vald.loader.Compile(gojsonschema.NewReferenceLoader(ref)); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	vald.compiled[SID] = schema
+	return
 }
@@ -0,0 +1,197 @@
+package openapiv3
+
+import (
+	"context"
+	"math/rand"
+	"path/filepath"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/protovalue"
+)
+
+func TestValidateAgainstSchemaTwice(t *testing.T) {
+	docPath := filepath.Join("testdata", "specs", "openapi3", "v3.0.0_petstore.yaml")
+	m := &oa3{pb: &fm.Clt_Fuzz_Model_OpenAPIv3{File: docPath}}
+	err := m.Lint(context.Background(), false)
+	require.NoError(t, err)
+
+	for i := 0; i < 2; i++ {
+		errs, err := m.ValidateAgainstSchema("#/components/schemas/Error", []byte(`{"code":42,"message":"!"}`))
+		require.NoError(t, err)
+		require.Empty(t, errs)
+	}
+
+	errs, err := m.ValidateAgainstSchema("#/components/schemas/Error", []byte(`{"code":42`))
+	require.ErrorIs(t, err, modeler.ErrUnparsablePayload)
+	require.Empty(t, errs)
+
+	_, err = m.ValidateAgainstSchema(m.ExpandRef("Nope"), []byte(`{}`))
+	require.IsType(t, &modeler.NoSuchRefError{}, err)
+
+	errs, err = m.ValidateAgainstSchema(m.ExpandRef("Error"), []byte(`{}`))
+	require.NoError(t, err)
+	require.Len(t, errs, 2)
+}
+
+func TestValidationErrors(t *testing.T) {
+	docPath := filepath.Join("testdata", "specs", "openapi3", "v3.0.0_petstore.yaml")
+	m := &oa3{pb: &fm.Clt_Fuzz_Model_OpenAPIv3{File: docPath}}
+	err := m.Lint(context.Background(), false)
+	require.NoError(t, err)
+
+	pets := []interface{}{
+		map[string]interface{}{"id": 1.0, "name": "a"},
+		map[string]interface{}{"id": "2", "name": "b", "tag": map[string]interface{}{"a": []interface{}{"bad"}}},
+		map[string]interface{}{"id": 3.0},
+	}
+	errs := m.Validate(m.vald.Refs["#/components/schemas/Pets"], protovalue.FromGo(pets))
+	sort.Slice(errs, func(i, j int) bool { return errs[i].Pointer < errs[j].Pointer })
+	require.Len(t, errs, 3)
+
+	require.Equal(t, "/1/id", errs[0].Pointer)
+	require.Equal(t, "type", errs[0].Keyword)
+	require.Equal(t, "#/components/schemas/Pet", errs[0].SchemaRef)
+	require.Equal(t, "/properties/id/type", errs[0].SchemaPath)
+	require.Equal(t, "2", errs[0].Value)
+
+	require.Equal(t, "/1/tag", errs[1].Pointer)
+	require.Equal(t, "enum", errs[1].Keyword)
+	require.Equal(t, "#/components/schemas/Pet/properties/tag/enum", errs[1].SchemaLocation())
+
+	require.Equal(t, "/2", errs[2].Pointer)
+	require.Equal(t, "required", errs[2].Keyword)
+	require.Equal(t, "#/components/schemas/Pet/required", errs[2].SchemaLocation())
+	require.Equal(t, []string{
+		"/2: name is required",
+		`  keyword "required" at #/components/schemas/Pet/required`,
+		`  value: {"id":3}`,
+	}, errs[2].Lines())
+}
+
+func TestValidationBoundsKeywords(t *testing.T) {
+	m, err := lintSpec(t, `
+openapi: 3.0.0
+info: {title: bounds, version: 0.0.1}
+paths: {}
+components:
+  schemas:
+    Ratio:
+      type: number
+      minimum: 0
+      maximum: 1
+      exclusiveMinimum: true
+      exclusiveMaximum: true
+    Tags:
+      type: object
+      minProperties: 1
+      maxProperties: 2
+`[1:])
+	require.NoError(t, err)
+
+	var sm schemap
+	sm = m.vald.Spec.GetSchemas().GetJson()
+	require.Equal(t, schemaJSON{
+		"type":             []string{"number"},
+		"minimum":          0.0,
+		"maximum":          1.0,
+		"exclusiveMinimum": true,
+		"exclusiveMaximum": true,
+	}, sm.toGo(m.vald.Refs["#/components/schemas/Ratio"]))
+	require.Equal(t, schemaJSON{
+		"type":          []string{"object"},
+		"minProperties": uint64(1),
+		"maxProperties": uint64(2),
+	}, sm.toGo(m.vald.Refs["#/components/schemas/Tags"]))
+
+	for data, keyword := range map[string]string{
+		`0.5`:                 "",
+		`0`:                   "exclusiveMinimum",
+		`1`:                   "exclusiveMaximum",
+		`{"a":1}`:             "",
+		`{}`:                  "minProperties",
+		`{"a":1,"b":2,"c":3}`: "maxProperties",
+	} {
+		t.Run(data, func(t *testing.T) {
+			ref := "#/components/schemas/Ratio"
+			if data[0] == '{' {
+				ref = "#/components/schemas/Tags"
+			}
+			errs, err := m.ValidateAgainstSchema(ref, []byte(data))
+			require.NoError(t, err)
+			if keyword == "" {
+				require.Empty(t, errs)
+				return
+			}
+			require.Len(t, errs, 1)
+			require.Equal(t, keyword, errs[0].Keyword)
+		})
+	}
+}
+
+// BenchmarkValidate compares validating against schemas compiled at lint time
+// with compiling all schemas again for each validation.
+func BenchmarkValidate(b *testing.B) {
+	docPath := filepath.Join("testdata", "specs", "openapi3", "v3.0.0_petstore-expanded.yaml")
+	m := &oa3{pb: &fm.Clt_Fuzz_Model_OpenAPIv3{File: docPath}}
+	err := m.Lint(context.Background(), false)
+	require.NoError(b, err)
+
+	const absRef = "#/components/schemas/Pet"
+	SID := m.vald.Refs[absRef]
+	value, err := m.GenerateFromSchema(absRef, rand.New(rand.NewSource(42)))
+	require.NoError(b, err)
+	data := protovalue.FromGo(value)
+
+	b.Run("compiled once", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if errs := m.vald.Validate(SID, data); len(errs) != 0 {
+				b.Fatal(errs)
+			}
+		}
+	})
+
+	b.Run("compiled each time", func(b *testing.B) {
+		for i := 0; i < b.N; i++ {
+			if errs := validateRecompiling(m.vald, SID, data); len(errs) != 0 {
+				b.Fatal(errs)
+			}
+		}
+	})
+}
+
+// validateRecompiling validates the way it was done before schemas were cached
+func validateRecompiling(vald *validator, SID sid, data *structpb.Value) []string {
+	var sm schemap
+	sm = vald.Spec.Schemas.GetJson()
+
+	refd := gojsonschema.NewSchemaLoader()
+	for _, refOrSchema := range sm {
+		if ptr := refOrSchema.GetPtr(); ptr != nil {
+			sl := gojsonschema.NewGoLoader(sm.toGo(ptr.GetSID()))
+			if err := refd.AddSchema(ptr.GetRef(), sl); err != nil {
+				return []string{err.Error()}
+			}
+		}
+	}
+	schema, err := refd.Compile(gojsonschema.NewGoLoader(sm.toGo(SID)))
+	if err != nil {
+		return []string{err.Error()}
+	}
+
+	res, err := schema.Validate(gojsonschema.NewGoLoader(protovalue.ToGo(data)))
+	if err != nil {
+		return []string{err.Error()}
+	}
+	errs := make([]string, 0, len(res.Errors()))
+	for _, e := range res.Errors() {
+		errs = append(errs, e.String())
+	}
+	return errs
+}
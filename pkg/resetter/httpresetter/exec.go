@@ -0,0 +1,112 @@
+package httpresetter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+const (
+	// Only this much of a response body ends up in an error
+	maxErrorBodyBytes = 2048
+	maxErrorBodyLines = 16
+)
+
+func (r *Resetter) exec(ctx context.Context, shower progresser.Shower, phases ...resetter.Phase) (err error) {
+	for _, p := range phases {
+		log.Printf("[NFO] running HTTP.%s", p)
+		start := time.Now()
+		for _, req := range r.requestsOf(p) {
+			if err = r.do(ctx, shower, p, req); err != nil {
+				return
+			}
+		}
+		log.Printf("[NFO] exec'd %s in %s", p, time.Since(start))
+	}
+	return
+}
+
+func (r *Resetter) do(ctx context.Context, shower progresser.Shower, p resetter.Phase, req *request) (err error) {
+	var body io.Reader
+	if len(req.GetBody()) != 0 {
+		body = bytes.NewReader(req.GetBody())
+	}
+	var hreq *http.Request
+	if hreq, err = http.NewRequestWithContext(ctx, req.GetMethod(), req.GetUrl(), body); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	for _, h := range req.GetHeaders() {
+		for _, value := range h.GetValues() {
+			hreq.Header.Add(h.GetKey(), value)
+		}
+	}
+
+	shower.Printf("> %s %s", req.GetMethod(), req.GetUrl())
+	rep, err := r.client.Do(hreq)
+	if err != nil {
+		log.Printf("[ERR] %s: %s", p, err)
+		err = resetter.NewError([][]byte{
+			[]byte(fmt.Sprintf("%s %s", req.GetMethod(), req.GetUrl())),
+			[]byte(err.Error()),
+		})
+		return
+	}
+	defer rep.Body.Close()
+	shower.Printf("< %s", rep.Status)
+
+	if expected(req.GetExpect(), rep.StatusCode) {
+		// Drain so the connection gets reused
+		_, _ = io.Copy(io.Discard, rep.Body)
+		return
+	}
+
+	lines := [][]byte{
+		[]byte(fmt.Sprintf("%s %s", req.GetMethod(), req.GetUrl())),
+		[]byte(fmt.Sprintf("got %s, expected %s", rep.Status, describeExpect(req.GetExpect()))),
+	}
+	repBody, _ := io.ReadAll(io.LimitReader(rep.Body, maxErrorBodyBytes))
+	for i, line := range bytes.Split(bytes.TrimSpace(repBody), []byte{'\n'}) {
+		if i == maxErrorBodyLines {
+			lines = append(lines, []byte("..."))
+			break
+		}
+		if len(line) != 0 {
+			lines = append(lines, line)
+		}
+	}
+	err = resetter.NewError(lines)
+	log.Printf("[ERR] %s: %s", p, err)
+	return
+}
+
+func expected(codes []uint32, code int) bool {
+	if len(codes) == 0 {
+		return code >= 200 && code < 300
+	}
+	for _, c := range codes {
+		if int(c) == code {
+			return true
+		}
+	}
+	return false
+}
+
+func describeExpect(codes []uint32) string {
+	if len(codes) == 0 {
+		return "2XX"
+	}
+	cs := make([]string, 0, len(codes))
+	for _, c := range codes {
+		cs = append(cs, fmt.Sprintf("%d", c))
+	}
+	return strings.Join(cs, " or ")
+}
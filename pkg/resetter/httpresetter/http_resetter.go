@@ -0,0 +1,157 @@
+package httpresetter
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/ready"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
+)
+
+// Name names the Starlark builtin
+const Name = "http_resetter"
+
+// Bounds each request a resetter sends
+const requestTimeout = 2 * time.Minute
+
+// New instanciates a new resetter
+func New(kwargs []starlark.Tuple) (resetter.Interface, error) {
+	var lot struct {
+		name               starlark.String
+		provides           tags.UniqueStringsNonEmpty
+		start, reset, stop requests
+		ready              *ready.Probe
+	}
+	if err := starlark.UnpackArgs(Name, nil, kwargs,
+		"name", &lot.name,
+		"provides", &lot.provides,
+		// NOTE: all args following an optional? are implicitly optional.
+		"start??", &lot.start,
+		"reset??", &lot.reset,
+		"stop??", &lot.stop,
+		"ready??", &lot.ready,
+	); err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	log.Printf("[DBG] unpacked %+v", lot)
+
+	// verify each
+
+	name := lot.name.GoString()
+	if err := tags.LegalName(name); err != nil { //TODO: newUserError
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	if err := ready.WithoutOutput(lot.ready); err != nil {
+		err = fmt.Errorf("ready: %v", err)
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	// verify all
+
+	r := &Resetter{
+		name:     name,
+		provides: lot.provides.GoStrings(),
+		probe:    lot.ready,
+		client:   &http.Client{Timeout: requestTimeout},
+	}
+	r.Start = lot.start.reqs
+	r.Rst = lot.reset.reqs
+	r.Stop = lot.stop.reqs
+	r.phases = resetter.Phases{
+		HasStart: len(r.Start) != 0,
+		HasReset: len(r.Rst) != 0,
+		HasStop:  len(r.Stop) != 0,
+	}
+	if _, ok := r.phases.Plan(); !ok {
+		log.Println("[ERR]", errMissingReset)
+		return nil, errMissingReset
+	}
+
+	// assemble
+
+	return r, nil
+}
+
+var _ resetter.Interface = (*Resetter)(nil)
+
+// Resetter implements resetter.Interface
+type Resetter struct {
+	name     string
+	provides []string
+	fm.Clt_Fuzz_Resetter_HTTP
+	probe *ready.Probe
+
+	phases resetter.Phases
+
+	client *http.Client
+}
+
+// Name uniquely identifies this instance
+func (r *Resetter) Name() string { return r.name }
+
+// Provides lists the models a resetter resets
+func (r *Resetter) Provides() []string { return r.provides }
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (r *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	pb := &fm.Clt_Fuzz_Resetter{
+		Name:     r.name,
+		Provides: r.provides,
+		Resetter: &fm.Clt_Fuzz_Resetter_Http{
+			Http: &r.Clt_Fuzz_Resetter_HTTP,
+		}}
+	if r.probe != nil {
+		pb.Ready = r.probe.ToProto()
+	}
+	return pb
+}
+
+// Ready optionally tells when the System Under Test can be used
+func (r *Resetter) Ready() *ready.Probe { return r.probe }
+
+// Lint checks the configuration against the current directory
+func (r *Resetter) Lint(ctx context.Context) error { return nil }
+
+// ExecStart executes the setup phase of the System Under Test
+func (r *Resetter) ExecStart(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	return r.exec(ctx, shower, resetter.PhaseStart)
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (r *Resetter) ExecReset(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	phases, ok := r.phases.Next(only)
+	if !ok {
+		log.Println("[ERR]", errMissingReset)
+		return errMissingReset
+	}
+	return r.exec(ctx, shower, phases...)
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (r *Resetter) ExecStop(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	return r.exec(ctx, shower, resetter.PhaseStop)
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (r *Resetter) Terminate(ctx context.Context, shower progresser.Shower, envRead map[string]string) (err error) {
+	if r.phases.HasStop {
+		if err = r.ExecStop(ctx, shower, true, envRead); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	r.client.CloseIdleConnections()
+	return
+}
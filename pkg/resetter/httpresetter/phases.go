@@ -0,0 +1,17 @@
+package httpresetter
+
+import (
+	"errors"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+var errMissingReset = errors.New("missing at least `http_resetter( reset = \"POST http://...\" )`")
+
+func (r *Resetter) requestsOf(p resetter.Phase) []*request {
+	return map[resetter.Phase][]*request{
+		resetter.PhaseStart: r.Start,
+		resetter.PhaseReset: r.Rst,
+		resetter.PhaseStop:  r.Stop,
+	}[p]
+}
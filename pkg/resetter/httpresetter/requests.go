@@ -0,0 +1,250 @@
+package httpresetter
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	"go.starlark.net/starlark"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarkvalue"
+)
+
+type request = fm.Clt_Fuzz_Resetter_HTTP_Request
+
+var headerContentType = http.CanonicalHeaderKey("Content-Type")
+
+const (
+	keyMethod  = "method"
+	keyURL     = "url"
+	keyHeaders = "headers"
+	keyBody    = "body"
+	keyExpect  = "expect"
+)
+
+var _ starlark.Unpacker = (*requests)(nil)
+
+// requests unpacks a phase: one request or a list of requests.
+// A request is either a "METHOD URL" string or a dict such as
+//
+//	{"method": "POST", "url": "...", "headers": {...}, "body": ..., "expect": [200, 204]}
+type requests struct {
+	reqs []*request
+}
+
+// Unpack implements starlark.Unpacker
+func (rs *requests) Unpack(v starlark.Value) error {
+	var items []starlark.Value
+	switch x := v.(type) {
+	case *starlark.List:
+		for i := 0; i < x.Len(); i++ {
+			items = append(items, x.Index(i))
+		}
+		if len(items) == 0 {
+			return errors.New("must not be empty")
+		}
+	default:
+		items = []starlark.Value{v}
+	}
+
+	rs.reqs = make([]*request, 0, len(items))
+	for _, item := range items {
+		req, err := unpackRequest(item)
+		if err != nil {
+			return err
+		}
+		rs.reqs = append(rs.reqs, req)
+	}
+	return nil
+}
+
+func unpackRequest(v starlark.Value) (req *request, err error) {
+	switch x := v.(type) {
+	case starlark.String:
+		fields := strings.Fields(x.GoString())
+		if len(fields) != 2 {
+			err = fmt.Errorf(`want "METHOD URL", got: %s`, x.String())
+			return
+		}
+		req = &request{Method: fields[0], Url: fields[1]}
+
+	case *starlark.Dict:
+		if req, err = unpackRequestDict(x); err != nil {
+			return
+		}
+
+	default:
+		err = fmt.Errorf(`want "METHOD URL" string or dict, got %s`, v.Type())
+		return
+	}
+
+	req.Method = strings.ToUpper(req.Method)
+	if !validMethod(req.Method) {
+		err = fmt.Errorf("unexpected HTTP method %q", req.Method)
+		return
+	}
+	var u *url.URL
+	if u, err = url.ParseRequestURI(req.Url); err != nil {
+		return
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		err = fmt.Errorf("want an http:// or https:// URL, got: %q", req.Url)
+		return
+	}
+	return
+}
+
+func unpackRequestDict(d *starlark.Dict) (req *request, err error) {
+	req = &request{Method: http.MethodGet}
+	jsonBody := false
+	for _, kv := range d.Items() {
+		k, ok := kv[0].(starlark.String)
+		if !ok {
+			err = fmt.Errorf("want string keys, got: %s", kv[0].String())
+			return
+		}
+		v := kv[1]
+		switch key := k.GoString(); key {
+		case keyMethod:
+			s, ok := v.(starlark.String)
+			if !ok {
+				err = fmt.Errorf("%s: want string, got %s", key, v.Type())
+				return
+			}
+			req.Method = s.GoString()
+
+		case keyURL:
+			s, ok := v.(starlark.String)
+			if !ok {
+				err = fmt.Errorf("%s: want string, got %s", key, v.Type())
+				return
+			}
+			req.Url = s.GoString()
+
+		case keyHeaders:
+			if req.Headers, err = unpackHeaders(v); err != nil {
+				err = fmt.Errorf("%s: %v", key, err)
+				return
+			}
+
+		case keyBody:
+			if req.Body, err = unpackBody(v); err != nil {
+				err = fmt.Errorf("%s: %v", key, err)
+				return
+			}
+			switch v.(type) {
+			case starlark.String, starlark.Bytes:
+			default:
+				jsonBody = true
+			}
+
+		case keyExpect:
+			if req.Expect, err = unpackExpect(v); err != nil {
+				err = fmt.Errorf("%s: %v", key, err)
+				return
+			}
+
+		default:
+			err = fmt.Errorf("unexpected key %q, want one of %s, %s, %s, %s or %s",
+				key, keyMethod, keyURL, keyHeaders, keyBody, keyExpect)
+			return
+		}
+	}
+	if req.Url == "" {
+		err = fmt.Errorf("missing %s", keyURL)
+		return
+	}
+	if jsonBody && !hasHeader(req.Headers, headerContentType) {
+		req.Headers = append(req.Headers, &fm.HeaderPair{
+			Key:    headerContentType,
+			Values: []string{"application/json"},
+		})
+	}
+	return
+}
+
+func unpackHeaders(v starlark.Value) (headers []*fm.HeaderPair, err error) {
+	d, ok := v.(*starlark.Dict)
+	if !ok {
+		err = fmt.Errorf("want dict, got %s", v.Type())
+		return
+	}
+	headers = make([]*fm.HeaderPair, 0, d.Len())
+	for _, kv := range d.Items() {
+		k, okK := kv[0].(starlark.String)
+		s, okV := kv[1].(starlark.String)
+		if !okK || !okV {
+			err = fmt.Errorf("want strings mapped to strings, got: %s = %s", kv[0].String(), kv[1].String())
+			return
+		}
+		headers = append(headers, &fm.HeaderPair{
+			Key:    http.CanonicalHeaderKey(k.GoString()),
+			Values: []string{s.GoString()},
+		})
+	}
+	sort.Slice(headers, func(i, j int) bool { return headers[i].Key < headers[j].Key })
+	return
+}
+
+func unpackBody(v starlark.Value) (body []byte, err error) {
+	switch x := v.(type) {
+	case starlark.String:
+		body = []byte(x.GoString())
+	case starlark.Bytes:
+		body = []byte(x)
+	default:
+		if err = starlarkvalue.ProtoCompatible(v); err != nil {
+			return
+		}
+		body, err = protojson.Marshal(starlarkvalue.ToProtoValue(v))
+	}
+	return
+}
+
+func unpackExpect(v starlark.Value) (codes []uint32, err error) {
+	var items []starlark.Value
+	switch x := v.(type) {
+	case starlark.Int:
+		items = []starlark.Value{x}
+	case *starlark.List:
+		for i := 0; i < x.Len(); i++ {
+			items = append(items, x.Index(i))
+		}
+	default:
+		err = fmt.Errorf("want int or list of ints, got %s", v.Type())
+		return
+	}
+	for _, item := range items {
+		code, e := starlark.AsInt32(item)
+		if e != nil || code < 100 || code > 599 {
+			err = fmt.Errorf("want HTTP status codes, got: %s", item.String())
+			return
+		}
+		codes = append(codes, uint32(code))
+	}
+	return
+}
+
+func hasHeader(headers []*fm.HeaderPair, key string) bool {
+	for _, h := range headers {
+		if h.GetKey() == key {
+			return true
+		}
+	}
+	return false
+}
+
+func validMethod(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPost, http.MethodPut,
+		http.MethodPatch, http.MethodDelete, http.MethodOptions:
+		return true
+	default:
+		return false
+	}
+}
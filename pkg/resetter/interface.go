@@ -4,14 +4,23 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"go.starlark.net/starlark"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/ready"
 )
 
+// EnvExport names the environment variable holding the path of the file
+// resetters append KEY=value lines to, exporting values to the runtime.
+const EnvExport = "MONKEY_EXPORT"
+
+// ExportKey matches the keys resetters can export
+var ExportKey = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_]*$`)
+
 // Maker types the New func that instanciates new resetters
 type Maker func(kwargs []starlark.Tuple) (Interface, error)
 
@@ -29,6 +38,12 @@ type Interface interface { // TODO: initers.Initer
 	// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
 	ToProto() *fm.Clt_Fuzz_Resetter
 
+	// Lint checks the configuration against the current directory
+	Lint(context.Context) error
+
+	// Ready optionally tells when the System Under Test can be used
+	Ready() *ready.Probe
+
 	// ExecStart executes the setup phase of the System Under Test
 	ExecStart(context.Context, progresser.Shower, bool, map[string]string) error
 	// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
@@ -40,6 +55,20 @@ type Interface interface { // TODO: initers.Initer
 	Terminate(context.Context, progresser.Shower, map[string]string) error
 }
 
+// Baseliner is implemented by resetters whose start phase records the state
+// that resets go back to. Restarting them would lose that known-good state.
+type Baseliner interface {
+	// StartTakesBaseline reports whether ExecStart replaces that state
+	StartTakesBaseline() bool
+}
+
+// ReadyWaiter is implemented by resetters that wait on their own Ready probe
+// whenever they bring the System Under Test up, so callers need not.
+type ReadyWaiter interface {
+	// WaitsReady reports whether the resetter waits on its probe itself
+	WaitsReady() bool
+}
+
 var _ error = (*Error)(nil)
 
 // Error describes a resetter error
@@ -0,0 +1,67 @@
+package resetter
+
+// Phase is one of a resetter's setup, reset or cleanup steps
+type Phase int
+
+// Phases a resetter may define
+const (
+	PhaseStart Phase = iota
+	PhaseReset
+	PhaseStop
+)
+
+func (p Phase) String() string {
+	return map[Phase]string{
+		PhaseStart: "Start",
+		PhaseReset: "Reset",
+		PhaseStop:  "Stop",
+	}[p]
+}
+
+// Phases picks what a reset runs given which phases a resetter defines,
+// the same way shell resetters pick their scripts.
+type Phases struct {
+	HasStart, HasReset, HasStop bool
+
+	isNotFirstRun bool
+}
+
+// Plan returns the phases the next reset runs,
+// or false when the defined phases cannot reset anything.
+func (ps *Phases) Plan() (phases []Phase, ok bool) {
+	switch {
+	case !ps.HasStart && ps.HasReset && !ps.HasStop:
+		phases = []Phase{PhaseReset}
+
+	case ps.HasStart && ps.HasReset && ps.HasStop:
+		if ps.isNotFirstRun {
+			phases = []Phase{PhaseReset}
+		} else {
+			phases = []Phase{PhaseStart, PhaseReset}
+		}
+
+	case ps.HasStart && !ps.HasReset && ps.HasStop:
+		if ps.isNotFirstRun {
+			phases = []Phase{PhaseStop, PhaseStart}
+		} else {
+			phases = []Phase{PhaseStart}
+		}
+
+	default:
+		return
+	}
+	ok = true
+	return
+}
+
+// Next is Plan for ExecReset: later resets then run as in between tests.
+// With only set (i.e. `monkey exec reset`) this one does too.
+func (ps *Phases) Next(only bool) (phases []Phase, ok bool) {
+	if only {
+		ps.isNotFirstRun = true
+	}
+	if phases, ok = ps.Plan(); ok {
+		ps.isNotFirstRun = true
+	}
+	return
+}
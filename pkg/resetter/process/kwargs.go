@@ -0,0 +1,68 @@
+package process
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.starlark.net/starlark"
+)
+
+var _ starlark.Unpacker = (*command)(nil)
+
+// command unpacks either a list of arguments or a string
+// split on whitespace. No shell is involved.
+type command struct {
+	argv []string
+}
+
+// Unpack implements starlark.Unpacker
+func (c *command) Unpack(v starlark.Value) error {
+	switch x := v.(type) {
+	case starlark.String:
+		c.argv = strings.Fields(x.GoString())
+	case *starlark.List:
+		c.argv = make([]string, 0, x.Len())
+		for i := 0; i < x.Len(); i++ {
+			s, ok := x.Index(i).(starlark.String)
+			if !ok {
+				return fmt.Errorf("got %s, want string", x.Index(i).Type())
+			}
+			c.argv = append(c.argv, s.GoString())
+		}
+	default:
+		return fmt.Errorf("got %s, want string or list", v.Type())
+	}
+	if len(c.argv) == 0 || c.argv[0] == "" {
+		return errors.New("must not be empty")
+	}
+	return nil
+}
+
+var _ starlark.Unpacker = (*envVars)(nil)
+
+// envVars unpacks a dict of strings to strings
+type envVars struct {
+	vars map[string]string
+}
+
+// Unpack implements starlark.Unpacker
+func (e *envVars) Unpack(v starlark.Value) error {
+	d, ok := v.(*starlark.Dict)
+	if !ok {
+		return fmt.Errorf("got %s, want dict", v.Type())
+	}
+	e.vars = make(map[string]string, d.Len())
+	for _, kv := range d.Items() {
+		k, okK := kv[0].(starlark.String)
+		s, okV := kv[1].(starlark.String)
+		if !okK || !okV {
+			return fmt.Errorf("want strings mapped to strings, got: %s = %s", kv[0].String(), kv[1].String())
+		}
+		if key := k.GoString(); key == "" || strings.ContainsAny(key, "=\x00") {
+			return fmt.Errorf("illegal environment variable name: %s", k.String())
+		}
+		e.vars[k.GoString()] = s.GoString()
+	}
+	return nil
+}
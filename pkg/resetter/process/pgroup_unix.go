@@ -0,0 +1,20 @@
+//go:build !windows
+
+package process
+
+import (
+	"errors"
+	"os/exec"
+	"syscall"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{Setpgid: true}
+}
+
+func interruptGroup(pid int) error { return syscall.Kill(-pid, syscall.SIGTERM) }
+
+func killGroup(pid int) error { return syscall.Kill(-pid, syscall.SIGKILL) }
+
+// isGone tells whether signaling failed because the group no longer exists
+func isGone(err error) bool { return errors.Is(err, syscall.ESRCH) }
@@ -0,0 +1,29 @@
+//go:build windows
+
+package process
+
+import (
+	"errors"
+	"os"
+	"os/exec"
+	"syscall"
+)
+
+func setProcessGroup(cmd *exec.Cmd) {
+	cmd.SysProcAttr = &syscall.SysProcAttr{CreationFlags: syscall.CREATE_NEW_PROCESS_GROUP}
+}
+
+// There is no SIGTERM here
+func interruptGroup(pid int) error { return killGroup(pid) }
+
+func killGroup(pid int) error {
+	p, err := os.FindProcess(pid)
+	if err != nil {
+		return err
+	}
+	return p.Kill()
+}
+
+// isGone tells whether signaling failed because the process no longer exists.
+// Opening a missing process fails with ERROR_INVALID_PARAMETER.
+func isGone(err error) bool { return errors.Is(err, syscall.Errno(87)) }
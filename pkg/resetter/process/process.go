@@ -0,0 +1,169 @@
+package process
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sync"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/ready"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
+)
+
+// Name names the Starlark builtin
+const Name = "process"
+
+// New instanciates a new resetter
+func New(kwargs []starlark.Tuple) (resetter.Interface, error) {
+	var lot struct {
+		name           starlark.String
+		provides       tags.UniqueStringsNonEmpty
+		command        command
+		env            envVars
+		ready          *ready.Probe
+		restartOnReset starlark.Bool
+	}
+	if err := starlark.UnpackArgs(Name, nil, kwargs,
+		"name", &lot.name,
+		"provides", &lot.provides,
+		"command", &lot.command,
+		"env?", &lot.env,
+		"ready?", &lot.ready,
+		"restart_on_reset?", &lot.restartOnReset,
+	); err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	log.Printf("[DBG] unpacked %+v", lot)
+
+	// verify each
+
+	name := lot.name.GoString()
+	if err := tags.LegalName(name); err != nil { //TODO: newUserError
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	// verify all
+
+	// assemble
+
+	p := &Resetter{
+		name:     name,
+		provides: lot.provides.GoStrings(),
+		probe:    lot.ready,
+	}
+	p.Command = lot.command.argv
+	p.Env = lot.env.vars
+	p.RestartOnReset = bool(lot.restartOnReset)
+	return p, nil
+}
+
+var _ resetter.Interface = (*Resetter)(nil)
+
+// Resetter implements resetter.Interface
+type Resetter struct {
+	name     string
+	provides []string
+	fm.Clt_Fuzz_Resetter_Process
+	probe *ready.Probe
+
+	isNotFirstRun bool
+
+	mu      sync.Mutex
+	running *running
+}
+
+// Name uniquely identifies this instance
+func (p *Resetter) Name() string { return p.name }
+
+// Provides lists the models a resetter resets
+func (p *Resetter) Provides() []string { return p.provides }
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (p *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	pb := &fm.Clt_Fuzz_Resetter{
+		Name:     p.name,
+		Provides: p.provides,
+		Resetter: &fm.Clt_Fuzz_Resetter_Process_{
+			Process: &p.Clt_Fuzz_Resetter_Process,
+		}}
+	if p.probe != nil {
+		pb.Ready = p.probe.ToProto()
+	}
+	return pb
+}
+
+// Ready optionally tells when the System Under Test can be used
+func (p *Resetter) Ready() *ready.Probe { return p.probe }
+
+var _ resetter.ReadyWaiter = (*Resetter)(nil)
+
+// WaitsReady reports whether the resetter waits on its probe itself:
+// it does so on every start, noticing when the process exits early.
+func (p *Resetter) WaitsReady() bool { return true }
+
+// Lint checks the configuration against the current directory
+func (p *Resetter) Lint(ctx context.Context) error { return nil }
+
+// ExecStart executes the setup phase of the System Under Test
+func (p *Resetter) ExecStart(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	return p.start(ctx, shower, envRead)
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (p *Resetter) ExecReset(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	if only {
+		// Makes `monkey exec reset` run as if in between tests
+		p.isNotFirstRun = true
+	}
+
+	if !p.isNotFirstRun {
+		p.isNotFirstRun = true
+		return p.start(ctx, shower, envRead)
+	}
+
+	if p.RestartOnReset {
+		if err := p.stop(shower, only); err != nil {
+			return err
+		}
+		return p.start(ctx, shower, envRead)
+	}
+
+	if only {
+		return nil
+	}
+	return p.alive()
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (p *Resetter) ExecStop(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	return p.stop(shower, only)
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (p *Resetter) Terminate(ctx context.Context, shower progresser.Shower, envRead map[string]string) (err error) {
+	if err = p.stop(shower, false); err != nil {
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func (p *Resetter) alive() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.running == nil {
+		return fmt.Errorf("%s is not running", p.name)
+	}
+	select {
+	case <-p.running.exited:
+		return p.running.exitError(fmt.Sprintf("%s exited: %v", p.name, p.running.err))
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,308 @@
+package process
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/exec"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+const (
+	stopGrace   = 5 * time.Second
+	followEvery = 50 * time.Millisecond
+
+	// Only this much of the output ends up in an error
+	tailBytes = 4096
+	tailLines = 16
+)
+
+type running struct {
+	pid     int
+	logFile string
+	exited  chan struct{}
+	err     error
+}
+
+func (p *Resetter) logPath() string { return cwid.Prefixed() + "process_" + p.name + ".log" }
+
+// Outlives the run so that `monkey exec stop` finds what `monkey exec start` started
+func (p *Resetter) pidPath() string { return cwid.Shared() + "process_" + p.name + ".pid" }
+
+func (p *Resetter) start(ctx context.Context, shower progresser.Shower, envRead map[string]string) (err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if r := p.running; r != nil {
+		select {
+		case <-r.exited:
+		default:
+			err = fmt.Errorf("%s is already running (pid %d)", p.name, r.pid)
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+
+	// Output goes straight to a file so the process survives `monkey exec start`
+	var out *os.File
+	if out, err = os.OpenFile(p.logPath(), os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0640); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	defer out.Close()
+
+	cmd := exec.Command(p.Command[0], p.Command[1:]...)
+	cmd.Env = p.environ(envRead)
+	cmd.Stdout = out
+	cmd.Stderr = out
+	setProcessGroup(cmd)
+
+	start := time.Now()
+	log.Printf("[NFO] starting %s: %q", p.name, p.Command)
+	if err = cmd.Start(); err != nil {
+		log.Println("[ERR]", err)
+		err = resetter.NewError([][]byte{
+			[]byte(fmt.Sprintf("cannot start %s: %q", p.name, p.Command)),
+			[]byte(err.Error()),
+		})
+		return
+	}
+
+	r := &running{
+		pid:     cmd.Process.Pid,
+		logFile: p.logPath(),
+		exited:  make(chan struct{}),
+	}
+	go func() {
+		r.err = cmd.Wait()
+		log.Printf("[NFO] %s (pid %d) exited: %v", p.name, r.pid, r.err)
+		close(r.exited)
+	}()
+	p.running = r
+	if errW := os.WriteFile(p.pidPath(), []byte(strconv.Itoa(r.pid)), 0640); errW != nil {
+		log.Println("[ERR]", errW)
+	}
+	shower.Printf("Started %s (pid %d), output in %s", p.name, r.pid, r.logFile)
+
+	if p.probe == nil {
+		return
+	}
+	if err = p.waitReady(ctx, r); err != nil {
+		log.Println("[ERR]", err)
+		_ = r.kill()
+		p.running = nil
+		return
+	}
+	shower.Printf("%s is ready after %s", p.name, time.Since(start))
+	return
+}
+
+func (p *Resetter) waitReady(ctx context.Context, r *running) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go func() {
+		select {
+		case <-r.exited:
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	var lines chan string
+	if p.probe.ReadsLines() {
+		lines = make(chan string)
+		go follow(ctx, r.logFile, lines)
+	}
+
+	if err := p.probe.Wait(ctx, lines); err != nil {
+		select {
+		case <-r.exited:
+			return r.exitError(fmt.Sprintf("%s exited before being ready: %v", p.name, r.err))
+		default:
+			return r.exitError(fmt.Sprintf("%s is not ready: %v", p.name, err))
+		}
+	}
+	return nil
+}
+
+// stop terminates the running process, or the one
+// recorded by a previous `monkey exec start` when recorded is set.
+func (p *Resetter) stop(shower progresser.Shower, recorded bool) (err error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	r := p.running
+	if r == nil {
+		if recorded {
+			err = p.stopRecorded(shower)
+		}
+		return
+	}
+	p.running = nil
+
+	log.Printf("[NFO] stopping %s (pid %d)", p.name, r.pid)
+	err = r.kill()
+	if errR := os.Remove(p.pidPath()); errR != nil && !os.IsNotExist(errR) {
+		log.Println("[ERR]", errR)
+	}
+	shower.Printf("Stopped %s (pid %d)", p.name, r.pid)
+	return
+}
+
+func (p *Resetter) stopRecorded(shower progresser.Shower) (err error) {
+	var data []byte
+	if data, err = os.ReadFile(p.pidPath()); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+			return
+		}
+		log.Println("[ERR]", err)
+		return
+	}
+	var pid int
+	if pid, err = strconv.Atoi(strings.TrimSpace(string(data))); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	log.Printf("[NFO] stopping recorded %s (pid %d)", p.name, pid)
+	if err = interruptGroup(pid); err != nil {
+		if !isGone(err) {
+			log.Println("[ERR]", err)
+			return
+		}
+		// Already gone, e.g. the machine rebooted since
+		log.Printf("[NFO] recorded %s (pid %d) is not running", p.name, pid)
+		err = nil
+	}
+	if err = os.Remove(p.pidPath()); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	shower.Printf("Stopped %s (pid %d)", p.name, pid)
+	return
+}
+
+// kill interrupts the process group then kills whatever is left of it
+func (r *running) kill() (err error) {
+	select {
+	case <-r.exited:
+	default:
+		if err = interruptGroup(r.pid); err != nil {
+			log.Println("[ERR]", err)
+		}
+		select {
+		case <-r.exited:
+		case <-time.After(stopGrace):
+			log.Printf("[NFO] pid %d still running after %s", r.pid, stopGrace)
+		}
+	}
+	// Children may outlive the group leader
+	if errK := killGroup(r.pid); errK != nil {
+		log.Println("[DBG]", errK)
+	}
+	<-r.exited
+	return
+}
+
+func (r *running) exitError(msg string) error {
+	lines := [][]byte{
+		[]byte(msg),
+		[]byte("output: " + r.logFile),
+	}
+	return resetter.NewError(append(lines, tail(r.logFile)...))
+}
+
+func (p *Resetter) environ(envRead map[string]string) []string {
+	env := os.Environ()
+	// Later entries take precedence
+	for _, vars := range []map[string]string{envRead, p.Env} {
+		keys := make([]string, 0, len(vars))
+		for key := range vars {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			env = append(env, key+"="+vars[key])
+		}
+	}
+	return env
+}
+
+// follow sends each complete line written to path until ctx is done
+func follow(ctx context.Context, path string, lines chan<- string) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	defer f.Close()
+
+	rd := bufio.NewReader(f)
+	var partial []byte
+	for {
+		chunk, err := rd.ReadBytes('\n')
+		partial = append(partial, chunk...)
+		if err == nil {
+			line := string(bytes.TrimRight(partial, "\r\n"))
+			partial = partial[:0]
+			select {
+			case lines <- line:
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+		if err != io.EOF {
+			log.Println("[ERR]", err)
+			return
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(followEvery):
+		}
+	}
+}
+
+// tail returns the last few lines of path
+func tail(path string) (lines [][]byte) {
+	f, err := os.Open(path)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	defer f.Close()
+
+	if fi, err := f.Stat(); err == nil && fi.Size() > tailBytes {
+		if _, err := f.Seek(-tailBytes, io.SeekEnd); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	data, err := io.ReadAll(f)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	for _, line := range bytes.Split(bytes.TrimSpace(data), []byte{'\n'}) {
+		if len(line) != 0 {
+			lines = append(lines, line)
+		}
+	}
+	if len(lines) > tailLines {
+		lines = lines[len(lines)-tailLines:]
+	}
+	return
+}
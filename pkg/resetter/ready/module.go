@@ -0,0 +1,166 @@
+package ready
+
+import (
+	"fmt"
+	"net"
+	"regexp"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+// Name names the Starlark namespace holding probe builtins
+const Name = "ready"
+
+const (
+	httpName      = "http"
+	logLineName   = "log_line"
+	predicateName = "predicate"
+	tcpName       = "tcp"
+)
+
+var _ starlark.HasAttrs = (*Module)(nil)
+
+// Module exposes probe builtins: ready.tcp(...), ready.http(...),
+// ready.log_line(...) & ready.predicate(...)
+type Module struct {
+	attrs map[string]*starlark.Builtin
+}
+
+// NewModule creates the namespace for probe builtins
+func NewModule() *Module {
+	m := &Module{}
+	m.attrs = map[string]*starlark.Builtin{
+		httpName:      starlark.NewBuiltin(httpName, bHTTP).BindReceiver(m),
+		logLineName:   starlark.NewBuiltin(logLineName, bLogLine).BindReceiver(m),
+		predicateName: starlark.NewBuiltin(predicateName, bPredicate).BindReceiver(m),
+		tcpName:       starlark.NewBuiltin(tcpName, bTCP).BindReceiver(m),
+	}
+	return m
+}
+
+// AttrNames lists probe builtins
+func (m *Module) AttrNames() []string {
+	return []string{
+		httpName,
+		logLineName,
+		predicateName,
+		tcpName,
+	}
+}
+
+// Attr returns a probe builtin
+func (m *Module) Attr(name string) (starlark.Value, error) {
+	if v := m.attrs[name]; v != nil {
+		return v, nil
+	}
+	return nil, nil // no such method
+}
+
+func (m *Module) String() string        { return Name }
+func (m *Module) Type() string          { return Name }
+func (m *Module) Freeze()               {}
+func (m *Module) Truth() starlark.Bool  { return true }
+func (m *Module) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", Name) }
+
+func bTCP(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var addr starlark.String
+	var timeout timeoutMs
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"addr", &addr,
+		"timeout_ms?", &timeout,
+	); err != nil {
+		return nil, err
+	}
+	if _, _, err := net.SplitHostPort(addr.GoString()); err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+	return &Probe{pb: &fm.Clt_Fuzz_Resetter_Probe{
+		Probe:     &fm.Clt_Fuzz_Resetter_Probe_Tcp{Tcp: addr.GoString()},
+		TimeoutMs: timeout.ms,
+	}}, nil
+}
+
+func bHTTP(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var u starlark.String
+	status := 200
+	var timeout timeoutMs
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"url", &u,
+		"status?", &status,
+		"timeout_ms?", &timeout,
+	); err != nil {
+		return nil, err
+	}
+	if err := validURL(u.GoString()); err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+	if status < 100 || status > 599 {
+		return nil, fmt.Errorf("%s: want an HTTP status code, got: %d", b.Name(), status)
+	}
+	return &Probe{pb: &fm.Clt_Fuzz_Resetter_Probe{
+		Probe: &fm.Clt_Fuzz_Resetter_Probe_Http{Http: &fm.Clt_Fuzz_Resetter_Probe_HTTP{
+			Url:    u.GoString(),
+			Status: uint32(status),
+		}},
+		TimeoutMs: timeout.ms,
+	}}, nil
+}
+
+func bLogLine(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var pattern starlark.String
+	var timeout timeoutMs
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"regex", &pattern,
+		"timeout_ms?", &timeout,
+	); err != nil {
+		return nil, err
+	}
+	re, err := regexp.Compile(pattern.GoString())
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", b.Name(), err)
+	}
+	return &Probe{
+		pb: &fm.Clt_Fuzz_Resetter_Probe{
+			Probe:     &fm.Clt_Fuzz_Resetter_Probe_LogLine{LogLine: pattern.GoString()},
+			TimeoutMs: timeout.ms,
+		},
+		re: re,
+	}, nil
+}
+
+func bPredicate(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var fn *starlark.Function
+	var timeout timeoutMs
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"fn", &fn,
+		"timeout_ms?", &timeout,
+	); err != nil {
+		return nil, err
+	}
+	if fn.NumParams() != 0 {
+		return nil, fmt.Errorf("%s: want a function taking no arguments, got %s", b.Name(), fn.Name())
+	}
+	return &Probe{
+		pb: &fm.Clt_Fuzz_Resetter_Probe{
+			Probe:     &fm.Clt_Fuzz_Resetter_Probe_Predicate{Predicate: fn.Name()},
+			TimeoutMs: timeout.ms,
+		},
+		fn: fn,
+	}, nil
+}
+
+var _ starlark.Unpacker = (*timeoutMs)(nil)
+
+type timeoutMs struct{ ms uint32 }
+
+// Unpack implements starlark.Unpacker
+func (t *timeoutMs) Unpack(v starlark.Value) error {
+	ms, err := starlark.AsInt32(v)
+	if err != nil || ms <= 0 {
+		return fmt.Errorf("want a positive number of milliseconds, got: %s", v.String())
+	}
+	t.ms = uint32(ms)
+	return nil
+}
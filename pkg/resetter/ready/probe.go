@@ -0,0 +1,209 @@
+package ready
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/url"
+	"regexp"
+	"time"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const (
+	defaultTimeout = time.Minute
+
+	pollEvery   = 100 * time.Millisecond
+	tryTimeout  = time.Second
+	probeTypeOf = "probe"
+)
+
+var _ starlark.Value = (*Probe)(nil)
+
+// Probe tells when a service is ready
+type Probe struct {
+	pb *fm.Clt_Fuzz_Resetter_Probe
+	re *regexp.Regexp
+	fn *starlark.Function
+}
+
+// ToProto marshals a probe into a *fm.Clt_Fuzz_Resetter_Probe
+func (p *Probe) ToProto() *fm.Clt_Fuzz_Resetter_Probe { return p.pb }
+
+// ReadsLines is true when probing needs the service's output
+func (p *Probe) ReadsLines() bool { return p.re != nil }
+
+// WithoutOutput errors on probes that need a service's output
+func WithoutOutput(p *Probe) error {
+	if p != nil && p.ReadsLines() {
+		return fmt.Errorf("%s probes only work with a process' output", logLineName)
+	}
+	return nil
+}
+
+// Timeout bounds how long one waits for the probe to succeed
+func (p *Probe) Timeout() time.Duration {
+	if ms := p.pb.GetTimeoutMs(); ms != 0 {
+		return time.Duration(ms) * time.Millisecond
+	}
+	return defaultTimeout
+}
+
+// String returns the Starlark representation of a probe
+func (p *Probe) String() string {
+	switch x := p.pb.GetProbe().(type) {
+	case *fm.Clt_Fuzz_Resetter_Probe_Tcp:
+		return fmt.Sprintf("%s(%q)", tcpName, x.Tcp)
+	case *fm.Clt_Fuzz_Resetter_Probe_Http:
+		return fmt.Sprintf("%s(%q, status = %d)", httpName, x.Http.GetUrl(), x.Http.GetStatus())
+	case *fm.Clt_Fuzz_Resetter_Probe_LogLine:
+		return fmt.Sprintf("%s(%q)", logLineName, x.LogLine)
+	case *fm.Clt_Fuzz_Resetter_Probe_Predicate:
+		return fmt.Sprintf("%s(%s)", predicateName, x.Predicate)
+	default:
+		panic("unreachable")
+	}
+}
+
+// Type names a probe's Starlark type
+func (p *Probe) Type() string { return probeTypeOf }
+func (p *Probe) Freeze() {
+	if p.fn != nil {
+		p.fn.Freeze()
+	}
+}
+func (p *Probe) Truth() starlark.Bool  { return starlark.True }
+func (p *Probe) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", probeTypeOf) }
+
+// Wait blocks until the probe succeeds, ctx is done or the probe times out.
+// lines is read from when probing for a line of output.
+func (p *Probe) Wait(ctx context.Context, lines <-chan string) (err error) {
+	ctx, cancel := context.WithTimeout(ctx, p.Timeout())
+	defer cancel()
+
+	switch x := p.pb.GetProbe().(type) {
+	case *fm.Clt_Fuzz_Resetter_Probe_Tcp:
+		err = poll(ctx, func(ctx context.Context) error {
+			conn, err := (&net.Dialer{}).DialContext(ctx, "tcp", x.Tcp)
+			if err != nil {
+				return err
+			}
+			return conn.Close()
+		})
+
+	case *fm.Clt_Fuzz_Resetter_Probe_Http:
+		client := &http.Client{}
+		err = poll(ctx, func(ctx context.Context) error {
+			req, err := http.NewRequestWithContext(ctx, http.MethodGet, x.Http.GetUrl(), nil)
+			if err != nil {
+				return err
+			}
+			rep, err := client.Do(req)
+			if err != nil {
+				return err
+			}
+			rep.Body.Close()
+			if rep.StatusCode != int(x.Http.GetStatus()) {
+				return fmt.Errorf("got %s", rep.Status)
+			}
+			return nil
+		})
+		client.CloseIdleConnections()
+
+	case *fm.Clt_Fuzz_Resetter_Probe_LogLine:
+		err = p.waitForLine(ctx, lines)
+
+	case *fm.Clt_Fuzz_Resetter_Probe_Predicate:
+		err = poll(ctx, p.callPredicate)
+	}
+	if err != nil {
+		err = fmt.Errorf("%s: %v", p, err)
+	}
+	return
+}
+
+func (p *Probe) waitForLine(ctx context.Context, lines <-chan string) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case line, ok := <-lines:
+			if !ok {
+				return errors.New("no more output")
+			}
+			if p.re.MatchString(line) {
+				return nil
+			}
+		}
+	}
+}
+
+func (p *Probe) callPredicate(ctx context.Context) error {
+	th := &starlark.Thread{
+		Name:  predicateName,
+		Print: func(_ *starlark.Thread, msg string) { log.Println("[NFO]", msg) },
+	}
+	stop := context.AfterFunc(ctx, func() { th.Cancel(ctx.Err().Error()) })
+	defer stop()
+
+	ret, err := starlark.Call(th, p.fn, nil, nil)
+	if err != nil {
+		return err
+	}
+	switch ret {
+	case starlark.True:
+		return nil
+	case starlark.False:
+		return errors.New("got False")
+	default:
+		return permanentError{fmt.Errorf("want bool, got %s", ret.Type())}
+	}
+}
+
+// permanentError stops polling as retrying cannot help
+type permanentError struct{ error }
+
+// poll tries until success or ctx is done, reporting the last failure
+func poll(ctx context.Context, try func(context.Context) error) (err error) {
+	ticker := time.NewTicker(pollEvery)
+	defer ticker.Stop()
+	for {
+		tryCtx, cancel := context.WithTimeout(ctx, tryTimeout)
+		tryErr := try(tryCtx)
+		cancel()
+		if tryErr == nil {
+			return nil
+		}
+		if perm, ok := tryErr.(permanentError); ok {
+			return perm.error
+		}
+		if err == nil || ctx.Err() == nil {
+			// Don't report an attempt interrupted by ctx
+			err = tryErr
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("%v (last attempt: %v)", ctx.Err(), err)
+		case <-ticker.C:
+		}
+	}
+}
+
+// validURL returns an error if u is not an absolute http(s) URL
+func validURL(u string) error {
+	parsed, err := url.ParseRequestURI(u)
+	if err != nil {
+		return err
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" {
+		return fmt.Errorf("want an http:// or https:// URL, got: %q", u)
+	}
+	return nil
+}
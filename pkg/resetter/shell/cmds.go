@@ -3,6 +3,8 @@ package shell
 import (
 	"errors"
 	"log"
+	"strings"
+	"time"
 )
 
 type shellCmd int
@@ -21,11 +23,33 @@ func (cmd shellCmd) String() string {
 	}[cmd]
 }
 
+// code returns what the shell runs for cmd
+func (s *Resetter) code(cmd shellCmd) string {
+	scripts := map[shellCmd]struct{ code, file string }{
+		cmdStart: {s.Start, s.StartFile},
+		cmdReset: {s.Rst, s.RstFile},
+		cmdStop:  {s.Stop, s.StopFile},
+	}[cmd]
+	if scripts.file == "" {
+		return scripts.code
+	}
+	return "'./" + strings.ReplaceAll(scripts.file, "'", `'\''`) + "'"
+}
+
+func (s *Resetter) timeout(cmd shellCmd) time.Duration {
+	ms := map[shellCmd]uint32{
+		cmdStart: s.StartTimeoutMs,
+		cmdReset: s.RstTimeoutMs,
+		cmdStop:  s.StopTimeoutMs,
+	}[cmd]
+	return time.Duration(ms) * time.Millisecond
+}
+
 func (s *Resetter) commands() (cmds []shellCmd, err error) {
 	var (
-		hasStart = "" != s.Start
-		hasReset = "" != s.Rst
-		hasStop  = "" != s.Stop
+		hasStart = "" != s.code(cmdStart)
+		hasReset = "" != s.code(cmdReset)
+		hasStop  = "" != s.code(cmdStop)
 	)
 	switch {
 	case !hasStart && hasReset && !hasStop:
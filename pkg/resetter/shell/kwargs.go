@@ -0,0 +1,79 @@
+package shell
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"go.starlark.net/starlark"
+)
+
+var _ starlark.Unpacker = (*timeouts)(nil)
+
+// timeouts unpacks either milliseconds for all scripts
+// or a dict such as {"start": 300000, "reset": 5000}
+type timeouts struct {
+	ms map[shellCmd]uint32
+}
+
+// Unpack implements starlark.Unpacker
+func (ts *timeouts) Unpack(v starlark.Value) error {
+	ts.ms = make(map[shellCmd]uint32, 3)
+	switch x := v.(type) {
+	case starlark.Int:
+		ms, err := positiveMs(x)
+		if err != nil {
+			return err
+		}
+		for _, cmd := range []shellCmd{cmdStart, cmdReset, cmdStop} {
+			ts.ms[cmd] = ms
+		}
+
+	case *starlark.Dict:
+		for _, kv := range x.Items() {
+			k, ok := kv[0].(starlark.String)
+			if !ok {
+				return fmt.Errorf("want string keys, got: %s", kv[0].String())
+			}
+			var cmd shellCmd
+			switch k.GoString() {
+			case "start":
+				cmd = cmdStart
+			case "reset":
+				cmd = cmdReset
+			case "stop":
+				cmd = cmdStop
+			default:
+				return fmt.Errorf("unexpected key %s, want one of start, reset or stop", k.String())
+			}
+			ms, err := positiveMs(kv[1])
+			if err != nil {
+				return fmt.Errorf("%s: %v", k.GoString(), err)
+			}
+			ts.ms[cmd] = ms
+		}
+
+	default:
+		return fmt.Errorf("got %s, want int or dict", v.Type())
+	}
+	return nil
+}
+
+func positiveMs(v starlark.Value) (uint32, error) {
+	ms, err := starlark.AsInt32(v)
+	if err != nil || ms <= 0 {
+		return 0, fmt.Errorf("want a positive number of milliseconds, got: %s", v.String())
+	}
+	return uint32(ms), nil
+}
+
+// scriptFile checks the kwarg names a file within the current directory
+func scriptFile(kwarg string, v starlark.String) (string, error) {
+	path := v.GoString()
+	if path == "" {
+		return "", nil
+	}
+	if !filepath.IsLocal(path) {
+		return "", fmt.Errorf("%s: want a path within the current directory, got: %q", kwarg, path)
+	}
+	return filepath.ToSlash(filepath.Clean(path)), nil
+}
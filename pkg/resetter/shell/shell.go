@@ -1,9 +1,13 @@
 package shell
 
 import (
+	"bytes"
 	"context"
+	"fmt"
 	"io"
 	"log"
+	"os"
+	"os/exec"
 	"strings"
 	"sync"
 	"time"
@@ -13,25 +17,27 @@ import (
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/ready"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
 )
 
 // Name names the Starlark builtin
 const Name = "shell"
 
-// TODO:{start,reset,strop}_file a la Bazel
-
 const (
-	shell = "/bin/bash" // TODO: use mentioned shell
+	defaultShell = "/bin/bash"
 
-	scriptTimeout = 2 * time.Minute // TODO: tune through kwargs
+	defaultScriptTimeout = 2 * time.Minute
 )
 
 // New instanciates a new resetter
 func New(kwargs []starlark.Tuple) (resetter.Interface, error) {
 	var lot struct {
-		name, start, reset, stop starlark.String
-		provides                 tags.UniqueStringsNonEmpty
+		name, start, reset, stop              starlark.String
+		startFile, resetFile, stopFile, shell starlark.String
+		provides                              tags.UniqueStringsNonEmpty
+		timeoutMs                             timeouts
+		ready                                 *ready.Probe
 	}
 	if err := starlark.UnpackArgs(Name, nil, kwargs,
 		"name", &lot.name,
@@ -40,7 +46,12 @@ func New(kwargs []starlark.Tuple) (resetter.Interface, error) {
 		"start??", &lot.start,
 		"reset??", &lot.reset,
 		"stop??", &lot.stop,
-		// TODO: waiton = "tcp/4000", various recipes => 1 rsttr per service
+		"start_file??", &lot.startFile,
+		"reset_file??", &lot.resetFile,
+		"stop_file??", &lot.stopFile,
+		"shell??", &lot.shell,
+		"timeout_ms??", &lot.timeoutMs,
+		"ready??", &lot.ready,
 		//TODO: tags
 	); err != nil {
 		log.Println("[ERR]", err)
@@ -56,6 +67,34 @@ func New(kwargs []starlark.Tuple) (resetter.Interface, error) {
 		return nil, err
 	}
 
+	if err := ready.WithoutOutput(lot.ready); err != nil {
+		err = fmt.Errorf("ready: %v", err)
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	files := make([]string, 0, 3)
+	for _, kwarg := range []struct {
+		name       string
+		code, file starlark.String
+	}{
+		{"start", lot.start, lot.startFile},
+		{"reset", lot.reset, lot.resetFile},
+		{"stop", lot.stop, lot.stopFile},
+	} {
+		file, err := scriptFile(kwarg.name+"_file", kwarg.file)
+		if err != nil {
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+		if file != "" && strings.TrimSpace(kwarg.code.GoString()) != "" {
+			err = fmt.Errorf("only one of %s or %s_file can be given", kwarg.name, kwarg.name)
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+		files = append(files, file)
+	}
+
 	// verify all
 
 	// assemble
@@ -63,10 +102,24 @@ func New(kwargs []starlark.Tuple) (resetter.Interface, error) {
 	s := &Resetter{
 		name:     name,
 		provides: lot.provides.GoStrings(),
+		probe:    lot.ready,
 	}
 	s.Start = strings.TrimSpace(lot.start.GoString())
 	s.Rst = strings.TrimSpace(lot.reset.GoString())
 	s.Stop = strings.TrimSpace(lot.stop.GoString())
+	s.StartFile, s.RstFile, s.StopFile = files[0], files[1], files[2]
+	if s.Shell = lot.shell.GoString(); s.Shell == "" {
+		s.Shell = defaultShell
+	}
+	timeout := func(cmd shellCmd) uint32 {
+		if ms, ok := lot.timeoutMs.ms[cmd]; ok {
+			return ms
+		}
+		return uint32(defaultScriptTimeout.Milliseconds())
+	}
+	s.StartTimeoutMs = timeout(cmdStart)
+	s.RstTimeoutMs = timeout(cmdReset)
+	s.StopTimeoutMs = timeout(cmdStop)
 	return s, nil
 }
 
@@ -77,6 +130,7 @@ type Resetter struct {
 	name     string
 	provides []string
 	fm.Clt_Fuzz_Resetter_Shell
+	probe *ready.Probe
 
 	isNotFirstRun bool
 
@@ -85,6 +139,7 @@ type Resetter struct {
 	stdin          io.WriteCloser
 	sherr          chan error
 	rcoms          chan uint8
+	exited         chan struct{}
 }
 
 // Name uniquely identifies this instance
@@ -95,12 +150,56 @@ func (s *Resetter) Provides() []string { return s.provides }
 
 // ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
 func (s *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
-	return &fm.Clt_Fuzz_Resetter{
+	pb := &fm.Clt_Fuzz_Resetter{
 		Name:     s.name,
 		Provides: s.provides,
 		Resetter: &fm.Clt_Fuzz_Resetter_Shell_{
 			Shell: &s.Clt_Fuzz_Resetter_Shell,
 		}}
+	if s.probe != nil {
+		pb.Ready = s.probe.ToProto()
+	}
+	return pb
+}
+
+// Ready optionally tells when the System Under Test can be used
+func (s *Resetter) Ready() *ready.Probe { return s.probe }
+
+// Lint checks the configuration against the current directory
+func (s *Resetter) Lint(ctx context.Context) (err error) {
+	if _, err = exec.LookPath(s.Shell); err != nil {
+		err = fmt.Errorf("shell: %v", err)
+		log.Println("[ERR]", err)
+		return
+	}
+	// Scripts are written for bash and run with --norc
+	if out, _ := exec.CommandContext(ctx, s.Shell, "--norc", "-c", `echo "$BASH_VERSION"`).Output(); len(bytes.TrimSpace(out)) == 0 {
+		err = fmt.Errorf("shell: want bash, got %q", s.Shell)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	for _, script := range []struct{ kwarg, file string }{
+		{"start_file", s.StartFile},
+		{"reset_file", s.RstFile},
+		{"stop_file", s.StopFile},
+	} {
+		if script.file == "" {
+			continue
+		}
+		var fi os.FileInfo
+		if fi, err = os.Stat(script.file); err != nil {
+			err = fmt.Errorf("%s: %v", script.kwarg, err)
+			log.Println("[ERR]", err)
+			return
+		}
+		if !fi.Mode().IsRegular() || fi.Mode().Perm()&0111 == 0 {
+			err = fmt.Errorf("%s: not an executable file: %q", script.kwarg, script.file)
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	return
 }
 
 // ExecStart executes the setup phase of the System Under Test
@@ -134,7 +233,7 @@ func (s *Resetter) ExecStop(ctx context.Context, shower progresser.Shower, only
 
 // Terminate cleans up after a resetter.Interface implementation instance
 func (s *Resetter) Terminate(ctx context.Context, shower progresser.Shower, envRead map[string]string) (err error) {
-	if hasStop := s.Stop != ""; hasStop {
+	if hasStop := s.code(cmdStop) != ""; hasStop {
 		if err = s.ExecStop(ctx, shower, true, envRead); err != nil {
 			log.Println("[ERR]", err)
 			return
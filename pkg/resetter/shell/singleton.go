@@ -11,6 +11,7 @@ import (
 	"os/exec"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -41,7 +42,7 @@ func (s *Resetter) signal(verb, param string) {
 	}
 }
 
-func writeMainScript(name string, paths []string) (err error) {
+func writeMainScript(shell, name string, paths []string) (err error) {
 	var script *os.File
 	if script, err = os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0740); err != nil {
 		return
@@ -80,7 +81,7 @@ done
 	return
 }
 
-func writeScript(scriptFile, cmdName, code string, envRead map[string]string) (err error) {
+func writeScript(shell, scriptFile, cmdName, code string, envRead map[string]string) (err error) {
 	var script *os.File
 	if script, err = os.OpenFile(scriptFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0740); err != nil {
 		return
@@ -92,7 +93,8 @@ func writeScript(scriptFile, cmdName, code string, envRead map[string]string) (e
 	for k, v := range envRead {
 		// -r     Make  names  readonly.   These names cannot then be assigned values by subsequent
 		//        assignment statements or unset.
-		fmt.Fprintf(script, "declare -p %s >/dev/null 2>&1 || declare -r %s=%s\n", k, k, v)
+		// -x     Mark names for export to subsequent commands via the environment.
+		fmt.Fprintf(script, "declare -p %s >/dev/null 2>&1 || declare -rx %s=%s\n", k, k, v)
 	}
 	fmt.Fprintln(script)
 	fmt.Fprintln(script, "set -o errexit")
@@ -119,18 +121,27 @@ func (s *Resetter) exec(ctx context.Context, shower progresser.Shower, envRead m
 		return
 	}
 
+	if s.exited != nil {
+		select {
+		case <-s.exited:
+			// Failing scripts take the shell down with them: start anew
+			s.scriptsCreator = sync.Once{}
+		default:
+		}
+	}
+
 	s.scriptsCreator.Do(func() {
 		paths := make([]string, 0, 3)
 		s.scriptsPaths = make(map[shellCmd]string, 3)
 		for cmd, command := range map[shellCmd]struct {
 			Name, Code string
 		}{
-			cmdStart: {"start", s.Start},
-			cmdReset: {"reset", s.Rst},
-			cmdStop:  {"stop", s.Stop},
+			cmdStart: {"start", s.code(cmdStart)},
+			cmdReset: {"reset", s.code(cmdReset)},
+			cmdStop:  {"stop", s.code(cmdStop)},
 		} {
-			path := fmt.Sprintf("%s%s.bash", cwid.Prefixed(), command.Name)
-			if err = writeScript(path, command.Name, command.Code, envRead); err != nil {
+			path := fmt.Sprintf("%sshell_%s_%s.bash", cwid.Prefixed(), s.name, command.Name)
+			if err = writeScript(s.Shell, path, command.Name, command.Code, envRead); err != nil {
 				log.Println("[ERR]", err)
 				return
 			}
@@ -138,14 +149,15 @@ func (s *Resetter) exec(ctx context.Context, shower progresser.Shower, envRead m
 			paths = append(paths, path)
 		}
 
-		main := fmt.Sprintf("%s%s.bash", cwid.Prefixed(), "main")
-		if err = writeMainScript(main, paths); err != nil {
+		main := fmt.Sprintf("%sshell_%s_%s.bash", cwid.Prefixed(), s.name, "main")
+		if err = writeMainScript(s.Shell, main, paths); err != nil {
 			return
 		}
 
-		s.sherr = make(chan error, 1)
+		sherr, exited := make(chan error, 1), make(chan struct{})
+		s.sherr, s.exited = sherr, exited
 		// TODO: isolate shell better. See: https://github.com/maxmcd/bramble/blob/205f61427fe505d109d22ef94967561006d6c83d/internal/command/cli.go#L258
-		exe := exec.CommandContext(ctx, shell, "--norc", "--", main)
+		exe := exec.CommandContext(ctx, s.Shell, "--norc", "--", main)
 		stdin, err := exe.StdinPipe()
 		if err != nil {
 			log.Println("[ERR]", err)
@@ -191,7 +203,8 @@ func (s *Resetter) exec(ctx context.Context, shower progresser.Shower, envRead m
 		stdout := printer(shower.Printf)
 		stderr := printer(shower.Errorf)
 
-		// TODO: mux stderr+stdout and fwd to server to track progress
+		// NOTE: shower interleaves stderr+stdout into the run's transcript
+		// TODO: fwd to server to track progress
 		exe.Stdout = io.MultiWriter(stdout, coms)
 		exe.Stderr = io.MultiWriter(stderr, coms)
 
@@ -199,10 +212,12 @@ func (s *Resetter) exec(ctx context.Context, shower progresser.Shower, envRead m
 			log.Printf("[DBG] starting shell instance")
 			defer log.Println("[NFO] shell singleton exited")
 
-			if err := exe.Run(); err != nil {
+			err := exe.Run()
+			close(exited)
+			if err != nil {
 				log.Println("[ERR] forwarding error:", err)
 				lines := append([][]byte(nil), []byte(err.Error()))
-				s.sherr <- resetter.NewError(lines)
+				sherr <- resetter.NewError(lines)
 			}
 		}()
 	})
@@ -244,9 +259,9 @@ func (s *Resetter) execEach(ctx context.Context, cmd shellCmd) (err error) {
 		}
 		return
 
-	case <-time.After(scriptTimeout):
+	case <-time.After(s.timeout(cmd)):
 		err = context.Canceled
-		log.Printf("[ERR] %s scriptTimeout=%s: %s", cmd, scriptTimeout, err)
+		log.Printf("[ERR] %s timeout=%s: %s", cmd, s.timeout(cmd), err)
 		return
 	}
 
@@ -0,0 +1,182 @@
+package snapshot
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/ready"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
+)
+
+// Name names the Starlark builtin
+const Name = "snapshot"
+
+// New instanciates a new resetter
+func New(kwargs []starlark.Tuple) (resetter.Interface, error) {
+	var lot struct {
+		name            starlark.String
+		provides, paths tags.UniqueStringsNonEmpty
+		ready           *ready.Probe
+	}
+	if err := starlark.UnpackArgs(Name, nil, kwargs,
+		"name", &lot.name,
+		"provides", &lot.provides,
+		"paths", &lot.paths,
+		"ready?", &lot.ready,
+	); err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	log.Printf("[DBG] unpacked %+v", lot)
+
+	// verify each
+
+	name := lot.name.GoString()
+	if err := tags.LegalName(name); err != nil { //TODO: newUserError
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	if err := ready.WithoutOutput(lot.ready); err != nil {
+		err = fmt.Errorf("ready: %v", err)
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	paths := make([]string, 0, len(lot.paths.GoStrings()))
+	for _, path := range lot.paths.GoStrings() {
+		clean := filepath.Clean(path)
+		if path == "" || clean == "." || clean == string(filepath.Separator) {
+			err := fmt.Errorf("cannot snapshot path %q", path)
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+		paths = append(paths, clean)
+	}
+
+	// verify all
+
+	for i, a := range paths {
+		for _, b := range paths[i+1:] {
+			if a == b || within(a, b) || within(b, a) {
+				err := fmt.Errorf("paths %q and %q overlap", a, b)
+				log.Println("[ERR]", err)
+				return nil, err
+			}
+		}
+	}
+
+	// assemble
+
+	s := &Resetter{
+		name:     name,
+		provides: lot.provides.GoStrings(),
+		probe:    lot.ready,
+	}
+	s.Paths = paths
+	return s, nil
+}
+
+func within(parent, path string) bool {
+	return strings.HasPrefix(path, parent+string(filepath.Separator))
+}
+
+var _ resetter.Interface = (*Resetter)(nil)
+
+// Resetter implements resetter.Interface
+type Resetter struct {
+	name     string
+	provides []string
+	fm.Clt_Fuzz_Resetter_Snapshot
+	probe *ready.Probe
+
+	isNotFirstRun bool
+}
+
+// Name uniquely identifies this instance
+func (s *Resetter) Name() string { return s.name }
+
+// Provides lists the models a resetter resets
+func (s *Resetter) Provides() []string { return s.provides }
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (s *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	pb := &fm.Clt_Fuzz_Resetter{
+		Name:     s.name,
+		Provides: s.provides,
+		Resetter: &fm.Clt_Fuzz_Resetter_Snapshot_{
+			Snapshot: &s.Clt_Fuzz_Resetter_Snapshot,
+		}}
+	if s.probe != nil {
+		pb.Ready = s.probe.ToProto()
+	}
+	return pb
+}
+
+// Ready optionally tells when the System Under Test can be used
+func (s *Resetter) Ready() *ready.Probe { return s.probe }
+
+// dir is where the snapshot is kept. Outside of test runs (only is set)
+// it outlives the run so `monkey exec reset` can restore what `monkey exec start` took,
+// until `monkey exec stop` drops it.
+func (s *Resetter) dir(only bool) string {
+	if only {
+		return cwid.Shared() + "snapshot_" + s.name
+	}
+	return cwid.Prefixed() + "snapshot_" + s.name
+}
+
+// Lint checks the configuration against the current directory
+func (s *Resetter) Lint(ctx context.Context) error { return nil }
+
+var _ resetter.Baseliner = (*Resetter)(nil)
+
+// StartTakesBaseline reports whether ExecStart replaces the state resets go back to
+func (s *Resetter) StartTakesBaseline() bool { return true }
+
+// ExecStart executes the setup phase of the System Under Test
+func (s *Resetter) ExecStart(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	return s.take(ctx, shower, s.dir(only))
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (s *Resetter) ExecReset(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	if only {
+		// Makes `monkey exec reset` run as if in between tests
+		s.isNotFirstRun = true
+	}
+
+	if !s.isNotFirstRun {
+		s.isNotFirstRun = true
+		return s.take(ctx, shower, s.dir(only))
+	}
+	return s.restore(ctx, shower, s.dir(only))
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (s *Resetter) ExecStop(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	return s.drop(s.dir(only))
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (s *Resetter) Terminate(ctx context.Context, shower progresser.Shower, envRead map[string]string) error {
+	return s.drop(s.dir(false))
+}
+
+func (s *Resetter) drop(dir string) (err error) {
+	log.Printf("[NFO] dropping snapshot %s", dir)
+	if err = os.RemoveAll(dir); err != nil {
+		log.Println("[ERR]", err)
+	}
+	return
+}
@@ -0,0 +1,328 @@
+package snapshot
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+)
+
+const manifestFile = "manifest.json"
+
+// SQLite keeps uncommitted or not yet checkpointed data next to the database
+var sqliteCompanions = []string{"-wal", "-shm", "-journal"}
+
+// manifest describes a snapshot
+type manifest struct {
+	Paths   []string `json:"paths"`
+	Entries []entry  `json:"entries"`
+}
+
+type entry struct {
+	Path   string      `json:"path"`
+	Mode   fs.FileMode `json:"mode"`
+	Dir    bool        `json:"dir,omitempty"`
+	Stored string      `json:"stored,omitempty"`
+	Size   int64       `json:"size,omitempty"`
+	Sha256 string      `json:"sha256,omitempty"`
+}
+
+func (s *Resetter) take(ctx context.Context, shower progresser.Shower, dir string) (err error) {
+	start := time.Now()
+	log.Printf("[NFO] snapshotting %v into %s", s.Paths, dir)
+
+	if err = os.RemoveAll(dir); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	m := manifest{Paths: s.Paths}
+	var size int64
+	add := func(path string, fi fs.FileInfo) (err error) {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		e := entry{Path: path, Mode: fi.Mode().Perm()}
+		switch {
+		case fi.IsDir():
+			e.Dir = true
+		case fi.Mode().IsRegular():
+			e.Stored = strconv.Itoa(len(m.Entries))
+			if e.Size, e.Sha256, err = copyFile(filepath.Join(dir, e.Stored), path, 0600, ""); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			size += e.Size
+		default:
+			err = fmt.Errorf("cannot snapshot %q: not a regular file nor a directory", path)
+			log.Println("[ERR]", err)
+			return
+		}
+		m.Entries = append(m.Entries, e)
+		return
+	}
+
+	for _, root := range s.Paths {
+		var fi fs.FileInfo
+		if fi, err = os.Lstat(root); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+
+		if !fi.IsDir() {
+			if err = add(root, fi); err != nil {
+				return
+			}
+			for _, suffix := range sqliteCompanions {
+				companion := root + suffix
+				if fi, err = os.Lstat(companion); err != nil {
+					if os.IsNotExist(err) {
+						err = nil
+						continue
+					}
+					log.Println("[ERR]", err)
+					return
+				}
+				if err = add(companion, fi); err != nil {
+					return
+				}
+			}
+			continue
+		}
+
+		if err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			fi, err := d.Info()
+			if err != nil {
+				return err
+			}
+			return add(path, fi)
+		}); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+
+	if err = writeManifest(dir, &m); err != nil {
+		return
+	}
+
+	shower.Printf("Snapshotted %d entries (%d bytes) in %s", len(m.Entries), size, time.Since(start))
+	return
+}
+
+func (s *Resetter) restore(ctx context.Context, shower progresser.Shower, dir string) (err error) {
+	start := time.Now()
+	log.Printf("[NFO] restoring %v from %s", s.Paths, dir)
+
+	var m *manifest
+	if m, err = readManifest(dir); err != nil {
+		return
+	}
+	if fmt.Sprint(m.Paths) != fmt.Sprint(s.Paths) {
+		err = fmt.Errorf("snapshot in %s was taken of %v, not %v", dir, m.Paths, s.Paths)
+		log.Println("[ERR]", err)
+		return
+	}
+
+	// Stage every file next to its destination, checking digests,
+	// before touching anything.
+	staged := make(map[string]string, len(m.Entries))
+	tmps := make(map[string]struct{}, len(m.Entries))
+	defer func() {
+		for _, tmp := range staged {
+			_ = os.Remove(tmp)
+		}
+	}()
+	known := make(map[string]struct{}, len(m.Entries))
+	for _, e := range m.Entries {
+		if err = ctx.Err(); err != nil {
+			return
+		}
+		known[e.Path] = struct{}{}
+
+		if e.Dir {
+			if err = os.MkdirAll(e.Path, 0700); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			// Stays writable until files are in place
+			if err = os.Chmod(e.Path, e.Mode|0700); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+			continue
+		}
+
+		var tmp *os.File
+		if tmp, err = os.CreateTemp(filepath.Dir(e.Path), "."+filepath.Base(e.Path)+".*"); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		staged[e.Path] = tmp.Name()
+		tmps[tmp.Name()] = struct{}{}
+		if err = tmp.Close(); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		if _, _, err = copyFile(tmp.Name(), filepath.Join(dir, e.Stored), e.Mode, e.Sha256); err != nil {
+			err = fmt.Errorf("cannot restore %q: %v", e.Path, err)
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+
+	// Drop what appeared since the snapshot was taken
+	var extras []string
+	for _, root := range m.Paths {
+		var fi fs.FileInfo
+		if fi, err = os.Lstat(root); err != nil && !os.IsNotExist(err) {
+			log.Println("[ERR]", err)
+			return
+		}
+		// A deleted file is put back from the snapshot
+		if err != nil || !fi.IsDir() {
+			err = nil
+			for _, suffix := range sqliteCompanions {
+				companion := root + suffix
+				if _, ok := known[companion]; !ok {
+					extras = append(extras, companion)
+				}
+			}
+			continue
+		}
+		if err = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+			if _, ok := known[path]; ok {
+				return nil
+			}
+			if _, ok := tmps[path]; ok {
+				return nil
+			}
+			extras = append(extras, path)
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+	for _, extra := range extras {
+		if err = os.RemoveAll(extra); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+	}
+
+	paths := make([]string, 0, len(staged))
+	for path := range staged {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if err = os.Rename(staged[path], path); err != nil {
+			log.Println("[ERR]", err)
+			return
+		}
+		delete(staged, path)
+	}
+	for _, e := range m.Entries {
+		if e.Dir {
+			if err = os.Chmod(e.Path, e.Mode); err != nil {
+				log.Println("[ERR]", err)
+				return
+			}
+		}
+	}
+
+	shower.Printf("Restored %d entries (removed %d) in %s", len(m.Entries), len(extras), time.Since(start))
+	return
+}
+
+// copyFile copies src to dst, failing if its digest differs from a non-empty expected one.
+func copyFile(dst, src string, mode fs.FileMode, expected string) (size int64, digest string, err error) {
+	var r *os.File
+	if r, err = os.Open(src); err != nil {
+		return
+	}
+	defer r.Close()
+
+	var w *os.File
+	if w, err = os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode); err != nil {
+		return
+	}
+	defer func() {
+		if errC := w.Close(); errC != nil && err == nil {
+			err = errC
+		}
+	}()
+
+	h := sha256.New()
+	if size, err = io.Copy(io.MultiWriter(w, h), r); err != nil {
+		return
+	}
+	digest = hex.EncodeToString(h.Sum(nil))
+	if expected != "" && digest != expected {
+		err = fmt.Errorf("corrupted snapshot: got sha256 %s, expected %s", digest, expected)
+		return
+	}
+	if err = w.Chmod(mode); err != nil {
+		return
+	}
+	err = w.Sync()
+	return
+}
+
+func writeManifest(dir string, m *manifest) (err error) {
+	var data []byte
+	if data, err = json.Marshal(m); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	tmp := filepath.Join(dir, manifestFile+".tmp")
+	if err = os.WriteFile(tmp, data, 0600); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+	if err = os.Rename(tmp, filepath.Join(dir, manifestFile)); err != nil {
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+func readManifest(dir string) (m *manifest, err error) {
+	var data []byte
+	if data, err = os.ReadFile(filepath.Join(dir, manifestFile)); err != nil {
+		if os.IsNotExist(err) {
+			err = fmt.Errorf("no snapshot in %s: run `monkey exec start` first", dir)
+		}
+		log.Println("[ERR]", err)
+		return
+	}
+	m = &manifest{}
+	if err = json.Unmarshal(data, m); err != nil {
+		log.Println("[ERR]", err)
+	}
+	return
+}
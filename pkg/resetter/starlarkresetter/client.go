@@ -0,0 +1,247 @@
+package starlarkresetter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"sort"
+	"strings"
+
+	"go.starlark.net/starlark"
+	"go.starlark.net/starlarkstruct"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarkvalue"
+)
+
+const clientTypeOf = "client"
+
+var _ starlark.HasAttrs = (*client)(nil)
+
+// client is given to phase functions: it issues HTTP calls and reads env
+type client struct {
+	ctx     context.Context
+	shower  progresser.Shower
+	http    *http.Client
+	envRead map[string]string
+	attrs   map[string]*starlark.Builtin
+}
+
+func newClient(ctx context.Context, shower progresser.Shower, hc *http.Client, envRead map[string]string) *client {
+	c := &client{
+		ctx:     ctx,
+		shower:  shower,
+		http:    hc,
+		envRead: envRead,
+	}
+	c.attrs = map[string]*starlark.Builtin{
+		"env":     starlark.NewBuiltin("env", c.bEnv).BindReceiver(c),
+		"export":  starlark.NewBuiltin("export", c.bExport).BindReceiver(c),
+		"request": starlark.NewBuiltin("request", c.bRequest).BindReceiver(c),
+	}
+	for _, method := range []string{http.MethodDelete, http.MethodGet, http.MethodPatch, http.MethodPost, http.MethodPut} {
+		name := strings.ToLower(method)
+		c.attrs[name] = starlark.NewBuiltin(name, c.bMethod(method)).BindReceiver(c)
+	}
+	return c
+}
+
+// AttrNames lists the client's builtins
+func (c *client) AttrNames() []string {
+	names := make([]string, 0, len(c.attrs))
+	for name := range c.attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Attr returns one of the client's builtins
+func (c *client) Attr(name string) (starlark.Value, error) {
+	if v := c.attrs[name]; v != nil {
+		return v, nil
+	}
+	return nil, nil // no such method
+}
+
+func (c *client) String() string        { return clientTypeOf }
+func (c *client) Type() string          { return clientTypeOf }
+func (c *client) Freeze()               {}
+func (c *client) Truth() starlark.Bool  { return true }
+func (c *client) Hash() (uint32, error) { return 0, fmt.Errorf("unhashable type: %s", clientTypeOf) }
+
+// bEnv reads an environment variable, preferring values read by monkey.env(...)
+func (c *client) bEnv(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var env starlark.String
+	var def starlark.Value
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 1, &env, &def); err != nil {
+		return nil, err
+	}
+	if def != nil {
+		if _, ok := def.(starlark.String); !ok {
+			return nil, fmt.Errorf("expected string, got %s: %s", def.Type(), def.String())
+		}
+	}
+
+	envStr := env.GoString()
+	if read, ok := c.envRead[envStr]; ok {
+		return starlark.String(read), nil
+	}
+	if read, ok := os.LookupEnv(envStr); ok {
+		log.Printf("[NFO] read env %q: %q", envStr, read)
+		return starlark.String(read), nil
+	}
+	if def == nil {
+		return nil, fmt.Errorf("unset environment variable: %q", envStr)
+	}
+	return def, nil
+}
+
+// bExport publishes a value to the runtime, e.g. for checks' ctx.resetter_env
+func (c *client) bExport(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var key, value starlark.String
+	if err := starlark.UnpackPositionalArgs(b.Name(), args, kwargs, 2, &key, &value); err != nil {
+		return nil, err
+	}
+	if !resetter.ExportKey.MatchString(key.GoString()) {
+		return nil, fmt.Errorf("%s: cannot export %s: want a key matching %s", b.Name(), key, resetter.ExportKey)
+	}
+	if strings.Contains(value.GoString(), "\n") {
+		return nil, fmt.Errorf("%s: cannot export %s=%s on one line", b.Name(), key, value)
+	}
+
+	path, ok := c.envRead[resetter.EnvExport]
+	if !ok {
+		return nil, fmt.Errorf("%s: nowhere to export to", b.Name())
+	}
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0600)
+	if err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	defer f.Close()
+	if _, err = fmt.Fprintf(f, "%s=%s\n", key.GoString(), value.GoString()); err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	return starlark.None, nil
+}
+
+func (c *client) bRequest(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+	var method, u starlark.String
+	var headers *starlark.Dict
+	var body starlark.Value
+	if err := starlark.UnpackArgs(b.Name(), args, kwargs,
+		"method", &method,
+		"url", &u,
+		"headers?", &headers,
+		"body?", &body,
+	); err != nil {
+		return nil, err
+	}
+	return c.do(b.Name(), strings.ToUpper(method.GoString()), u.GoString(), headers, body)
+}
+
+func (c *client) bMethod(method string) func(*starlark.Thread, *starlark.Builtin, starlark.Tuple, []starlark.Tuple) (starlark.Value, error) {
+	return func(th *starlark.Thread, b *starlark.Builtin, args starlark.Tuple, kwargs []starlark.Tuple) (starlark.Value, error) {
+		var u starlark.String
+		var headers *starlark.Dict
+		var body starlark.Value
+		pairs := []interface{}{"url", &u, "headers?", &headers}
+		if method != http.MethodGet && method != http.MethodDelete {
+			pairs = append(pairs, "body?", &body)
+		}
+		if err := starlark.UnpackArgs(b.Name(), args, kwargs, pairs...); err != nil {
+			return nil, err
+		}
+		return c.do(b.Name(), method, u.GoString(), headers, body)
+	}
+}
+
+func (c *client) do(name, method, u string, headers *starlark.Dict, body starlark.Value) (starlark.Value, error) {
+	var reqBody io.Reader
+	contentType := ""
+	switch x := body.(type) {
+	case nil, starlark.NoneType:
+	case starlark.String:
+		reqBody = strings.NewReader(x.GoString())
+	case starlark.Bytes:
+		reqBody = strings.NewReader(string(x))
+	default:
+		if err := starlarkvalue.ProtoCompatible(x); err != nil {
+			return nil, fmt.Errorf("%s: body: %v", name, err)
+		}
+		data, err := protojson.Marshal(starlarkvalue.ToProtoValue(x))
+		if err != nil {
+			return nil, fmt.Errorf("%s: body: %v", name, err)
+		}
+		reqBody = bytes.NewReader(data)
+		contentType = "application/json"
+	}
+
+	req, err := http.NewRequestWithContext(c.ctx, method, u, reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if headers != nil {
+		for _, kv := range headers.Items() {
+			k, okK := kv[0].(starlark.String)
+			v, okV := kv[1].(starlark.String)
+			if !okK || !okV {
+				return nil, fmt.Errorf("%s: headers: want strings mapped to strings, got: %s = %s", name, kv[0].String(), kv[1].String())
+			}
+			req.Header.Set(k.GoString(), v.GoString())
+		}
+	}
+
+	c.shower.Printf("> %s %s", method, u)
+	rep, err := c.http.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	defer rep.Body.Close()
+	c.shower.Printf("< %s", rep.Status)
+
+	repBody, err := io.ReadAll(rep.Body)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", name, err)
+	}
+	return response(rep, repBody), nil
+}
+
+// response exposes status_code, headers, body and the decoded JSON body (or None)
+func response(rep *http.Response, body []byte) starlark.Value {
+	headers := starlark.NewDict(len(rep.Header))
+	keys := make([]string, 0, len(rep.Header))
+	for key := range rep.Header {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value := starlark.String(strings.Join(rep.Header.Values(key), ", "))
+		_ = headers.SetKey(starlark.String(key), value)
+	}
+
+	var decoded starlark.Value = starlark.None
+	var pv structpb.Value
+	if err := protojson.Unmarshal(body, &pv); err == nil {
+		decoded = starlarkvalue.FromProtoValue(&pv)
+	}
+
+	return starlarkstruct.FromStringDict(starlarkstruct.Default, starlark.StringDict{
+		"body":        starlark.String(body),
+		"headers":     headers,
+		"json":        decoded,
+		"status_code": starlark.MakeInt(rep.StatusCode),
+	})
+}
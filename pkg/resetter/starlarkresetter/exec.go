@@ -0,0 +1,57 @@
+package starlarkresetter
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarktruth"
+)
+
+func (s *Resetter) exec(ctx context.Context, shower progresser.Shower, envRead map[string]string, phases ...resetter.Phase) (err error) {
+	for _, p := range phases {
+		fn := s.fns[p]
+		if fn == nil {
+			continue
+		}
+		log.Printf("[NFO] running Starlark.%s", p)
+		start := time.Now()
+		if err = s.call(ctx, shower, envRead, fn); err != nil {
+			log.Printf("[ERR] %s: %s", p, err)
+			return
+		}
+		log.Printf("[NFO] exec'd %s in %s", p, time.Since(start))
+	}
+	return
+}
+
+func (s *Resetter) call(ctx context.Context, shower progresser.Shower, envRead map[string]string, fn *starlark.Function) (err error) {
+	th := &starlark.Thread{
+		Name:  s.name,
+		Print: func(_ *starlark.Thread, msg string) { shower.Printf("%s", msg) },
+	}
+	stop := context.AfterFunc(ctx, func() { th.Cancel(ctx.Err().Error()) })
+	defer stop()
+
+	c := newClient(ctx, shower, s.client, envRead)
+	if _, err = starlark.Call(th, fn, starlark.Tuple{c}, nil); err == nil {
+		err = starlarktruth.Close(th)
+	}
+	if err != nil {
+		msg := err.Error()
+		if evalErr, ok := err.(*starlark.EvalError); ok {
+			msg = evalErr.Backtrace()
+		}
+		var lines [][]byte
+		for _, line := range strings.Split(msg, "\n") {
+			lines = append(lines, []byte(line))
+		}
+		err = resetter.NewError(lines)
+	}
+	return
+}
@@ -0,0 +1,187 @@
+package starlarkresetter
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"net/http"
+	"time"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/ready"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
+)
+
+// Name names the Starlark builtin
+const Name = "starlark_resetter"
+
+// Bounds each request a resetter sends
+const requestTimeout = 2 * time.Minute
+
+var errMissingReset = errors.New("missing at least `starlark_resetter( reset = fn )`")
+
+// New instanciates a new resetter
+func New(kwargs []starlark.Tuple) (resetter.Interface, error) {
+	var lot struct {
+		name               starlark.String
+		provides           tags.UniqueStringsNonEmpty
+		start, reset, stop *starlark.Function
+		ready              *ready.Probe
+	}
+	if err := starlark.UnpackArgs(Name, nil, kwargs,
+		"name", &lot.name,
+		"provides", &lot.provides,
+		// NOTE: all args following an optional? are implicitly optional.
+		"start??", &lot.start,
+		"reset??", &lot.reset,
+		"stop??", &lot.stop,
+		"ready??", &lot.ready,
+	); err != nil {
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+	log.Printf("[DBG] unpacked %+v", lot)
+
+	// verify each
+
+	name := lot.name.GoString()
+	if err := tags.LegalName(name); err != nil { //TODO: newUserError
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	for _, kwarg := range []struct {
+		name string
+		fn   *starlark.Function
+	}{
+		{"start", lot.start},
+		{"reset", lot.reset},
+		{"stop", lot.stop},
+	} {
+		if kwarg.fn != nil && kwarg.fn.NumParams() != 1 {
+			err := fmt.Errorf("%s: want a function taking one argument (a client), got %s", kwarg.name, kwarg.fn.Name())
+			log.Println("[ERR]", err)
+			return nil, err
+		}
+	}
+
+	if err := ready.WithoutOutput(lot.ready); err != nil {
+		err = fmt.Errorf("ready: %v", err)
+		log.Println("[ERR]", err)
+		return nil, err
+	}
+
+	// verify all
+
+	s := &Resetter{
+		name:     name,
+		provides: lot.provides.GoStrings(),
+		probe:    lot.ready,
+		fns: map[resetter.Phase]*starlark.Function{
+			resetter.PhaseStart: lot.start,
+			resetter.PhaseReset: lot.reset,
+			resetter.PhaseStop:  lot.stop,
+		},
+		phases: resetter.Phases{
+			HasStart: lot.start != nil,
+			HasReset: lot.reset != nil,
+			HasStop:  lot.stop != nil,
+		},
+		client: &http.Client{Timeout: requestTimeout},
+	}
+	s.Start = nameOf(lot.start)
+	s.Rst = nameOf(lot.reset)
+	s.Stop = nameOf(lot.stop)
+	if _, ok := s.phases.Plan(); !ok {
+		log.Println("[ERR]", errMissingReset)
+		return nil, errMissingReset
+	}
+
+	// assemble
+
+	return s, nil
+}
+
+var _ resetter.Interface = (*Resetter)(nil)
+
+// Resetter implements resetter.Interface
+type Resetter struct {
+	name     string
+	provides []string
+	fm.Clt_Fuzz_Resetter_Starlark
+	probe *ready.Probe
+
+	phases resetter.Phases
+
+	fns    map[resetter.Phase]*starlark.Function
+	client *http.Client
+}
+
+// Name uniquely identifies this instance
+func (s *Resetter) Name() string { return s.name }
+
+// Provides lists the models a resetter resets
+func (s *Resetter) Provides() []string { return s.provides }
+
+// ToProto marshals a resetter.Interface implementation into a *fm.Clt_Fuzz_Resetter
+func (s *Resetter) ToProto() *fm.Clt_Fuzz_Resetter {
+	pb := &fm.Clt_Fuzz_Resetter{
+		Name:     s.name,
+		Provides: s.provides,
+		Resetter: &fm.Clt_Fuzz_Resetter_Starlark_{
+			Starlark: &s.Clt_Fuzz_Resetter_Starlark,
+		}}
+	if s.probe != nil {
+		pb.Ready = s.probe.ToProto()
+	}
+	return pb
+}
+
+// Ready optionally tells when the System Under Test can be used
+func (s *Resetter) Ready() *ready.Probe { return s.probe }
+
+// Lint checks the configuration against the current directory
+func (s *Resetter) Lint(ctx context.Context) error { return nil }
+
+// ExecStart executes the setup phase of the System Under Test
+func (s *Resetter) ExecStart(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	return s.exec(ctx, shower, envRead, resetter.PhaseStart)
+}
+
+// ExecReset resets the System Under Test to a state similar to a post-ExecStart state
+func (s *Resetter) ExecReset(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	phases, ok := s.phases.Next(only)
+	if !ok {
+		log.Println("[ERR]", errMissingReset)
+		return errMissingReset
+	}
+	return s.exec(ctx, shower, envRead, phases...)
+}
+
+// ExecStop executes the cleanup phase of the System Under Test
+func (s *Resetter) ExecStop(ctx context.Context, shower progresser.Shower, only bool, envRead map[string]string) error {
+	return s.exec(ctx, shower, envRead, resetter.PhaseStop)
+}
+
+// Terminate cleans up after a resetter.Interface implementation instance
+func (s *Resetter) Terminate(ctx context.Context, shower progresser.Shower, envRead map[string]string) (err error) {
+	if s.phases.HasStop {
+		if err = s.ExecStop(ctx, shower, true, envRead); err != nil {
+			log.Println("[ERR]", err)
+		}
+	}
+	s.client.CloseIdleConnections()
+	return
+}
+
+func nameOf(fn *starlark.Function) string {
+	if fn == nil {
+		return ""
+	}
+	return fn.Name()
+}
@@ -21,7 +21,8 @@ func (rt *Runtime) call(ctx context.Context, msg *fm.Srv_Call, tagsFilter *tags.
 	print := func(msg string) { rt.progress.Printf("%s", msg) }
 
 	log.Printf("[NFO] raw input: %.999v", msg.GetInput())
-	cx := newCxModBeforeRequest(newCxRequestBeforeRequest(msg.GetInput()))
+	resetterEnv := newResetterEnvDict(rt.exportedEnv())
+	cx := newCxModBeforeRequest(newCxRequestBeforeRequest(msg.GetInput()), resetterEnv)
 
 	// Runs check(before_request = ..) sequentially
 	err := rt.forEachBeforeRequestCheck(func(name string, chk *check) error {
@@ -57,7 +58,7 @@ func (rt *Runtime) call(ctx context.Context, msg *fm.Srv_Call, tagsFilter *tags.
 	cllr := mdl.NewCaller(ctx, msg, rt.progress)
 	cllr.Do(ctx)
 
-	ctxer2 := ctxCurry(input.GetInput())
+	ctxer2 := ctxCurry(input.GetInput(), resetterEnv)
 
 	output := cllr.ResponseProto()
 	log.Printf("[NFO] call output: %.999v", output)
@@ -25,6 +25,7 @@ def ctxchecks(ctx):
     assert that(type(ctx.response)).is_equal_to("http_response")
     assert that(ctx.response.status_code).is_equal_to(404)
     assert that(ctx.response.elapsed_ms).is_within(50).of(1)
+    assert that(ctx.response.retries).is_equal_to(0)
     assert that(ctx.response).has_attribute("body")
     assert that(ctx.response.body).contains_key("error")
 
@@ -41,6 +42,142 @@ monkey.check(
 	require.Empty(t, v.Reason)
 }
 
+func TestCtxResponseTimings(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+def ctx_response_timings(ctx):
+    """
+    Ensure response timings are accessible.
+
+    Args:
+      ctx: the context that Monkey provides.
+    """
+    timings = ctx.response.timings
+    assert that(type(timings)).is_equal_to("http_timings")
+    assert that(timings.dns_ms).is_equal_to(2)
+    assert that(timings.connect_ms).is_equal_to(5)
+    assert that(timings.tls_ms).is_equal_to(11)
+    assert that(timings.ttfb_ns).is_equal_to(31000000)
+    assert that(timings.ttfb_ms).is_at_most(ctx.response.elapsed_ms)
+    assert that(timings.transfer_ms).is_equal_to(6)
+    assert that(timings.reused_conn).is_false()
+    assert that(timings.local_addr).is_equal_to("192.168.1.2:50424")
+    assert that(timings.remote_addr).is_equal_to("104.21.4.48:443")
+
+monkey.check(
+    name = "ctx_response_timings",
+    after_response = ctx_response_timings,
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+	v := rt.runFakeUserCheck(t, "ctx_response_timings")
+	require.Empty(t, v.Reason)
+}
+
+func TestCtxResponseHistory(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+def ctx_response_history(ctx):
+    """
+    Ensure followed redirects are accessible.
+
+    Args:
+      ctx: the context that Monkey provides.
+    """
+    history = ctx.response.history
+    assert that(history).has_size(1)
+    hop = history[0]
+    assert that(type(hop)).is_equal_to("http_redirect")
+    assert that(hop.method).is_equal_to("GET")
+    assert that(hop.url).is_equal_to("http://jsonplaceholder.typicode.com/albums/0")
+    assert that(hop.status_code).is_equal_to(301)
+    assert that(hop.headers.get("Location")).is_equal_to(ctx.request.url)
+    assert that(hop.elapsed_ms).is_equal_to(12)
+    history.append(hop)
+
+monkey.check(
+    name = "ctx_response_history",
+    after_response = ctx_response_history,
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+	v := rt.runFakeUserCheck(t, "ctx_response_history")
+	require.Equal(t, fm.Clt_CallVerifProgress_failure, v.Status)
+	require.Equal(t, []string{
+		"*starlark.EvalError",
+		"Traceback (most recent call last):",
+		"  fuzzymonkey.star:17:19: in ctx_response_history",
+		"Error in append: append: cannot append to frozen list",
+	}, v.Reason)
+}
+
+func TestCtxResponseEvents(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+def ctx_response_events(ctx):
+    """
+    Ensure streamed events are accessible.
+
+    Args:
+      ctx: the context that Monkey provides.
+    """
+    events = ctx.response.events
+    assert that(events).has_size(2)
+    assert that(ctx.response.events_truncated).is_true()
+    first, second = events
+    assert that(type(first)).is_equal_to("http_event")
+    assert that(first.id).is_equal_to("1")
+    assert that(first.event).is_equal_to("album")
+    assert that(first.body).is_equal_to({"id": 1})
+    assert that(first.elapsed_ms).is_equal_to(20)
+    assert that(second.event).is_equal_to("")
+    assert that(second.data).is_equal_to("ping")
+    assert that(dir(second)).does_not_contain("body")
+    events.append(first)
+
+monkey.check(
+    name = "ctx_response_events",
+    after_response = ctx_response_events,
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+	v := rt.runFakeUserCheck(t, "ctx_response_events")
+	require.Equal(t, fm.Clt_CallVerifProgress_failure, v.Status)
+	require.Equal(t, []string{
+		"*starlark.EvalError",
+		"Traceback (most recent call last):",
+		"  fuzzymonkey.star:20:18: in ctx_response_events",
+		"Error in append: append: cannot append to frozen list",
+	}, v.Reason)
+}
+
+func TestCtxResponseContentBytes(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+def ctx_response_content_bytes(ctx):
+    """
+    Ensure raw bodies and their digest are accessible.
+
+    Args:
+      ctx: the context that Monkey provides.
+    """
+    content = ctx.response.content_bytes
+    assert that(type(content)).is_equal_to("bytes")
+    assert that(ctx.response.size).is_equal_to(len(content))
+    assert that(ctx.response.size).is_equal_to(59)
+    assert that(ctx.response.content_truncated).is_false()
+    assert that(ctx.response.sha256).is_equal_to("54cf7d83437a16c7ba674caf6174c81dd5a43bf9ff440b3848a3b13f388dde53")
+
+monkey.check(
+    name = "ctx_response_content_bytes",
+    after_response = ctx_response_content_bytes,
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Len(t, rt.checks, 1)
+	v := rt.runFakeUserCheck(t, "ctx_response_content_bytes")
+	require.Equal(t, fm.Clt_CallVerifProgress_success, v.Status)
+}
+
 func TestCtxRequestHeadersFrozen(t *testing.T) {
 	rt, err := newFakeMonkey(t, `
 def ctx_request_headers_frozen(ctx):
@@ -7,3 +7,9 @@ type xUserAgent struct{}
 
 // XUserAgent key's associated value contains monkey version and platform information
 var XUserAgent = xUserAgent{}
+
+type xResetterEnv struct{}
+
+// XResetterEnv key's associated value is a func() map[string]string
+// returning what resetters exported so far
+var XResetterEnv = xResetterEnv{}
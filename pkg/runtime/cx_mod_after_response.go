@@ -17,6 +17,7 @@ type cxModAfterResponse struct {
 	accessedState bool
 	request       *cxRequestAfterResponse
 	response      *cxResponseAfterResponse
+	resetterEnv   *starlark.Dict
 	state         *starlark.Dict
 	//TODO: specs             starlark.Value => provide models as JSON for now until we find a suitable Python-ish API
 	//TODO: CLI filter `--only="starlark.expr(ctx.specs)"`
@@ -28,7 +29,7 @@ type (
 	ctxctor1 func(*starlark.Dict) *cxModAfterResponse
 )
 
-func ctxCurry(callInput *fm.Clt_CallRequestRaw_Input) ctxctor2 {
+func ctxCurry(callInput *fm.Clt_CallRequestRaw_Input, resetterEnv *starlark.Dict) ctxctor2 {
 	request := newCxRequestAfterResponse(callInput)
 	request.Freeze()
 	return func(callOutput *fm.Clt_CallResponseRaw_Output) ctxctor1 {
@@ -37,9 +38,10 @@ func ctxCurry(callInput *fm.Clt_CallRequestRaw_Input) ctxctor2 {
 		return func(state *starlark.Dict) *cxModAfterResponse {
 			// state is mutated through checks
 			return &cxModAfterResponse{
-				request:  request,
-				response: response,
-				state:    state,
+				request:     request,
+				response:    response,
+				resetterEnv: resetterEnv,
+				state:       state,
 			}
 		}
 	}
@@ -51,7 +53,9 @@ func (m *cxModAfterResponse) Hash() (uint32, error) { return 0, fmt.Errorf("unha
 func (m *cxModAfterResponse) String() string        { return "ctx_after_response" }
 func (m *cxModAfterResponse) Truth() starlark.Bool  { return true }
 func (m *cxModAfterResponse) Type() string          { return "ctx" }
-func (m *cxModAfterResponse) AttrNames() []string   { return []string{"request", "response", "state"} }
+func (m *cxModAfterResponse) AttrNames() []string {
+	return []string{"request", "resetter_env", "response", "state"}
+}
 
 func (m *cxModAfterResponse) Freeze() {
 	m.request.Freeze()
@@ -71,6 +75,8 @@ func (m *cxModAfterResponse) Attr(name string) (starlark.Value, error) {
 			return nil, errors.New("cannot access ctx.response after accessing ctx.state")
 		}
 		return m.response, nil
+	case "resetter_env":
+		return m.resetterEnv, nil
 	case "state":
 		m.accessedState = true
 		return m.state, nil
@@ -8,15 +8,17 @@ import (
 
 // cxModBeforeRequest is the `ctx` starlark value accessible before executing a call
 type cxModBeforeRequest struct {
-	request *cxRequestBeforeRequest
+	request     *cxRequestBeforeRequest
+	resetterEnv *starlark.Dict
 	// No response: this lives only before the request is attempted
 	// No state: disallowed for now
 	//TODO: specs
 }
 
-func newCxModBeforeRequest(req *cxRequestBeforeRequest) *cxModBeforeRequest {
+func newCxModBeforeRequest(req *cxRequestBeforeRequest, resetterEnv *starlark.Dict) *cxModBeforeRequest {
 	return &cxModBeforeRequest{
-		request: req,
+		request:     req,
+		resetterEnv: resetterEnv,
 	}
 }
 
@@ -30,13 +32,15 @@ func (m *cxModBeforeRequest) Hash() (uint32, error) { return 0, fmt.Errorf("unha
 func (m *cxModBeforeRequest) String() string        { return "ctx_before_request" }
 func (m *cxModBeforeRequest) Truth() starlark.Bool  { return true }
 func (m *cxModBeforeRequest) Type() string          { return "ctx" }
-func (m *cxModBeforeRequest) AttrNames() []string   { return []string{"request"} }
+func (m *cxModBeforeRequest) AttrNames() []string   { return []string{"request", "resetter_env"} }
 func (m *cxModBeforeRequest) Freeze()               { m.request.Freeze() }
 
 func (m *cxModBeforeRequest) Attr(name string) (starlark.Value, error) {
 	switch name {
 	case "request":
 		return m.request, nil
+	case "resetter_env":
+		return m.resetterEnv, nil
 	default:
 		return nil, nil // no such method
 	}
@@ -32,17 +32,31 @@ func newCxResponseAfterResponse(o *fm.Clt_CallResponseRaw_Output) (cr *cxRespons
 	case *fm.Clt_CallResponseRaw_Output_HttpResponse_:
 		cr = &cxResponseAfterResponse{
 			ty:    cxResponseHttp,
-			attrs: make(starlark.StringDict, 6),
+			attrs: make(starlark.StringDict, 15),
 		}
 
 		repProto := o.GetHttpResponse()
 		cr.attrs["status_code"] = starlark.MakeUint(uint(repProto.StatusCode))
 		cr.attrs["reason"] = starlark.String(repProto.Reason)
 		cr.attrs["content"] = starlark.String(repProto.Body)
+		cr.attrs["content_bytes"] = starlark.Bytes(repProto.Body)
+		cr.attrs["size"] = starlark.MakeInt64(repProto.BodySize)
+		cr.attrs["sha256"] = starlark.String(repProto.BodySha256)
+		cr.attrs["content_truncated"] = starlark.Bool(repProto.BodyTruncated)
 		cr.attrs["elapsed_ns"] = starlark.MakeInt64(repProto.ElapsedNs)
 		cr.attrs["elapsed_ms"] = starlark.MakeInt64(repProto.ElapsedNs / 1.e6)
+		cr.attrs["retries"] = starlark.MakeUint(uint(repProto.Retries))
+		timings := newCxResponseTimings(repProto.Timings)
+		timings.Freeze()
+		cr.attrs["timings"] = timings
+		history := newCxResponseHistory(repProto.History)
+		history.Freeze()
+		cr.attrs["history"] = history
+		events := newCxResponseEvents(repProto.Events)
+		events.Freeze()
+		cr.attrs["events"] = events
+		cr.attrs["events_truncated"] = starlark.Bool(repProto.EventsTruncated)
 		// "error": repProto.Error Checks make this unreachable
-		// "history" :: []Rep (redirects)?
 		headers := newcxHead(repProto.Headers)
 		headers.Freeze()
 		cr.attrs["headers"] = headers
@@ -0,0 +1,58 @@
+package runtime
+
+import (
+	"fmt"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/starlarkvalue"
+)
+
+const (
+	cxResponseEventHttp = "http_event"
+)
+
+// cxResponseEvent is an item of the `ctx.response.events` starlark list
+type cxResponseEvent struct {
+	ty string
+
+	attrs starlark.StringDict
+}
+
+func newCxResponseEvents(evs []*fm.Clt_CallResponseRaw_Output_HttpResponse_Event) *starlark.List {
+	events := make([]starlark.Value, 0, len(evs))
+	for _, ev := range evs {
+		attrs := starlark.StringDict{
+			"id":         starlark.String(ev.GetId()),
+			"event":      starlark.String(ev.GetEvent()),
+			"data":       starlark.String(ev.GetData()),
+			"elapsed_ns": starlark.MakeInt64(ev.GetElapsedNs()),
+			"elapsed_ms": starlark.MakeInt64(ev.GetElapsedNs() / 1.e6),
+		}
+		if decoded := ev.GetDataDecoded(); decoded != nil {
+			body := starlarkvalue.FromProtoValue(decoded)
+			body.Freeze()
+			attrs["body"] = body
+		}
+		events = append(events, &cxResponseEvent{
+			ty:    cxResponseEventHttp,
+			attrs: attrs,
+		})
+	}
+	return starlark.NewList(events)
+}
+
+var _ starlark.HasAttrs = (*cxResponseEvent)(nil)
+
+func (m *cxResponseEvent) Hash() (uint32, error) {
+	return 0, fmt.Errorf("unhashable: %s", m.Type())
+}
+func (m *cxResponseEvent) String() string       { return "response_event" }
+func (m *cxResponseEvent) Truth() starlark.Bool { return true }
+func (m *cxResponseEvent) Type() string         { return m.ty }
+func (m *cxResponseEvent) Freeze()              { m.attrs.Freeze() }
+func (m *cxResponseEvent) AttrNames() []string  { return m.attrs.Keys() }
+func (m *cxResponseEvent) Attr(name string) (starlark.Value, error) {
+	return m.attrs[name], nil
+}
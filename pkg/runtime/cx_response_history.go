@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"fmt"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const (
+	cxResponseRedirectHttp = "http_redirect"
+)
+
+// cxResponseRedirect is an item of the `ctx.response.history` starlark list
+type cxResponseRedirect struct {
+	ty string
+
+	attrs starlark.StringDict
+}
+
+func newCxResponseHistory(hops []*fm.Clt_CallResponseRaw_Output_HttpResponse_Redirect) *starlark.List {
+	history := make([]starlark.Value, 0, len(hops))
+	for _, hop := range hops {
+		headers := newcxHead(hop.GetHeaders())
+		headers.Freeze()
+		history = append(history, &cxResponseRedirect{
+			ty: cxResponseRedirectHttp,
+			attrs: starlark.StringDict{
+				"method":      starlark.String(hop.GetMethod()),
+				"url":         starlark.String(hop.GetUrl()),
+				"status_code": starlark.MakeUint(uint(hop.GetStatusCode())),
+				"reason":      starlark.String(hop.GetReason()),
+				"headers":     headers,
+				"elapsed_ns":  starlark.MakeInt64(hop.GetElapsedNs()),
+				"elapsed_ms":  starlark.MakeInt64(hop.GetElapsedNs() / 1.e6),
+			},
+		})
+	}
+	return starlark.NewList(history)
+}
+
+var _ starlark.HasAttrs = (*cxResponseRedirect)(nil)
+
+func (m *cxResponseRedirect) Hash() (uint32, error) {
+	return 0, fmt.Errorf("unhashable: %s", m.Type())
+}
+func (m *cxResponseRedirect) String() string       { return "response_redirect" }
+func (m *cxResponseRedirect) Truth() starlark.Bool { return true }
+func (m *cxResponseRedirect) Type() string         { return m.ty }
+func (m *cxResponseRedirect) Freeze()              { m.attrs.Freeze() }
+func (m *cxResponseRedirect) AttrNames() []string  { return m.attrs.Keys() }
+func (m *cxResponseRedirect) Attr(name string) (starlark.Value, error) {
+	return m.attrs[name], nil
+}
@@ -0,0 +1,55 @@
+package runtime
+
+import (
+	"fmt"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+const (
+	cxResponseTimingsHttp = "http_timings"
+)
+
+// cxResponseTimings is the `ctx.response.timings` starlark value accessible after executing a call
+type cxResponseTimings struct {
+	ty string
+
+	attrs starlark.StringDict
+}
+
+func newCxResponseTimings(t *fm.Clt_CallResponseRaw_Output_HttpResponse_Timings) *cxResponseTimings {
+	ct := &cxResponseTimings{
+		ty:    cxResponseTimingsHttp,
+		attrs: make(starlark.StringDict, 13),
+	}
+	for name, ns := range map[string]int64{
+		"dns":      t.GetDnsNs(),
+		"connect":  t.GetConnectNs(),
+		"tls":      t.GetTlsNs(),
+		"ttfb":     t.GetTtfbNs(),
+		"transfer": t.GetTransferNs(),
+	} {
+		ct.attrs[name+"_ns"] = starlark.MakeInt64(ns)
+		ct.attrs[name+"_ms"] = starlark.MakeInt64(ns / 1.e6)
+	}
+	ct.attrs["reused_conn"] = starlark.Bool(t.GetReusedConn())
+	ct.attrs["local_addr"] = starlark.String(t.GetLocalAddr())
+	ct.attrs["remote_addr"] = starlark.String(t.GetRemoteAddr())
+	return ct
+}
+
+var _ starlark.HasAttrs = (*cxResponseTimings)(nil)
+
+func (m *cxResponseTimings) Hash() (uint32, error) {
+	return 0, fmt.Errorf("unhashable: %s", m.Type())
+}
+func (m *cxResponseTimings) String() string       { return "response_timings" }
+func (m *cxResponseTimings) Truth() starlark.Bool { return true }
+func (m *cxResponseTimings) Type() string         { return m.ty }
+func (m *cxResponseTimings) Freeze()              { m.attrs.Freeze() }
+func (m *cxResponseTimings) AttrNames() []string  { return m.attrs.Keys() }
+func (m *cxResponseTimings) Attr(name string) (starlark.Value, error) {
+	return m.attrs[name], nil
+}
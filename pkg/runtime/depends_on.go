@@ -0,0 +1,109 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"golang.org/x/sync/errgroup"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+// resettersDepsCycle errors when resetters transitively depend on themselves
+func (rt *Runtime) resettersDepsCycle() error {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	state := make(map[string]int, len(rt.resetters))
+	var path []string
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visited:
+			return nil
+		case visiting:
+			for i, n := range path {
+				if n == name {
+					cycle := append(append([]string(nil), path[i:]...), name)
+					return fmt.Errorf("resetters depend on each other: %s", strings.Join(cycle, " -> "))
+				}
+			}
+		}
+		state[name] = visiting
+		path = append(path, name)
+		for _, dep := range rt.resettersDeps[name] {
+			if err := visit(dep); err != nil {
+				return err
+			}
+		}
+		path = path[:len(path)-1]
+		state[name] = visited
+		return nil
+	}
+	return rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
+		return visit(name)
+	})
+}
+
+// forEachResetterByDependency runs f concurrently on the named resetters.
+// Each resetter waits for the ones it depends on, or when reversed
+// for the ones depending on it. A failure skips the resetters that
+// were waiting on it, unless reversed so that all of them get stopped.
+func (rt *Runtime) forEachResetterByDependency(
+	ctx context.Context,
+	names map[string]struct{},
+	reversed bool,
+	f func(string, resetter.Interface) error,
+) error {
+	after := make(map[string][]string, len(names))
+	for name := range names {
+		for _, dep := range rt.resettersDeps[name] {
+			if _, ok := names[dep]; !ok {
+				continue
+			}
+			if reversed {
+				after[dep] = append(after[dep], name)
+			} else {
+				after[name] = append(after[name], dep)
+			}
+		}
+	}
+
+	type outcome struct {
+		done   chan struct{}
+		failed bool
+	}
+	outcomes := make(map[string]*outcome, len(names))
+	for name := range names {
+		outcomes[name] = &outcome{done: make(chan struct{})}
+	}
+
+	g, _ := errgroup.WithContext(ctx)
+	_ = rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
+		o, ok := outcomes[name]
+		if !ok {
+			return nil
+		}
+		g.Go(func() (err error) {
+			defer close(o.done)
+			for _, prev := range after[name] {
+				<-outcomes[prev].done
+				if outcomes[prev].failed && !reversed {
+					log.Printf("[NFO] skipping resetter %s as %s failed", name, prev)
+					o.failed = true
+					return
+				}
+			}
+			if err = f(name, rsttr); err != nil {
+				o.failed = true
+			}
+			return
+		})
+		return nil
+	})
+	return g.Wait()
+}
@@ -0,0 +1,18 @@
+package runtime
+
+import (
+	"context"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+)
+
+// DiffAgainst lists changes made to the API since the given older spec file
+func (rt *Runtime) DiffAgainst(ctx context.Context, oldFile string) (changes []modeler.SpecChange, err error) {
+	// TODO: support >1 models (pick model by name)
+	err = rt.forEachModel(func(name string, mdl modeler.Interface) error {
+		mdlChanges, err := mdl.DiffAgainst(ctx, oldFile)
+		changes = append(changes, mdlChanges...)
+		return err
+	})
+	return
+}
@@ -8,21 +8,35 @@ import (
 
 // JustExecStart only executes SUT 'start'
 func (rt *Runtime) JustExecStart(ctx context.Context) error {
-	return rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
-		return rsttr.ExecStart(ctx, &osShower{}, true, rt.envRead)
+	return rt.forEachResetterByDependency(ctx, rt.allResetters(), false, func(name string, rsttr resetter.Interface) error {
+		if err := rsttr.ExecStart(ctx, &osShower{}, true, rt.envFor(name)); err != nil {
+			return err
+		}
+		return waitReady(ctx, name, rsttr)
 	})
 }
 
 // JustExecReset only executes SUT 'reset' which may be 'stop' followed by 'start'
 func (rt *Runtime) JustExecReset(ctx context.Context) error {
-	return rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
-		return rsttr.ExecReset(ctx, &osShower{}, true, rt.envRead)
+	return rt.forEachResetterByDependency(ctx, rt.allResetters(), false, func(name string, rsttr resetter.Interface) error {
+		if err := rsttr.ExecReset(ctx, &osShower{}, true, rt.envFor(name)); err != nil {
+			return err
+		}
+		return waitReady(ctx, name, rsttr)
 	})
 }
 
 // JustExecStop only executes SUT 'stop'
 func (rt *Runtime) JustExecStop(ctx context.Context) error {
-	return rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
-		return rsttr.ExecStop(ctx, &osShower{}, true, rt.envRead)
+	return rt.forEachResetterByDependency(ctx, rt.allResetters(), true, func(name string, rsttr resetter.Interface) error {
+		return rsttr.ExecStop(ctx, &osShower{}, true, rt.envFor(name))
 	})
 }
+
+func (rt *Runtime) allResetters() map[string]struct{} {
+	names := make(map[string]struct{}, len(rt.resetters))
+	for name := range rt.resetters {
+		names[name] = struct{}{}
+	}
+	return names
+}
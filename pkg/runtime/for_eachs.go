@@ -6,7 +6,6 @@ import (
 	"sort"
 
 	"go.starlark.net/starlark"
-	"golang.org/x/sync/errgroup"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
@@ -69,13 +68,40 @@ func (rt *Runtime) forEachResetter(f func(name string, rsttr resetter.Interface)
 	return nil
 }
 
+// forEachSelectedResetter runs f on selected resetters, in dependency order
 func (rt *Runtime) forEachSelectedResetter(ctx context.Context, f func(string, resetter.Interface) error) error {
+	if err := rt.selectResetters(); err != nil {
+		return err
+	}
+	return rt.forEachResetterByDependency(ctx, rt.selectedResetters, false, f)
+}
+
+// forEachSelectedResetterReversed runs f on selected resetters, in reverse dependency order
+func (rt *Runtime) forEachSelectedResetterReversed(ctx context.Context, f func(string, resetter.Interface) error) error {
+	if err := rt.selectResetters(); err != nil {
+		return err
+	}
+	return rt.forEachResetterByDependency(ctx, rt.selectedResetters, true, f)
+}
+
+// selectResetters picks resetters providing selected models, along with their dependencies
+func (rt *Runtime) selectResetters() error {
 	if rt.selectedResetters == nil {
 		rt.selectedResetters = make(map[string]struct{}, len(rt.resetters))
+		var selectWithDeps func(name string)
+		selectWithDeps = func(name string) {
+			if _, ok := rt.selectedResetters[name]; ok {
+				return
+			}
+			rt.selectedResetters[name] = struct{}{}
+			for _, dep := range rt.resettersDeps[name] {
+				selectWithDeps(dep)
+			}
+		}
 		_ = rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
 			for _, modelName := range rsttr.Provides() {
 				if _, ok := rt.selectedEIDs[modelName]; ok {
-					rt.selectedResetters[name] = struct{}{}
+					selectWithDeps(name)
 					break
 				}
 			}
@@ -85,17 +111,7 @@ func (rt *Runtime) forEachSelectedResetter(ctx context.Context, f func(string, r
 	if len(rt.selectedResetters) == 0 {
 		return errors.New("no resetter selected")
 	}
-
-	g, _ := errgroup.WithContext(ctx)
-	_ = rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
-		if _, ok := rt.selectedResetters[name]; ok {
-			g.Go(func() error {
-				return f(name, rsttr)
-			})
-		}
-		return nil
-	})
-	return g.Wait()
+	return nil
 }
 
 func (rt *Runtime) forEachGlobal(f func(name string, value starlark.Value) error) error {
@@ -35,6 +35,9 @@ func (rt *Runtime) Fuzz(
 		rt.fuzzingStartedAt = start
 	}
 
+	// Pass resetters' exports down to caller
+	ctx = context.WithValue(ctx, ctxvalues.XResetterEnv, rt.exportedEnv)
+
 	if apiKey != "" {
 		// Pass user agent down to caller
 		ctx = context.WithValue(ctx, ctxvalues.XUserAgent, rt.binTitle)
@@ -48,9 +51,15 @@ func (rt *Runtime) Fuzz(
 	}
 	defer rt.client.Close()
 
+	_ = rt.selectResetters()
 	protoResetters := make([]*fm.Clt_Fuzz_Resetter, 0, len(rt.selectedResetters))
-	_ = rt.forEachSelectedResetter(ctx, func(name string, rsttr resetter.Interface) error {
-		protoResetters = append(protoResetters, rsttr.ToProto())
+	_ = rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
+		if _, ok := rt.selectedResetters[name]; ok {
+			pb := rsttr.ToProto()
+			pb.DependsOn = rt.resettersDeps[name]
+			pb.Retry = rt.resettersRetries[name]
+			protoResetters = append(protoResetters, pb)
+		}
 		return nil
 	})
 
@@ -177,6 +186,7 @@ func (rt *Runtime) Fuzz(
 		as.ColorNFO.Printf("A test produced a bug in %d calls:\n", len(counterexample))
 		as.ColorOK.Println("#!/bin/sh -eux")
 		as.ColorOK.Println("monkey exec start")
+		rt.printLastResets()
 		for _, ceItem := range counterexample {
 			as.ColorOK.Println(ceItem.CLIString())
 		}
@@ -195,6 +205,10 @@ func (rt *Runtime) Fuzz(
 		return rt.Fuzz(ctx, ntensity, newSeed, vvv, tagsFilter, ptype, "")
 	}
 
+	rt.forEachTranscript(func(name string, ts *transcript) {
+		as.ColorNFO.Printf("Output of resetter %s: %s\n", name, ts.path)
+	})
+
 	if l.GetSuccess() {
 		as.ColorNFO.Println("No bugs found yet.")
 		return &TestingCampaignSuccess{}
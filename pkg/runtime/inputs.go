@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"io"
+	"math/rand"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 )
@@ -27,16 +28,48 @@ func (rt *Runtime) WriteAbsoluteReferences(w io.Writer) {
 }
 
 // ValidateAgainstSchema tries to smash the data through the given keyhole
-func (rt *Runtime) ValidateAgainstSchema(absRef string, data []byte) (err error) {
+func (rt *Runtime) ValidateAgainstSchema(absRef string, data []byte) (errs []*modeler.ValidationError, err error) {
 	count := 0
 
 	err = rt.forEachModel(func(name string, mdl modeler.Interface) error {
-		err := mdl.ValidateAgainstSchema(absRef, data)
+		mdlErrs, err := mdl.ValidateAgainstSchema(absRef, data)
 		// TODO: support >1 models (MAY validate against schema of wrong mdl)
 		if _, ok := err.(*modeler.NoSuchRefError); ok {
 			count++
-			err = nil
+			return nil
 		}
+		errs = append(errs, mdlErrs...)
+		return err
+	})
+
+	if count == len(rt.modelsNames) {
+		err = modeler.NewNoSuchRefError(absRef)
+	}
+	return
+}
+
+// ExpandRef completes a schema name (e.g. Pet) into an absolute $ref
+func (rt *Runtime) ExpandRef(name string) (absRef string) {
+	// TODO: support >1 models
+	_ = rt.forEachModel(func(_ string, mdl modeler.Interface) error {
+		absRef = mdl.ExpandRef(name)
+		return nil
+	})
+	return
+}
+
+// GenerateFromSchema produces a random value that validates against absRef
+func (rt *Runtime) GenerateFromSchema(absRef string, rnd *rand.Rand) (value interface{}, err error) {
+	count := 0
+
+	err = rt.forEachModel(func(name string, mdl modeler.Interface) error {
+		v, err := mdl.GenerateFromSchema(absRef, rnd)
+		// TODO: support >1 models (MAY generate from schema of wrong mdl)
+		if _, ok := err.(*modeler.NoSuchRefError); ok {
+			count++
+			return nil
+		}
+		value = v
 		return err
 	})
 
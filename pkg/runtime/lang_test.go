@@ -2,6 +2,7 @@ package runtime
 
 import (
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"log"
 	"strconv"
@@ -120,7 +121,7 @@ func ctxer1Maker(t *testing.T, chkname string) ctxctor1 {
 					BodyDecoded: &reqdecoded,
 				},
 			},
-		})
+		}, newResetterEnvDict(nil))
 
 		return ctxer2(&fm.Clt_CallResponseRaw_Output{
 			Output: &fm.Clt_CallResponseRaw_Output_HttpResponse_{
@@ -135,6 +136,49 @@ func ctxer1Maker(t *testing.T, chkname string) ctxctor1 {
 			},
 		})
 
+	case strings.HasPrefix(chkname, "ctx_response_events"):
+		ctxer2 := ctxCurry(&fm.Clt_CallRequestRaw_Input{
+			Input: &fm.Clt_CallRequestRaw_Input_HttpRequest_{
+				HttpRequest: &fm.Clt_CallRequestRaw_Input_HttpRequest{
+					Method: "GET",
+					Url:    "https://jsonplaceholder.typicode.com/albums/feed",
+					Headers: []*fm.HeaderPair{
+						{Key: "Accept", Values: []string{"text/event-stream"}},
+					},
+				},
+			},
+		}, newResetterEnvDict(nil))
+
+		repbody := []byte("id: 1\nevent: album\ndata: {\"id\": 1}\n\nid: 2\ndata: ping\n\n")
+		var evdecoded structpb.Value
+		err := protojson.Unmarshal([]byte(`{"id": 1}`), &evdecoded)
+		require.NoError(t, err)
+		return ctxer2(&fm.Clt_CallResponseRaw_Output{
+			Output: &fm.Clt_CallResponseRaw_Output_HttpResponse_{
+				HttpResponse: &fm.Clt_CallResponseRaw_Output_HttpResponse{
+					StatusCode: 200,
+					Reason:     "200 OK",
+					Headers: []*fm.HeaderPair{
+						{Key: "Content-Type", Values: []string{"text/event-stream"}},
+					},
+					Body:      repbody,
+					ElapsedNs: 1000 * 1000 * 1000,
+					Events: []*fm.Clt_CallResponseRaw_Output_HttpResponse_Event{{
+						Id:          "1",
+						Event:       "album",
+						Data:        `{"id": 1}`,
+						DataDecoded: &evdecoded,
+						ElapsedNs:   20 * 1000 * 1000,
+					}, {
+						Id:        "2",
+						Data:      "ping",
+						ElapsedNs: 520 * 1000 * 1000,
+					}},
+					EventsTruncated: true,
+				},
+			},
+		})
+
 	default:
 		ctxer2 := ctxCurry(&fm.Clt_CallRequestRaw_Input{
 			Input: &fm.Clt_CallRequestRaw_Input_HttpRequest_{
@@ -146,7 +190,7 @@ func ctxer1Maker(t *testing.T, chkname string) ctxctor1 {
 					},
 				},
 			},
-		})
+		}, newResetterEnvDict(nil))
 
 		repbody := []byte(`{"error": {"msg":"not found", "id":0, "category":"albums"}}`)
 		var repdecoded structpb.Value
@@ -172,7 +216,28 @@ func ctxer1Maker(t *testing.T, chkname string) ctxctor1 {
 					},
 					Body:        repbody,
 					BodyDecoded: &repdecoded,
+					BodySize:    int64(len(repbody)),
+					BodySha256:  fmt.Sprintf("%x", sha256.Sum256(repbody)),
 					ElapsedNs:   37 * 1000 * 1000,
+					Timings: &fm.Clt_CallResponseRaw_Output_HttpResponse_Timings{
+						DnsNs:      2 * 1000 * 1000,
+						ConnectNs:  5 * 1000 * 1000,
+						TlsNs:      11 * 1000 * 1000,
+						TtfbNs:     31 * 1000 * 1000,
+						TransferNs: 6 * 1000 * 1000,
+						LocalAddr:  "192.168.1.2:50424",
+						RemoteAddr: "104.21.4.48:443",
+					},
+					History: []*fm.Clt_CallResponseRaw_Output_HttpResponse_Redirect{{
+						Method:     "GET",
+						Url:        "http://jsonplaceholder.typicode.com/albums/0",
+						StatusCode: 301,
+						Reason:     "301 Moved Permanently",
+						Headers: []*fm.HeaderPair{
+							{Key: "Location", Values: []string{"https://jsonplaceholder.typicode.com/albums/0"}},
+						},
+						ElapsedNs: 12 * 1000 * 1000,
+					}},
 				},
 			},
 		})
@@ -2,13 +2,24 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
 )
 
-// Lint goes through specs and unsures they are valid
-func (rt *Runtime) Lint(ctx context.Context, showSpec bool) error {
-	return rt.forEachModel(func(name string, mdl modeler.Interface) error {
+// Lint goes through specs and resetters and unsures they are valid
+func (rt *Runtime) Lint(ctx context.Context, showSpec bool) (err error) {
+	if err = rt.forEachModel(func(name string, mdl modeler.Interface) error {
 		return mdl.Lint(ctx, showSpec)
+	}); err != nil {
+		return
+	}
+	err = rt.forEachResetter(func(name string, rsttr resetter.Interface) error {
+		if err := rsttr.Lint(ctx); err != nil {
+			return fmt.Errorf("resetter %s: %v", name, err)
+		}
+		return nil
 	})
+	return
 }
@@ -7,30 +7,37 @@ import (
 
 	"go.starlark.net/starlark"
 
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/modeler"
 	openapi3 "github.com/FuzzyMonkeyCo/monkey/pkg/modeler/openapiv3"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/httpresetter"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/process"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/ready"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/shell"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/snapshot"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter/starlarkresetter"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/tags"
 )
 
 const (
-	moduleBuiltins  = 2
-	moduleModelers  = 1
-	moduleResetters = 1
+	moduleBuiltins   = 2
+	moduleModelers   = 1
+	moduleResetters  = 5
+	moduleNamespaces = 1
 
-	moduleAttrs = moduleBuiltins + moduleModelers + moduleResetters
+	moduleAttrs = moduleBuiltins + moduleModelers + moduleResetters + moduleNamespaces
 )
 
 type module struct {
-	attrs map[string]*starlark.Builtin
+	attrs map[string]starlark.Value
 }
 
 var _ starlark.HasAttrs = (*module)(nil)
 
 func (rt *Runtime) newModule() (m *module) {
 	m = &module{
-		attrs: make(map[string]*starlark.Builtin, moduleAttrs),
+		attrs: make(map[string]starlark.Value, moduleAttrs),
 	}
 
 	modelMaker := func(modelerName string, maker modeler.Maker) *starlark.Builtin {
@@ -45,10 +52,16 @@ func (rt *Runtime) newModule() (m *module) {
 		b := starlark.NewBuiltin(resetterName, f)
 		return b.BindReceiver(m)
 	}
+	m.attrs["http_resetter"] = resetterMaker(httpresetter.Name, httpresetter.New)
+	m.attrs["process"] = resetterMaker(process.Name, process.New)
 	m.attrs["shell"] = resetterMaker(shell.Name, shell.New)
+	m.attrs["snapshot"] = resetterMaker(snapshot.Name, snapshot.New)
+	m.attrs["starlark_resetter"] = resetterMaker(starlarkresetter.Name, starlarkresetter.New)
 
 	m.attrs["check"] = starlark.NewBuiltin("check", rt.bCheck).BindReceiver(m)
 	m.attrs["env"] = starlark.NewBuiltin("env", rt.bEnv).BindReceiver(m)
+
+	m.attrs["ready"] = ready.NewModule()
 	return
 }
 
@@ -56,8 +69,13 @@ func (m *module) AttrNames() []string {
 	return []string{
 		"check",
 		"env",
+		"http_resetter",
 		"openapi3",
+		"process",
+		"ready",
 		"shell",
+		"snapshot",
+		"starlark_resetter",
 	}
 }
 
@@ -128,8 +146,48 @@ func (rt *Runtime) resetterMakerBuiltin(resetterName string, maker resetter.Make
 			return
 		}
 
+		// These relate resetters to one another and to tests so they are handled here
+		var (
+			dependsOn tags.UniqueStringsNonEmpty
+			retries   starlark.Int
+			backoffMs starlark.Value
+			onFailure starlark.String
+		)
+		ours := make([]starlark.Tuple, 0, len(kwargs))
+		rest := make([]starlark.Tuple, 0, len(kwargs))
+		for _, kv := range kwargs {
+			switch kv[0] {
+			case starlark.String("depends_on"), starlark.String("retries"),
+				starlark.String("backoff_ms"), starlark.String("on_failure"):
+				ours = append(ours, kv)
+			default:
+				rest = append(rest, kv)
+			}
+		}
+		if err = starlark.UnpackArgs(resetterName, nil, ours,
+			"depends_on?", &dependsOn,
+			"retries?", &retries,
+			"backoff_ms?", &backoffMs,
+			"on_failure?", &onFailure,
+		); err != nil {
+			log.Println("[ERR]", b.Name(), err)
+			return
+		}
+		var retry *fm.Clt_Fuzz_Resetter_Retry
+		if retry, err = newResetRetry(retries, backoffMs, onFailure); err != nil {
+			err = fmt.Errorf("%s: %v", resetterName, err)
+			log.Println("[ERR]", b.Name(), err)
+			return
+		}
+
 		var rsttr resetter.Interface
-		if rsttr, err = maker(kwargs); err != nil {
+		if rsttr, err = maker(rest); err != nil {
+			log.Println("[ERR]", b.Name(), err)
+			return
+		}
+
+		if bl, ok := rsttr.(resetter.Baseliner); ok && retry.GetRestart() && bl.StartTakesBaseline() {
+			err = fmt.Errorf("%s: on_failure = %q would replace the state resets go back to", resetterName, onFailureRestart)
 			log.Println("[ERR]", b.Name(), err)
 			return
 		}
@@ -147,6 +205,12 @@ func (rt *Runtime) resetterMakerBuiltin(resetterName string, maker resetter.Make
 			return
 		}
 		rt.resetters[rsttrName] = rsttr
+		if deps := dependsOn.GoStrings(); len(deps) != 0 {
+			rt.resettersDeps[rsttrName] = deps
+		}
+		if retry != nil {
+			rt.resettersRetries[rsttrName] = retry
+		}
 		log.Printf("[NFO] registered %s: %q", b.Name(), rsttrName)
 		rt.resettersNames = append(rt.resettersNames, rsttrName)
 		return
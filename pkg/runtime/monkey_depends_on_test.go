@@ -0,0 +1,102 @@
+package runtime
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestResetterDependsOnTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`"db"`:         `for parameter "depends_on": got string, want list`,
+		`[]`:           `for parameter "depends_on": must not be empty`,
+		`["db", "db"]`: `for parameter "depends_on": "db" appears more than once`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "api",
+    provides = ["some_model"],
+    depends_on = `[1:]+code+`,
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:13: in <toplevel>
+Error in shell: shell: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestResetterDependsOnDefinedResetters(t *testing.T) {
+	for expected, deps := range map[string]map[string]string{
+		`resetter "api" depends on undefined "queue"`:               {"api": `["queue"]`},
+		`resetter "api" depends on itself`:                          {"api": `["api"]`},
+		`resetters depend on each other: api -> db -> api`:          {"api": `["db"]`, "db": `["api"]`},
+		`resetters depend on each other: api -> db -> cache -> api`: {"api": `["db"]`, "cache": `["api"]`, "db": `["cache"]`},
+	} {
+		t.Run(expected, func(t *testing.T) {
+			var config strings.Builder
+			for i, name := range []string{"api", "cache", "db"} {
+				if i != 0 {
+					config.WriteString("\n")
+				}
+				fmt.Fprintf(&config, "monkey.shell(\n    name = %q,\n    provides = [\"some_model\"],\n", name)
+				if dep, ok := deps[name]; ok {
+					fmt.Fprintf(&config, "    depends_on = %s,\n", dep)
+				}
+				config.WriteString(")\n")
+			}
+			rt, err := newFakeMonkey(t, config.String()+someOpenAPI3Model)
+			require.EqualError(t, err, expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestResetterDependsOnOrdersPhases(t *testing.T) {
+	log := filepath.Join(t.TempDir(), "phases.log")
+
+	var config strings.Builder
+	for i, rsttr := range []struct{ name, deps string }{
+		{"api", `["cache", "db"]`},
+		{"cache", ""},
+		{"db", ""},
+	} {
+		if i != 0 {
+			config.WriteString("\n")
+		}
+		fmt.Fprintf(&config, `
+monkey.shell(
+    name = %q,
+    provides = ["some_model"],
+`[1:], rsttr.name)
+		if rsttr.deps != "" {
+			fmt.Fprintf(&config, "    depends_on = %s,\n", rsttr.deps)
+		}
+		fmt.Fprintf(&config, `
+    start = "sleep .2; echo start %[1]s >>%[2]s",
+    stop = "sleep .2; echo stop %[1]s >>%[2]s",
+)
+`[1:], rsttr.name, log)
+	}
+	rt, err := newFakeMonkey(t, config.String()+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Equal(t, map[string][]string{"api": {"cache", "db"}}, rt.resettersDeps)
+
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+
+	data, err := os.ReadFile(log)
+	require.NoError(t, err)
+	phases := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, phases, 6)
+	require.ElementsMatch(t, []string{"start cache", "start db"}, phases[:2])
+	require.Equal(t, []string{"start api", "stop api"}, phases[2:4])
+	require.ElementsMatch(t, []string{"stop cache", "stop db"}, phases[4:])
+}
@@ -0,0 +1,202 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser/ci"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+// kwargs
+
+func TestHTTPResetterProvidesIsRequired(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.http_resetter(
+    name = "blop",
+    reset = "POST http://localhost:6773/__test/reset",
+)
+`[1:]+someOpenAPI3Model)
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:21: in <toplevel>
+Error in http_resetter: http_resetter: missing argument for provides`[1:])
+	require.Nil(t, rt)
+}
+
+func TestHTTPResetterNeedsAReset(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.http_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    start = "POST http://localhost:6773/__test/start",
+)
+`[1:]+someOpenAPI3Model)
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:21: in <toplevel>
+Error in http_resetter: missing at least `[1:]+"`"+`http_resetter( reset = "POST http://..." )`+"`")
+	require.Nil(t, rt)
+}
+
+func TestHTTPResetterRequestsTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`"http://localhost:6773/__test/reset"`:       `for parameter "reset": want "METHOD URL", got: "http://localhost:6773/__test/reset"`,
+		`"FETCH http://localhost:6773/__test/reset"`: `for parameter "reset": unexpected HTTP method "FETCH"`,
+		`"POST localhost:6773/__test/reset"`:         `for parameter "reset": want an http:// or https:// URL, got: "localhost:6773/__test/reset"`,
+		`42`:                                         `for parameter "reset": want "METHOD URL" string or dict, got int`,
+		`[]`:                                         `for parameter "reset": must not be empty`,
+		`{"method": "POST"}`:                         `for parameter "reset": missing url`,
+		`{"url": "http://a.b", "verb": "POST"}`:      `for parameter "reset": unexpected key "verb", want one of method, url, headers, body or expect`,
+		`{"headers": {"X-Retry": 1}, "url": "http://a.b"}`:        `for parameter "reset": headers: want strings mapped to strings, got: "X-Retry" = 1`,
+		`{"expect": [200, 1337], "url": "http://a.b"}`:            `for parameter "reset": expect: want HTTP status codes, got: 1337`,
+		`{"body": {"a": set([1])}, "url": "http://a.b"}`:          `for parameter "reset": body: incompatible value (set): set([1])`,
+		`["POST http://a.b", {"expect": 1, "url": "http://a.b"}]`: `for parameter "reset": expect: want HTTP status codes, got: 1`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.http_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    reset = `[1:]+code+`,
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:21: in <toplevel>
+Error in http_resetter: http_resetter: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+// execution
+
+type resetServer struct {
+	*httptest.Server
+	mu   sync.Mutex
+	seen []string
+}
+
+func newResetServer() *resetServer {
+	s := &resetServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		s.seen = append(s.seen, fmt.Sprintf("%s %s %s %s", r.Method, r.URL.Path, r.Header.Get("Content-Type"), body))
+		s.mu.Unlock()
+		switch r.URL.Path {
+		case "/__test/reset":
+			w.WriteHeader(http.StatusNoContent)
+		case "/__test/seed":
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			fmt.Fprintln(w, "database is locked")
+			fmt.Fprintln(w, "  at reset_all (db.go:42)")
+		}
+	}))
+	return s
+}
+
+func (rt *Runtime) runFakeReset(t *testing.T) (scriptErr error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err := rt.Lint(ctx, false)
+	require.NoError(t, err)
+	err = rt.FilterEndpoints(nil)
+	require.NoError(t, err)
+
+	rt.progress = &ci.Progresser{}
+	if rt.client == nil {
+		rt.client = &fakeClient{}
+	}
+
+	err = cwid.MakePwdID(rt.binTitle, ".", 0)
+	require.NoError(t, err)
+
+	scriptErr, err = rt.reset(ctx)
+	require.NoError(t, err)
+
+	err = rt.Cleanup(ctx)
+	require.NoError(t, err)
+	return
+}
+
+func TestHTTPResetterResets(t *testing.T) {
+	srv := newResetServer()
+	defer srv.Close()
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.http_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    reset = [
+        "POST %[1]s/__test/reset",
+        {
+            "body": {"users": 2},
+            "expect": [200, 201],
+            "method": "put",
+            "url": "%[1]s/__test/seed",
+        },
+    ],
+)
+`[1:]+someOpenAPI3Model, srv.URL))
+	require.NoError(t, err)
+	require.Len(t, rt.resetters, 1)
+	require.Equal(t, []string{"some_model"}, rt.resetters["blop"].Provides())
+
+	pb := rt.resetters["blop"].ToProto().GetHttp()
+	require.Len(t, pb.GetRst(), 2)
+	require.Equal(t, "PUT", pb.GetRst()[1].GetMethod())
+	require.Len(t, pb.GetRst()[1].GetHeaders(), 1)
+	require.Equal(t, "Content-Type", pb.GetRst()[1].GetHeaders()[0].GetKey())
+	require.Equal(t, []string{"application/json"}, pb.GetRst()[1].GetHeaders()[0].GetValues())
+
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+	require.Equal(t, []string{
+		"POST /__test/reset  ",
+		`PUT /__test/seed application/json {"users":2}`,
+	}, srv.seen)
+}
+
+func TestHTTPResetterFailsWithDetails(t *testing.T) {
+	srv := newResetServer()
+	defer srv.Close()
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.http_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    start = "POST %[1]s/__test/reset",
+    reset = {"expect": 204, "method": "DELETE", "url": "%[1]s/__test/everything"},
+    stop = "POST %[1]s/__test/reset",
+)
+`[1:]+someOpenAPI3Model, srv.URL))
+	require.NoError(t, err)
+
+	scriptErr := rt.runFakeReset(t)
+	require.IsType(t, resetter.NewError(nil), scriptErr)
+	require.Equal(t, []string{
+		"DELETE " + srv.URL + "/__test/everything",
+		"got 500 Internal Server Error, expected 204",
+		"database is locked",
+		"  at reset_all (db.go:42)",
+	}, scriptErr.(*resetter.Error).Reason())
+	require.Equal(t, []string{
+		"POST /__test/reset  ",
+		"DELETE /__test/everything  ",
+		"POST /__test/reset  ",
+	}, srv.seen, "start, reset then stop on cleanup")
+}
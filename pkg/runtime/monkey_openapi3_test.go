@@ -183,3 +183,268 @@ Traceback (most recent call last):
 Error in openapi3: openapi3: for parameter "header_authorization": got float, want string`[1:])
 	require.Nil(t, rt)
 }
+
+// kwargs: transport
+
+func TestOpenapi3TransportOptions(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    ca_bundle = "some/ca.pem",
+    client_cert = "some/cert.pem",
+    client_key = "some/key.pem",
+    insecure_skip_verify = True,
+    proxy = "http://localhost:3128",
+    unix_socket = "/run/sut.sock",
+    http2 = "never",
+    reuse_connections = False,
+    timeout_ms = 500,
+)
+`[1:])
+	require.NoError(t, err)
+	require.NotNil(t, rt)
+}
+
+func TestOpenapi3TransportHTTP2Values(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    http2 = "always",
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: http2 must be one of "auto", "never" or "h2c", got: "always"`[1:])
+	require.Nil(t, rt)
+}
+
+func TestOpenapi3TransportClientCertNeedsKey(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    client_cert = "some/cert.pem",
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: client_cert and client_key must be given together`[1:])
+	require.Nil(t, rt)
+}
+
+func TestOpenapi3TransportH2CIsCleartext(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    http2 = "h2c",
+    insecure_skip_verify = True,
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: h2c is cleartext: TLS options cannot be used with it`[1:])
+	require.Nil(t, rt)
+}
+
+func TestOpenapi3TransportTimeoutTyping(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    timeout_ms = "1s",
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: openapi3: for parameter "timeout_ms": got string, want int`[1:])
+	require.Nil(t, rt)
+}
+
+// kwargs: follow_redirects & cookies
+
+func TestOpenapi3FollowRedirectsIsPositive(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    follow_redirects = -1,
+    cookies = False,
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: follow_redirects must be positive, got: -1`[1:])
+	require.Nil(t, rt)
+}
+
+// kwargs: pacing
+
+func TestOpenapi3Pacing(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    rate_limit = 2.5,
+    max_in_flight = 1,
+    max_retries = 3,
+    max_retry_wait_ms = 2000,
+)
+`[1:])
+	require.NoError(t, err)
+	require.NotNil(t, rt)
+}
+
+func TestOpenapi3RateLimitTyping(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    rate_limit = "10/s",
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: rate_limit must be a positive amount of requests per second, got: "10/s"`[1:])
+	require.Nil(t, rt)
+}
+
+func TestOpenapi3MaxRetriesIsPositive(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    max_retries = -3,
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: max_retries must be positive, got: -3`[1:])
+	require.Nil(t, rt)
+}
+
+// kwargs: server & server_variables
+
+func TestOpenapi3Server(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    server = monkey.env("TESTING_SERVER", "staging"),
+    server_variables = {"port": "8080"},
+)
+`[1:])
+	require.NoError(t, err)
+	require.NotNil(t, rt)
+}
+
+func TestOpenapi3ServerVariablesTyping(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    server_variables = {"port": 8080},
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: server_variables must map strings to strings, got: "port" = 8080`[1:])
+	require.Nil(t, rt)
+}
+
+func TestOpenapi3Endpoints(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    endpoints = ["tag:billing", "op:createPet", "!is:deprecated", "^GET"],
+)
+`[1:])
+	require.NoError(t, err)
+	require.NotNil(t, rt)
+}
+
+func TestOpenapi3EndpointsTyping(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    endpoints = ["tag:billing", 42],
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: endpoints must be a list of strings, got: 42`[1:])
+	require.Nil(t, rt)
+
+	rt, err = newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    endpoints = ["op:"],
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: bad selector in endpoints: missing operationId in "op:"`[1:])
+	require.Nil(t, rt)
+}
+
+// kwargs: max_stream_events & max_stream_duration_ms
+
+func TestOpenapi3StreamLimits(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    max_stream_events = 10,
+    max_stream_duration_ms = 0,
+)
+`[1:])
+	require.NoError(t, err)
+	require.NotNil(t, rt)
+}
+
+func TestOpenapi3StreamLimitsArePositive(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    max_stream_duration_ms = -1,
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: max_stream_duration_ms must be positive, got: -1`[1:])
+	require.Nil(t, rt)
+}
+
+// kwargs: max_body_bytes
+
+func TestOpenapi3MaxBodyBytesIsPositive(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.openapi3(
+    name = "some_name",
+    file = "some/api_spec.yml",
+    max_body_bytes = -1,
+)
+`[1:])
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in openapi3: max_body_bytes must be positive, got: -1`[1:])
+	require.Nil(t, rt)
+}
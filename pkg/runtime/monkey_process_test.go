@@ -0,0 +1,300 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"syscall"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser/ci"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+// probes
+
+func TestReadyProbesTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`monkey.ready.tcp("localhost")`:                       `Error in tcp: tcp: address localhost: missing port in address`,
+		`monkey.ready.tcp(8080)`:                              `Error in tcp: tcp: for parameter addr: got int, want string`,
+		`monkey.ready.http("localhost:8080/health")`:          `Error in http: http: want an http:// or https:// URL, got: "localhost:8080/health"`,
+		`monkey.ready.http("http://localhost/", status = 42)`: `Error in http: http: want an HTTP status code, got: 42`,
+		`monkey.ready.log_line("[")`:                          "Error in log_line: log_line: error parsing regexp: missing closing ]: `[`",
+		`monkey.ready.predicate("up")`:                        `Error in predicate: predicate: for parameter fn: got string, want function`,
+		`monkey.ready.predicate(print)`:                       `Error in predicate: predicate: for parameter fn: got builtin_function_or_method, want function`,
+		`monkey.ready.predicate(lambda x: x)`:                 `Error in predicate: predicate: want a function taking no arguments, got lambda`,
+		`monkey.ready.tcp("localhost:80", timeout_ms = 0)`:    `Error in tcp: tcp: for parameter "timeout_ms": want a positive number of milliseconds, got: 0`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			col := strings.Index(code, "(") + 1
+			rt, err := newFakeMonkey(t, code+"\n"+someOpenAPI3Model)
+			require.EqualError(t, err, fmt.Sprintf(`
+Traceback (most recent call last):
+  fuzzymonkey.star:1:%d: in <toplevel>
+%s`[1:], col, expected))
+			require.Nil(t, rt)
+		})
+	}
+}
+
+// kwargs
+
+func TestProcessCommandTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`42`:           `for parameter "command": got int, want string or list`,
+		`[]`:           `for parameter "command": must not be empty`,
+		`"  "`:         `for parameter "command": must not be empty`,
+		`["sleep", 1]`: `for parameter "command": got int, want string`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.process(
+    name = "blop",
+    provides = ["some_model"],
+    command = `[1:]+code+`,
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:15: in <toplevel>
+Error in process: process: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestProcessKwargsTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`env = {"A": 1}`:       `for parameter "env": want strings mapped to strings, got: "A" = 1`,
+		`env = {"A=B": "C"}`:   `for parameter "env": illegal environment variable name: "A=B"`,
+		`ready = "tcp/8080"`:   `for parameter "ready": got string, want probe`,
+		`restart_on_reset = 1`: `for parameter "restart_on_reset": got int, want bool`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.process(
+    name = "blop",
+    provides = ["some_model"],
+    command = "sleep 1",
+    `[1:]+code+`,
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:15: in <toplevel>
+Error in process: process: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestProcessCommandIsRequired(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.process(
+    name = "blop",
+    provides = ["some_model"],
+)
+`[1:]+someOpenAPI3Model)
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:15: in <toplevel>
+Error in process: process: missing argument for command`[1:])
+	require.Nil(t, rt)
+}
+
+// execution
+
+func newFakeProcessMonkey(t *testing.T, kwargs string) (rt *Runtime, ctx context.Context) {
+	rt, err := newFakeMonkey(t, `
+monkey.process(
+    name = "blop",
+    provides = ["some_model"],
+`[1:]+kwargs+`)
+`+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Len(t, rt.resetters, 1)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	t.Cleanup(cancel)
+
+	err = rt.Lint(ctx, false)
+	require.NoError(t, err)
+	err = rt.FilterEndpoints(nil)
+	require.NoError(t, err)
+
+	rt.progress = &ci.Progresser{}
+	rt.client = &fakeClient{}
+
+	err = cwid.MakePwdID(rt.binTitle, ".", 0)
+	require.NoError(t, err)
+	return
+}
+
+func TestProcessWaitsForLogLine(t *testing.T) {
+	rt, ctx := newFakeProcessMonkey(t, `
+    command = ["/bin/sh", "-c", "sleep 0.2; echo Listening on port $PORT; exec sleep 30"],
+    env = {"PORT": "6773"},
+    ready = monkey.ready.log_line("^Listening on port 6773$"),
+`[1:])
+	pb := rt.resetters["blop"].ToProto()
+	require.Equal(t, "^Listening on port 6773$", pb.GetReady().GetLogLine())
+	require.Equal(t, map[string]string{"PORT": "6773"}, pb.GetProcess().GetEnv())
+
+	scriptErr, err := rt.reset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, scriptErr)
+
+	// Still running: nothing to do
+	scriptErr, err = rt.reset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, scriptErr)
+
+	err = rt.Cleanup(ctx)
+	require.NoError(t, err)
+	require.NoFileExists(t, cwid.Shared()+"process_blop.pid")
+}
+
+func TestProcessStoppedByLaterRun(t *testing.T) {
+	rt, ctx := newFakeProcessMonkey(t, `
+    command = "sleep 30",
+`[1:])
+	err := rt.JustExecStart(ctx)
+	require.NoError(t, err)
+	data, err := os.ReadFile(cwid.Shared() + "process_blop.pid")
+	require.NoError(t, err)
+	pid, err := strconv.Atoi(string(data))
+	require.NoError(t, err)
+	started := cwid.Prefixed()
+
+	// `monkey exec stop` runs in another slot
+	rt, ctx = newFakeProcessMonkey(t, `
+    command = "sleep 30",
+`[1:])
+	require.NotEqual(t, started, cwid.Prefixed())
+	err = rt.JustExecStop(ctx)
+	require.NoError(t, err)
+	require.NoFileExists(t, cwid.Shared()+"process_blop.pid")
+
+	proc, err := os.FindProcess(pid)
+	require.NoError(t, err)
+	require.Eventually(t, func() bool { return proc.Signal(syscall.Signal(0)) != nil },
+		5*time.Second, 10*time.Millisecond, "pid %d still running", pid)
+
+	// A stale pid file does not get in the way
+	err = os.WriteFile(cwid.Shared()+"process_blop.pid", data, 0640)
+	require.NoError(t, err)
+	err = rt.JustExecStop(ctx)
+	require.NoError(t, err)
+	require.NoFileExists(t, cwid.Shared()+"process_blop.pid")
+}
+
+func TestProcessWaitsForTCP(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	rt, ctx := newFakeProcessMonkey(t, fmt.Sprintf(`
+    command = "sleep 30",
+    ready = monkey.ready.tcp(%q),
+    restart_on_reset = True,
+`[1:], ln.Addr().String()))
+
+	pidOf := func() string {
+		data, err := os.ReadFile(cwid.Shared() + "process_blop.pid")
+		require.NoError(t, err)
+		return string(data)
+	}
+
+	scriptErr, err := rt.reset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, scriptErr)
+	pid := pidOf()
+
+	scriptErr, err = rt.reset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, scriptErr)
+	require.NotEqual(t, pid, pidOf(), "restarted")
+
+	err = rt.Cleanup(ctx)
+	require.NoError(t, err)
+}
+
+func TestProcessProbesOncePerStart(t *testing.T) {
+	var probed int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&probed, 1)
+	}))
+	defer srv.Close()
+
+	rt, ctx := newFakeProcessMonkey(t, fmt.Sprintf(`
+    command = "sleep 30",
+    ready = monkey.ready.http(%q),
+    restart_on_reset = True,
+`[1:], srv.URL))
+
+	for i := 1; i <= 2; i++ {
+		scriptErr, err := rt.reset(ctx)
+		require.NoError(t, err)
+		require.NoError(t, scriptErr)
+		require.EqualValues(t, i, atomic.LoadInt32(&probed))
+	}
+
+	err := rt.Cleanup(ctx)
+	require.NoError(t, err)
+}
+
+func TestProcessExitingBeforeReady(t *testing.T) {
+	rt, ctx := newFakeProcessMonkey(t, `
+    command = ["/bin/sh", "-c", "echo cannot bind; exit 3"],
+    ready = monkey.ready.http("http://127.0.0.1:1/health"),
+`[1:])
+
+	start := time.Now()
+	scriptErr, err := rt.reset(ctx)
+	require.NoError(t, err)
+	require.Less(t, time.Since(start), 5*time.Second)
+	require.IsType(t, resetter.NewError(nil), scriptErr)
+	require.Equal(t, []string{
+		"blop exited before being ready: exit status 3",
+		"output: " + cwid.Prefixed() + "process_blop.log",
+		"cannot bind",
+	}, scriptErr.(*resetter.Error).Reason())
+
+	err = rt.Cleanup(ctx)
+	require.NoError(t, err)
+}
+
+func TestProcessDyingBetweenResets(t *testing.T) {
+	rt, ctx := newFakeProcessMonkey(t, `
+    command = ["/bin/sh", "-c", "echo up; sleep 0.3; echo crashed; exit 1"],
+    ready = monkey.ready.log_line("up"),
+`[1:])
+
+	scriptErr, err := rt.reset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, scriptErr)
+
+	time.Sleep(time.Second)
+	scriptErr, err = rt.reset(ctx)
+	require.NoError(t, err)
+	require.IsType(t, resetter.NewError(nil), scriptErr)
+	require.Equal(t, []string{
+		"blop exited: exit status 1",
+		"output: " + cwid.Prefixed() + "process_blop.log",
+		"up",
+		"crashed",
+	}, scriptErr.(*resetter.Error).Reason())
+
+	err = rt.Cleanup(ctx)
+	require.NoError(t, err)
+}
@@ -0,0 +1,117 @@
+package runtime
+
+import (
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+func TestReadyNeedsProcessOutputForLogLines(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = "true",
+    ready = monkey.ready.log_line("^up$"),
+)
+`[1:]+someOpenAPI3Model)
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:13: in <toplevel>
+Error in shell: ready: log_line probes only work with a process' output`[1:])
+	require.Nil(t, rt)
+}
+
+func TestReadyGatesResetsUntilTCPListens(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = "true",
+    ready = monkey.ready.tcp(%q),
+)
+`[1:], addr)+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Equal(t, addr, rt.resetters["blop"].ToProto().GetReady().GetTcp())
+
+	const delay = 300 * time.Millisecond
+	go func() {
+		time.Sleep(delay)
+		if ln, err := net.Listen("tcp", addr); err == nil {
+			t.Cleanup(func() { ln.Close() })
+		}
+	}()
+
+	start := time.Now()
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+	require.GreaterOrEqual(t, time.Since(start), delay)
+}
+
+func TestReadyFailsResetsPastDeadline(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	addr := ln.Addr().String()
+	require.NoError(t, ln.Close())
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = "true",
+    ready = monkey.ready.http("http://%s/health", timeout_ms = 300),
+)
+`[1:], addr)+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Equal(t, uint32(300), rt.resetters["blop"].ToProto().GetReady().GetTimeoutMs())
+
+	start := time.Now()
+	scriptErr := rt.runFakeReset(t)
+	require.Less(t, time.Since(start), 2*time.Second)
+	require.IsType(t, resetter.NewError(nil), scriptErr)
+	reason := scriptErr.(*resetter.Error).Reason()
+	require.Len(t, reason, 1)
+	require.Contains(t, reason[0], fmt.Sprintf(`blop is not ready: http("http://%s/health", status = 200): context deadline exceeded`, addr))
+}
+
+func TestReadyPredicates(t *testing.T) {
+	for ret, expected := range map[string]string{
+		"True":  "",
+		"False": "blop is not ready: predicate(up): context deadline exceeded (last attempt: got False)",
+		"42":    "blop is not ready: predicate(up): want bool, got int",
+	} {
+		t.Run(ret, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+def up():
+    return `[1:]+ret+`
+
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = "true",
+    ready = monkey.ready.predicate(up, timeout_ms = 200),
+)
+`+someOpenAPI3Model)
+			require.NoError(t, err)
+			require.Equal(t, "up", rt.resetters["blop"].ToProto().GetReady().GetPredicate())
+
+			scriptErr := rt.runFakeReset(t)
+			if expected == "" {
+				require.NoError(t, scriptErr)
+				return
+			}
+			require.IsType(t, resetter.NewError(nil), scriptErr)
+			require.Equal(t, []string{expected}, scriptErr.(*resetter.Error).Reason())
+		})
+	}
+}
@@ -0,0 +1,118 @@
+package runtime
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"go.starlark.net/starlark"
+)
+
+func TestShellExportsResetterEnv(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = """
+echo PORT=36718 >>"$MONKEY_EXPORT"
+echo '# comments and blank lines are skipped' >>"$MONKEY_EXPORT"
+echo >>"$MONKEY_EXPORT"
+echo TOKEN=s3cr3t=42 >>"$MONKEY_EXPORT"
+""",
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+	require.Equal(t, map[string]string{
+		"PORT":  "36718",
+		"TOKEN": "s3cr3t=42",
+	}, rt.exportedEnv())
+
+	env := newResetterEnvDict(rt.exportedEnv())
+	cx := newCxModBeforeRequest(nil, env)
+	v, err := cx.Attr("resetter_env")
+	require.NoError(t, err)
+	port, found, err := v.(*starlark.Dict).Get(starlark.String("PORT"))
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, starlark.String("36718"), port)
+	err = v.(*starlark.Dict).SetKey(starlark.String("PORT"), starlark.String("80"))
+	require.EqualError(t, err, "cannot insert into frozen hash table")
+}
+
+func TestShellExportsMustBeKeyValues(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = "echo 'not an export' >>$MONKEY_EXPORT",
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+
+	scriptErr := rt.runFakeReset(t)
+	require.EqualError(t, scriptErr, `blop exported "not an export" (line 1 of $MONKEY_EXPORT): want KEY=value`)
+	require.Empty(t, rt.exportedEnv())
+}
+
+func TestShellFileExportsResetterEnv(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset_file = "testdata/export.sh",
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+	require.Equal(t, map[string]string{"FROM_FILE": "./testdata/export.sh"}, rt.exportedEnv())
+}
+
+func TestStarlarkResetterExportsResetterEnv(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+def reset(client):
+    client.export("TOKEN", "s3cr3t")
+    client.export("PORT", "36718")
+
+monkey.starlark_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    reset = reset,
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+	require.Equal(t, map[string]string{
+		"PORT":  "36718",
+		"TOKEN": "s3cr3t",
+	}, rt.exportedEnv())
+}
+
+func TestStarlarkResetterExportsOneLiners(t *testing.T) {
+	for call, expected := range map[string]string{
+		`client.export("TOKEN", "a\nb")`: `Error in export: export: cannot export "TOKEN"="a\nb" on one line`,
+		`client.export("my-key", "v")`:   `Error in export: export: cannot export "my-key": want a key matching ^[A-Za-z_][A-Za-z0-9_]*$`,
+		`client.export("A=B", "v")`:      `Error in export: export: cannot export "A=B": want a key matching ^[A-Za-z_][A-Za-z0-9_]*$`,
+	} {
+		t.Run(call, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.starlark_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    reset = lambda client: `[1:]+call+`,
+)
+`+someOpenAPI3Model)
+			require.NoError(t, err)
+
+			scriptErr := rt.runFakeReset(t)
+			require.ErrorContains(t, scriptErr, expected)
+			require.ErrorContains(t, scriptErr, "fuzzymonkey.star:4:", "backtrace points at the call")
+			require.Empty(t, rt.exportedEnv())
+		})
+	}
+}
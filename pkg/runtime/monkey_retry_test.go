@@ -0,0 +1,148 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+)
+
+func TestResetterRetryTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`retries = -1`:         `shell: retries: want a number from 0 to 100, got -1`,
+		`retries = 101`:        `shell: retries: want a number from 0 to 100, got 101`,
+		`retries = "2"`:        `shell: for parameter "retries": got string, want int`,
+		`backoff_ms = 10`:      `shell: backoff_ms and on_failure only apply with retries`,
+		`on_failure = "retry"`: `shell: backoff_ms and on_failure only apply with retries`,
+		`backoff_ms = -10,
+    retries = 2`: `shell: backoff_ms: want a number of milliseconds from 0 to 60000, got -10`,
+		`backoff_ms = 60001,
+    retries = 2`: `shell: backoff_ms: want a number of milliseconds from 0 to 60000, got 60001`,
+		`on_failure = "crash",
+    retries = 2`: `shell: on_failure: want "retry" or "restart", got "crash"`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    `[1:]+code+`,
+    reset = "true",
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:13: in <toplevel>
+Error in shell: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestResetterRestartKeepsBaselines(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.snapshot(
+    name = "blop",
+    paths = ["db.sqlite"],
+    provides = ["some_model"],
+    retries = 2,
+    on_failure = "restart",
+)
+`[1:]+someOpenAPI3Model)
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in snapshot: snapshot: on_failure = "restart" would replace the state resets go back to`[1:])
+	require.Nil(t, rt)
+}
+
+type resetProgressClient struct {
+	fakeClient
+	sent []*fm.Clt_ResetProgress
+}
+
+func (c *resetProgressClient) Send(ctx context.Context, msg *fm.Clt) (err error) {
+	if rp := msg.GetResetProgress(); rp != nil {
+		c.sent = append(c.sent, rp)
+	}
+	return
+}
+
+// flakyReset fails the first n resets, counting attempts in a file
+func flakyReset(t *testing.T, n int) string {
+	count := filepath.Join(t.TempDir(), "count")
+	return fmt.Sprintf(`c=$(cat %[1]s 2>/dev/null || echo 0); echo $((c + 1)) >%[1]s; [[ $c -ge %[2]d ]]`, count, n)
+}
+
+func TestResetterRetriesFlakyResets(t *testing.T) {
+	for retries, ok := range map[int]bool{1: false, 2: true, 3: true} {
+		t.Run(fmt.Sprintf("retries=%d", retries), func(t *testing.T) {
+			rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    retries = %d,
+    backoff_ms = 1,
+    reset = %q,
+)
+`[1:], retries, flakyReset(t, 2))+someOpenAPI3Model)
+			require.NoError(t, err)
+			require.Equal(t, uint32(retries), rt.resettersRetries["blop"].GetRetries())
+			require.Equal(t, uint32(1), rt.resettersRetries["blop"].GetBackoffMs())
+			require.False(t, rt.resettersRetries["blop"].GetRestart())
+
+			clt := &resetProgressClient{}
+			rt.client = clt
+			scriptErr := rt.runFakeReset(t)
+			require.Len(t, clt.sent, 2)
+			if ok {
+				require.NoError(t, scriptErr)
+				require.Equal(t, fm.Clt_ResetProgress_ended, clt.sent[1].GetStatus())
+				require.Equal(t, map[string]uint32{"blop": 2}, clt.sent[1].GetRetries())
+			} else {
+				require.Error(t, scriptErr)
+				require.Equal(t, fm.Clt_ResetProgress_failed, clt.sent[1].GetStatus())
+				require.Equal(t, map[string]uint32{"blop": 1}, clt.sent[1].GetRetries())
+			}
+		})
+	}
+}
+
+func TestResetterRestartsOnFailure(t *testing.T) {
+	log := filepath.Join(t.TempDir(), "phases.log")
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    retries = 1,
+    backoff_ms = 1,
+    on_failure = "restart",
+    start = "echo start >>%[1]s",
+    reset = %[2]q,
+    stop = "echo stop >>%[1]s",
+)
+`[1:], log, "echo reset >>"+log+"; "+flakyReset(t, 1))+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.True(t, rt.resettersRetries["blop"].GetRestart())
+
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+
+	data, err := os.ReadFile(log)
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"start",
+		"reset",
+		"stop",
+		"start",
+		"reset",
+		"stop",
+	}, strings.Split(strings.TrimSpace(string(data)), "\n"))
+}
@@ -3,6 +3,7 @@ package runtime
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"testing"
 	"time"
@@ -214,8 +215,121 @@ Error in shell: shell: for parameter "stop": got float, want string`[1:])
 	require.Nil(t, rt)
 }
 
+// kwarg: *_file
+
+func TestShellFilesTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`reset_file = 42`:                  `shell: for parameter "reset_file": got int, want string`,
+		`reset_file = "/tmp/reset.sh"`:     `reset_file: want a path within the current directory, got: "/tmp/reset.sh"`,
+		`reset_file = "../reset.sh"`:       `reset_file: want a path within the current directory, got: "../reset.sh"`,
+		`reset = "true", reset_file = "x"`: `only one of reset or reset_file can be given`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    `[1:]+strings.ReplaceAll(code, ", ", ",\n    ")+`,
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:13: in <toplevel>
+Error in shell: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestShellLintsFilesAndInterpreter(t *testing.T) {
+	for code, expected := range map[string]string{
+		`reset_file = "testdata/nope.sh"`:           `resetter blop: reset_file: stat testdata/nope.sh: no such file or directory`,
+		`reset_file = "testdata/not_executable.sh"`: `resetter blop: reset_file: not an executable file: "testdata/not_executable.sh"`,
+		`reset_file = "testdata"`:                   `resetter blop: reset_file: not an executable file: "testdata"`,
+		`reset = "true", shell = "/nope/bash"`:      `resetter blop: shell: exec: "/nope/bash": stat /nope/bash: no such file or directory`,
+		`reset = "true", shell = "true"`:            `resetter blop: shell: want bash, got "true"`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    `[1:]+strings.ReplaceAll(code, ", ", ",\n    ")+`,
+)
+`+someOpenAPI3Model)
+			require.NoError(t, err)
+			err = rt.Lint(context.Background(), false)
+			require.EqualError(t, err, expected)
+		})
+	}
+}
+
+// kwarg: timeout_ms
+
+func TestShellTimeoutTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`1.5`:            `for parameter "timeout_ms": got float, want int or dict`,
+		`0`:              `for parameter "timeout_ms": want a positive number of milliseconds, got: 0`,
+		`{"rst": 1000}`:  `for parameter "timeout_ms": unexpected key "rst", want one of start, reset or stop`,
+		`{"reset": "1"}`: `for parameter "timeout_ms": reset: want a positive number of milliseconds, got: "1"`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = "true",
+    timeout_ms = `[1:]+code+`,
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:13: in <toplevel>
+Error in shell: shell: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+func TestShellTimeouts(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = "sleep 5",
+    timeout_ms = {"reset": 200},
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+	pb := rt.resetters["blop"].ToProto().GetShell()
+	require.Equal(t, uint32(120000), pb.GetStartTimeoutMs())
+	require.Equal(t, uint32(200), pb.GetRstTimeoutMs())
+	require.Equal(t, "/bin/bash", pb.GetShell())
+
+	start := time.Now()
+	scriptErr := rt.runFakeReset(t)
+	require.ErrorIs(t, scriptErr, context.Canceled)
+	require.Less(t, time.Since(start), 5*time.Second)
+}
+
 // execution
 
+func TestShellResetsFromFile(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset_file = "testdata/reset.sh",
+    shell = "bash",
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Equal(t, "testdata/reset.sh", rt.resetters["blop"].ToProto().GetShell().GetRstFile())
+
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+}
+
 func TestShellResets(t *testing.T) {
 	type testcase struct {
 		code  string
@@ -290,3 +404,50 @@ monkey.shell(
 		})
 	}
 }
+
+func TestShellOutputIsTranscribed(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.shell(
+    name = "blop",
+    provides = ["some_model"],
+    reset = "echo Hello; echo hi >&2; false",
+)
+`[1:]+someOpenAPI3Model)
+	require.NoError(t, err)
+
+	clt := &resetProgressClient{}
+	rt.client = clt
+	scriptErr := rt.runFakeReset(t)
+	require.Error(t, scriptErr)
+
+	ts := rt.transcripts["blop"]
+	require.Equal(t, cwid.Prefixed()+"resetter_blop.log", ts.path)
+	data, err := os.ReadFile(ts.path)
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSuffix(string(data), "\n"), "\n")
+	require.Len(t, lines, 9)
+	require.Regexp(t, `^### reset #1 at \S+$`, lines[0])
+	// stdout and stderr go through distinct pipes: only each one's order is kept
+	var stdout, stderr []string
+	for _, line := range lines[1:6] {
+		if x := strings.TrimPrefix(line, "[stderr] "); x != line {
+			stderr = append(stderr, x)
+		} else {
+			stdout = append(stdout, line)
+		}
+	}
+	require.Equal(t, []string{"Hello"}, stdout)
+	require.Equal(t, []string{" ❯ echo Hello", " ❯ echo hi", "hi", " ❯ false"}, stderr)
+	require.Regexp(t, `^### reset #1 failed after \S+: exit status 1$`, lines[6])
+	require.Regexp(t, `^### stop at \S+$`, lines[7])
+	require.Regexp(t, `^### stop ended after \S+$`, lines[8])
+
+	// The reset's output is sent along for counterexamples
+	require.Len(t, clt.sent, 2)
+	outputs := clt.sent[1].GetOutputs()
+	require.Len(t, outputs, 1)
+	require.Equal(t, "blop", outputs[0].GetResetter())
+	require.Equal(t, ts.path, outputs[0].GetTranscript())
+	require.False(t, outputs[0].GetTruncated())
+	require.ElementsMatch(t, lines[1:6], outputs[0].GetLines())
+}
@@ -0,0 +1,242 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser/ci"
+)
+
+// kwargs
+
+func TestSnapshotPathsIsRequired(t *testing.T) {
+	rt, err := newFakeMonkey(t, `
+monkey.snapshot(
+    name = "blop",
+    provides = ["some_model"],
+)
+`[1:]+someOpenAPI3Model)
+	require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in snapshot: snapshot: missing argument for paths`[1:])
+	require.Nil(t, rt)
+}
+
+func TestSnapshotPathsTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`[]`:                         `snapshot: for parameter "paths": must not be empty`,
+		`["db.sqlite", 42]`:          `snapshot: for parameter "paths": got int, want string`,
+		`["db.sqlite", "db.sqlite"]`: `snapshot: for parameter "paths": "db.sqlite" appears more than once`,
+		`["."]`:                      `cannot snapshot path "."`,
+		`["uploads", "./uploads/"]`:  `paths "uploads" and "uploads" overlap`,
+		`["data", "data/db.sqlite"]`: `paths "data" and "data/db.sqlite" overlap`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.snapshot(
+    name = "blop",
+    paths = `[1:]+code+`,
+    provides = ["some_model"],
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:16: in <toplevel>
+Error in snapshot: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+// execution
+
+func TestSnapshotRestores(t *testing.T) {
+	tmp := t.TempDir()
+	db := filepath.Join(tmp, "db.sqlite")
+	uploads := filepath.Join(tmp, "uploads")
+	require.NoError(t, os.WriteFile(db, []byte("initial"), 0644))
+	require.NoError(t, os.MkdirAll(filepath.Join(uploads, "avatars"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(uploads, "avatars", "a.png"), []byte("A"), 0644))
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.snapshot(
+    name = "blop",
+    paths = [%q, %q],
+    provides = ["some_model"],
+)
+`[1:]+someOpenAPI3Model, db, uploads))
+	require.NoError(t, err)
+	require.Len(t, rt.resetters, 1)
+	require.Equal(t, []string{db, uploads}, rt.resetters["blop"].ToProto().GetSnapshot().GetPaths())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = rt.Lint(ctx, false)
+	require.NoError(t, err)
+	err = rt.FilterEndpoints(nil)
+	require.NoError(t, err)
+
+	rt.progress = &ci.Progresser{}
+	rt.client = &fakeClient{}
+
+	err = cwid.MakePwdID(rt.binTitle, ".", 0)
+	require.NoError(t, err)
+
+	// First reset takes the snapshot
+	scriptErr, err := rt.reset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, scriptErr)
+
+	// A test run messes things up
+	require.NoError(t, os.WriteFile(db, []byte("modified"), 0644))
+	require.NoError(t, os.WriteFile(db+"-wal", []byte("pending"), 0644))
+	require.NoError(t, os.Remove(filepath.Join(uploads, "avatars", "a.png")))
+	require.NoError(t, os.MkdirAll(filepath.Join(uploads, "new", "dir"), 0755))
+	require.NoError(t, os.WriteFile(filepath.Join(uploads, "b.png"), []byte("B"), 0644))
+
+	scriptErr, err = rt.reset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, scriptErr)
+
+	data, err := os.ReadFile(db)
+	require.NoError(t, err)
+	require.Equal(t, "initial", string(data))
+	require.NoFileExists(t, db+"-wal")
+	data, err = os.ReadFile(filepath.Join(uploads, "avatars", "a.png"))
+	require.NoError(t, err)
+	require.Equal(t, "A", string(data))
+	require.NoFileExists(t, filepath.Join(uploads, "b.png"))
+	require.NoDirExists(t, filepath.Join(uploads, "new"))
+	entries, err := os.ReadDir(tmp)
+	require.NoError(t, err)
+	require.Len(t, entries, 2, "no staging leftovers")
+
+	// Damaged snapshots are not restored
+	stored := cwid.Prefixed() + "snapshot_blop"
+	require.DirExists(t, stored)
+	require.NoError(t, os.WriteFile(filepath.Join(stored, "0"), []byte("garbage"), 0600))
+	require.NoError(t, os.WriteFile(db, []byte("modified again"), 0644))
+
+	scriptErr, err = rt.reset(ctx)
+	require.NoError(t, err)
+	require.ErrorContains(t, scriptErr, fmt.Sprintf("cannot restore %q: corrupted snapshot: got sha256 ", db))
+	data, err = os.ReadFile(db)
+	require.NoError(t, err)
+	require.Equal(t, "modified again", string(data))
+
+	err = rt.Cleanup(ctx)
+	require.NoError(t, err)
+	require.NoDirExists(t, stored)
+}
+
+func TestSnapshotRestoresDeletedFile(t *testing.T) {
+	tmp := t.TempDir()
+	db := filepath.Join(tmp, "app.db")
+	require.NoError(t, os.WriteFile(db, []byte("initial"), 0644))
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.snapshot(
+    name = "blop",
+    paths = [%q],
+    provides = ["some_model"],
+)
+`[1:]+someOpenAPI3Model, db))
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	err = rt.Lint(ctx, false)
+	require.NoError(t, err)
+	err = rt.FilterEndpoints(nil)
+	require.NoError(t, err)
+
+	rt.progress = &ci.Progresser{}
+	rt.client = &fakeClient{}
+
+	err = cwid.MakePwdID(rt.binTitle, ".", 0)
+	require.NoError(t, err)
+
+	scriptErr, err := rt.reset(ctx)
+	require.NoError(t, err)
+	require.NoError(t, scriptErr)
+
+	// A test run deletes the file
+	require.NoError(t, os.Remove(db))
+	require.NoError(t, os.WriteFile(db+"-journal", []byte("pending"), 0644))
+
+	for i := 0; i < 2; i++ {
+		scriptErr, err = rt.reset(ctx)
+		require.NoError(t, err)
+		require.NoError(t, scriptErr)
+
+		data, err := os.ReadFile(db)
+		require.NoError(t, err)
+		require.Equal(t, "initial", string(data))
+		require.NoFileExists(t, db+"-journal")
+	}
+
+	err = rt.Cleanup(ctx)
+	require.NoError(t, err)
+}
+
+func TestSnapshotSpansExecRuns(t *testing.T) {
+	tmp := t.TempDir()
+	db := filepath.Join(tmp, "app.db")
+	require.NoError(t, os.WriteFile(db, []byte("initial"), 0644))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// Each `monkey exec ...` runs in its own slot
+	newRun := func() *Runtime {
+		rt, err := newFakeMonkey(t, fmt.Sprintf(`
+monkey.snapshot(
+    name = "blop",
+    paths = [%q],
+    provides = ["some_model"],
+)
+`[1:]+someOpenAPI3Model, db))
+		require.NoError(t, err)
+		err = rt.Lint(ctx, false)
+		require.NoError(t, err)
+
+		err = cwid.MakePwdID(rt.binTitle, ".", 0)
+		require.NoError(t, err)
+		logFile := cwid.LogFile()
+		require.NoError(t, os.WriteFile(logFile, nil, 0644))
+		t.Cleanup(func() { os.Remove(logFile) })
+		return rt
+	}
+
+	err := newRun().JustExecStart(ctx)
+	require.NoError(t, err)
+	started := cwid.Prefixed()
+	stored := cwid.Shared() + "snapshot_blop"
+	require.DirExists(t, stored)
+
+	require.NoError(t, os.WriteFile(db, []byte("modified"), 0644))
+
+	err = newRun().JustExecReset(ctx)
+	require.NoError(t, err)
+	require.NotEqual(t, started, cwid.Prefixed())
+	data, err := os.ReadFile(db)
+	require.NoError(t, err)
+	require.Equal(t, "initial", string(data))
+
+	err = newRun().JustExecStop(ctx)
+	require.NoError(t, err)
+	require.NoDirExists(t, stored)
+
+	err = newRun().JustExecReset(ctx)
+	require.ErrorContains(t, err, "run `monkey exec start` first")
+}
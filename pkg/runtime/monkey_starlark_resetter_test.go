@@ -0,0 +1,140 @@
+package runtime
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+// kwargs
+
+func TestStarlarkResetterPhasesTyping(t *testing.T) {
+	for code, expected := range map[string]string{
+		`reset = "DELETE http://a.b/things"`: `starlark_resetter: for parameter "reset": got string, want function`,
+		`reset = lambda: None`:               `reset: want a function taking one argument (a client), got lambda`,
+		`start = lambda c: None`:             "missing at least `starlark_resetter( reset = fn )`",
+		`ready = monkey.ready.log_line("up"),
+    reset = lambda c: None`: `ready: log_line probes only work with a process' output`,
+	} {
+		t.Run(code, func(t *testing.T) {
+			rt, err := newFakeMonkey(t, `
+monkey.starlark_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    `[1:]+code+`,
+)
+`+someOpenAPI3Model)
+			require.EqualError(t, err, `
+Traceback (most recent call last):
+  fuzzymonkey.star:1:25: in <toplevel>
+Error in starlark_resetter: `[1:]+expected)
+			require.Nil(t, rt)
+		})
+	}
+}
+
+// execution
+
+type thingsServer struct {
+	*httptest.Server
+	mu   sync.Mutex
+	seen []string
+}
+
+func newThingsServer() *thingsServer {
+	s := &thingsServer{}
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		s.mu.Lock()
+		s.seen = append(s.seen, strings.TrimSpace(fmt.Sprintf("%s %s %s", r.Method, r.URL.Path, body)))
+		s.mu.Unlock()
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/things":
+			w.Header().Set("Content-Type", "application/json")
+			fmt.Fprintln(w, `[{"id": 1}, {"id": 2}]`)
+		case r.Method == http.MethodDelete && strings.HasPrefix(r.URL.Path, "/things/"):
+			w.WriteHeader(http.StatusNoContent)
+		case r.Method == http.MethodPost && r.URL.Path == "/things":
+			w.WriteHeader(http.StatusCreated)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	return s
+}
+
+func TestStarlarkResetterResets(t *testing.T) {
+	srv := newThingsServer()
+	defer srv.Close()
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+def delete_things(client):
+    """Deletes every listed thing then creates one.
+
+    Args:
+      client: issues HTTP calls and reads env
+    """
+    base = client.env("SOME_UNSET_ENV_VAR", %q)
+    rep = client.get(base + "/things")
+    assert that(rep.status_code).is_equal_to(200)
+    assert that(rep.headers["Content-Type"]).is_equal_to("application/json")
+    for thing in rep.json:
+        rep = client.delete(base + "/things/%%d" %% thing["id"])
+        assert that(rep.status_code).is_equal_to(204)
+    rep = client.post(base + "/things", body = {"id": 3})
+    assert that(rep.status_code).is_equal_to(201)
+    print("deleted", len(rep.body), "things")
+
+monkey.starlark_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    reset = delete_things,
+)
+`[1:], srv.URL)+someOpenAPI3Model)
+	require.NoError(t, err)
+	require.Equal(t, "delete_things", rt.resetters["blop"].ToProto().GetStarlark().GetRst())
+
+	scriptErr := rt.runFakeReset(t)
+	require.NoError(t, scriptErr)
+	require.Equal(t, []string{
+		"GET /things",
+		"DELETE /things/1",
+		"DELETE /things/2",
+		`POST /things {"id":3}`,
+	}, srv.seen)
+}
+
+func TestStarlarkResetterFailsWithAssertions(t *testing.T) {
+	srv := newThingsServer()
+	defer srv.Close()
+
+	rt, err := newFakeMonkey(t, fmt.Sprintf(`
+def reset(client):
+    rep = client.request("PURGE", %q)
+    assert that(rep.status_code).is_equal_to(204)
+
+monkey.starlark_resetter(
+    name = "blop",
+    provides = ["some_model"],
+    reset = reset,
+)
+`[1:], srv.URL+"/things")+someOpenAPI3Model)
+	require.NoError(t, err)
+
+	scriptErr := rt.runFakeReset(t)
+	require.IsType(t, resetter.NewError(nil), scriptErr)
+	require.Equal(t, []string{
+		"Traceback (most recent call last):",
+		"  fuzzymonkey.star:3:45: in reset",
+		"Error in is_equal_to: Not true that <404> is equal to <204>.",
+	}, scriptErr.(*resetter.Error).Reason())
+	require.Equal(t, []string{"PURGE /things"}, srv.seen)
+}
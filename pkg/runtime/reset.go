@@ -2,12 +2,15 @@ package runtime
 
 import (
 	"context"
+	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
 	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
 )
 
@@ -21,12 +24,15 @@ func (rt *Runtime) Cleanup(ctx context.Context) (err error) {
 	as.ColorNFO.Println("Cleaning up...")
 
 	log.Println("[NFO] terminating resetter")
-	if errR := rt.forEachSelectedResetter(ctx, func(name string, rsttr resetter.Interface) error {
-		return rsttr.Terminate(ctx, &osShower{}, rt.envRead)
+	if errR := rt.forEachSelectedResetterReversed(ctx, func(name string, rsttr resetter.Interface) error {
+		return rt.record(name, "stop", &osShower{}, func(shower progresser.Shower) error {
+			return rsttr.Terminate(ctx, shower, rt.envFor(name))
+		})
 	}); errR != nil {
 		err = errR
 		// Keep going
 	}
+	rt.closeTranscripts()
 
 	rt.cleanedup = true
 	return
@@ -48,7 +54,8 @@ func (rt *Runtime) reset(ctx context.Context) (errL, errT error) {
 	}
 
 	start := time.Now()
-	errL = rt.runReset(ctx)
+	var retries map[string]uint32
+	retries, errL = rt.runReset(ctx)
 	elapsed := time.Since(start).Nanoseconds()
 	if errL != nil {
 		log.Println("[ERR] exec'd:", errL)
@@ -64,6 +71,8 @@ func (rt *Runtime) reset(ctx context.Context) (errL, errT error) {
 			Status:    fm.Clt_ResetProgress_failed,
 			ElapsedNs: elapsed,
 			Reason:    reason,
+			Retries:   retries,
+			Outputs:   rt.lastOutputs(),
 		})); errT != nil {
 			log.Println("[ERR]", errT)
 			return
@@ -80,6 +89,8 @@ func (rt *Runtime) reset(ctx context.Context) (errL, errT error) {
 	if errT = rt.client.Send(ctx, rp(&fm.Clt_ResetProgress{
 		Status:    fm.Clt_ResetProgress_ended,
 		ElapsedNs: elapsed,
+		Retries:   retries,
+		Outputs:   rt.lastOutputs(),
 	})); errT != nil {
 		log.Println("[ERR]", errT)
 		return
@@ -89,7 +100,7 @@ func (rt *Runtime) reset(ctx context.Context) (errL, errT error) {
 	return
 }
 
-func (rt *Runtime) runReset(ctx context.Context) (err error) {
+func (rt *Runtime) runReset(ctx context.Context) (retries map[string]uint32, err error) {
 	if err = rt.forEachAfterResponseCheck(func(name string, chk *check) error {
 		if err := chk.reset(name); err != nil {
 			log.Println("[ERR]", err)
@@ -101,7 +112,46 @@ func (rt *Runtime) runReset(ctx context.Context) (err error) {
 	}
 	log.Println("[NFO] re-initialized model state")
 
-	return rt.forEachSelectedResetter(ctx, func(name string, rsttr resetter.Interface) error {
-		return rsttr.ExecReset(ctx, rt.progress, false, rt.envRead)
+	for _, mdl := range rt.models {
+		mdl.ResetSession()
+	}
+
+	rt.resetsCount++
+	what := fmt.Sprintf("reset #%d", rt.resetsCount)
+	var mu sync.Mutex
+	err = rt.forEachSelectedResetter(ctx, func(name string, rsttr resetter.Interface) error {
+		n, err := rt.resetWithRetries(ctx, name, rsttr, what)
+		if n != 0 {
+			mu.Lock()
+			defer mu.Unlock()
+			if retries == nil {
+				retries = make(map[string]uint32)
+			}
+			retries[name] = n
+		}
+		return err
 	})
+	return
+}
+
+// waitReady holds off using the system under test until the resetter's probe succeeds,
+// so that a service still booting is reported as a reset failure and not as a bug.
+// Probes reading output are waited on by the resetter itself, as are the probes
+// of resetters that start the system under test (e.g. processes).
+func waitReady(ctx context.Context, name string, rsttr resetter.Interface) error {
+	probe := rsttr.Ready()
+	if probe == nil || probe.ReadsLines() {
+		return nil
+	}
+	if rw, ok := rsttr.(resetter.ReadyWaiter); ok && rw.WaitsReady() {
+		return nil
+	}
+
+	start := time.Now()
+	if err := probe.Wait(ctx, nil); err != nil {
+		log.Println("[ERR]", err)
+		return resetter.NewError([][]byte{[]byte(fmt.Sprintf("%s is not ready: %v", name, err))})
+	}
+	log.Printf("[NFO] %s is ready after %s", name, time.Since(start))
+	return nil
 }
@@ -0,0 +1,106 @@
+package runtime
+
+import (
+	"bufio"
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+func exportsPath(name string) string {
+	return cwid.Prefixed() + "resetter_" + name + ".env"
+}
+
+// envFor returns the envs given to the named resetter
+func (rt *Runtime) envFor(name string) map[string]string {
+	env := make(map[string]string, len(rt.envRead)+1)
+	for k, v := range rt.envRead {
+		env[k] = v
+	}
+	env[resetter.EnvExport] = exportsPath(name)
+	return env
+}
+
+// readExports collects what the named resetter exported since last read.
+// Later exports of a key replace earlier ones.
+func (rt *Runtime) readExports(name string) (err error) {
+	path := exportsPath(name)
+	var f *os.File
+	if f, err = os.Open(path); err != nil {
+		if os.IsNotExist(err) {
+			err = nil
+			return
+		}
+		log.Println("[ERR]", err)
+		return
+	}
+	defer os.Remove(path)
+	defer f.Close()
+
+	exports := make(map[string]string)
+	scanner := bufio.NewScanner(f)
+	for i := 1; scanner.Scan(); i++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		key, value, ok := strings.Cut(line, "=")
+		if !ok || !resetter.ExportKey.MatchString(key) {
+			err = fmt.Errorf("%s exported %q (line %d of $%s): want KEY=value", name, line, i, resetter.EnvExport)
+			log.Println("[ERR]", err)
+			return
+		}
+		exports[key] = value
+	}
+	if err = scanner.Err(); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
+
+	rt.resetterEnvMu.Lock()
+	defer rt.resetterEnvMu.Unlock()
+	if rt.resetterEnv == nil {
+		rt.resetterEnv = make(map[string]string, len(exports))
+	}
+	for key, value := range exports {
+		log.Printf("[NFO] resetter %s exported %s=%q", name, key, value)
+		rt.resetterEnv[key] = value
+	}
+	return
+}
+
+// exportedEnv returns a copy of what resetters exported so far
+func (rt *Runtime) exportedEnv() map[string]string {
+	rt.resetterEnvMu.Lock()
+	defer rt.resetterEnvMu.Unlock()
+	env := make(map[string]string, len(rt.resetterEnv))
+	for k, v := range rt.resetterEnv {
+		env[k] = v
+	}
+	return env
+}
+
+// newResetterEnvDict makes the frozen `ctx.resetter_env` value
+func newResetterEnvDict(env map[string]string) *starlark.Dict {
+	keys := make([]string, 0, len(env))
+	for key := range env {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	d := starlark.NewDict(len(env))
+	for _, key := range keys {
+		if err := d.SetKey(starlark.String(key), starlark.String(env[key])); err != nil {
+			panic(err) // unreachable: dict is not frozen yet
+		}
+	}
+	d.Freeze()
+	return d
+}
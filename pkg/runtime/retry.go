@@ -0,0 +1,125 @@
+package runtime
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"go.starlark.net/starlark"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/resetter"
+)
+
+const (
+	defaultResetBackoffMs = 1000
+	maxResetBackoff       = time.Minute // delays double up to this
+	maxResetRetries       = 100
+
+	onFailureRetry   = "retry"
+	onFailureRestart = "restart"
+)
+
+// newResetRetry validates a resetter's retry policy, which is nil when it has none
+func newResetRetry(retries starlark.Int, backoffMs starlark.Value, onFailure starlark.String) (retry *fm.Clt_Fuzz_Resetter_Retry, err error) {
+	n, ok := retries.Uint64()
+	if !ok || n > maxResetRetries {
+		err = fmt.Errorf("retries: want a number from 0 to %d, got %s", maxResetRetries, retries)
+		return
+	}
+
+	var restart bool
+	switch onFailure {
+	case "", onFailureRetry:
+	case onFailureRestart:
+		restart = true
+	default:
+		err = fmt.Errorf("on_failure: want %q or %q, got %s", onFailureRetry, onFailureRestart, onFailure)
+		return
+	}
+
+	backoff := uint64(defaultResetBackoffMs)
+	if backoffMs != nil {
+		i, ok := backoffMs.(starlark.Int)
+		if ok {
+			backoff, ok = i.Uint64()
+		}
+		if !ok || backoff > uint64(maxResetBackoff/time.Millisecond) {
+			err = fmt.Errorf("backoff_ms: want a number of milliseconds from 0 to %d, got %s", maxResetBackoff/time.Millisecond, backoffMs)
+			return
+		}
+	}
+
+	if n == 0 {
+		if backoffMs != nil || onFailure != "" {
+			err = errors.New("backoff_ms and on_failure only apply with retries")
+		}
+		return
+	}
+
+	retry = &fm.Clt_Fuzz_Resetter_Retry{
+		Retries:   uint32(n),
+		BackoffMs: uint32(backoff),
+		Restart:   restart,
+	}
+	return
+}
+
+// resetWithRetries resets the named resetter, trying again as its retry policy allows.
+// Flaky resets (e.g. port races) then do not end the whole campaign.
+func (rt *Runtime) resetWithRetries(ctx context.Context, name string, rsttr resetter.Interface, what string) (retries uint32, err error) {
+	reset := func(what string) error {
+		return rt.record(name, what, rt.progress, func(shower progresser.Shower) error {
+			if err := rsttr.ExecReset(ctx, shower, false, rt.envFor(name)); err != nil {
+				return err
+			}
+			return waitReady(ctx, name, rsttr)
+		})
+	}
+
+	if err = reset(what); err == nil {
+		return
+	}
+
+	retry := rt.resettersRetries[name]
+	delay := time.Duration(retry.GetBackoffMs()) * time.Millisecond
+	for ; retries < retry.GetRetries(); delay = min(2*delay, maxResetBackoff) {
+		log.Printf("[NFO] retrying resetter %s in %s after: %v", name, delay, err)
+		rt.progress.Printf("Resetter %s failed, retrying in %s...\n", name, delay)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		retries++
+
+		if retry.GetRestart() {
+			if err = rt.restart(ctx, name, rsttr, fmt.Sprintf("%s restart #%d", what, retries)); err != nil {
+				log.Println("[ERR]", err)
+				continue
+			}
+		}
+
+		if err = reset(fmt.Sprintf("%s retry #%d", what, retries)); err == nil {
+			log.Printf("[NFO] resetter %s succeeded after %d retries", name, retries)
+			return
+		}
+	}
+	return
+}
+
+// restart stops then starts the named resetter again
+func (rt *Runtime) restart(ctx context.Context, name string, rsttr resetter.Interface, what string) error {
+	return rt.record(name, what, rt.progress, func(shower progresser.Shower) error {
+		if err := rsttr.ExecStop(ctx, shower, false, rt.envFor(name)); err != nil {
+			return err
+		}
+		if err := rsttr.ExecStart(ctx, shower, false, rt.envFor(name)); err != nil {
+			return err
+		}
+		return waitReady(ctx, name, rsttr)
+	})
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"sync"
 	"time"
 
 	"go.starlark.net/starlark"
@@ -34,6 +35,13 @@ type Runtime struct {
 	selectedResetters map[string]struct{}
 	resetters         map[string]resetter.Interface
 	resettersNames    []string
+	resettersDeps     map[string][]string
+	resettersRetries  map[string]*fm.Clt_Fuzz_Resetter_Retry
+	resetsCount       uint32
+	transcriptsMu     sync.Mutex
+	transcripts       map[string]*transcript
+	resetterEnvMu     sync.Mutex
+	resetterEnv       map[string]string // exported by resetters
 
 	checks      map[string]*check
 	checksNames []string
@@ -81,10 +89,12 @@ func NewMonkey(name, starfile string, arglabels []string) (rt *Runtime, err erro
 	}
 
 	r := &Runtime{
-		binTitle:  name,
-		files:     map[string]string{starfile: string(starfileContents)},
-		models:    make(map[string]modeler.Interface, moduleModelers),
-		resetters: make(map[string]resetter.Interface, moduleResetters),
+		binTitle:         name,
+		files:            map[string]string{starfile: string(starfileContents)},
+		models:           make(map[string]modeler.Interface, moduleModelers),
+		resetters:        make(map[string]resetter.Interface, moduleResetters),
+		resettersDeps:    make(map[string][]string),
+		resettersRetries: make(map[string]*fm.Clt_Fuzz_Resetter_Retry),
 		thread: &starlark.Thread{
 			Name:  "cfg",
 			Load:  loadDisabled,
@@ -147,10 +157,26 @@ func (rt *Runtime) loadCfg(starfile string) (err error) {
 				return err
 			}
 		}
+		for _, dep := range rt.resettersDeps[name] {
+			var err error
+			if _, ok := rt.resetters[dep]; !ok {
+				err = fmt.Errorf("resetter %q depends on undefined %q", name, dep)
+			} else if dep == name {
+				err = fmt.Errorf("resetter %q depends on itself", name)
+			} else {
+				continue
+			}
+			log.Println("[ERR]", err)
+			return err
+		}
 		return nil
 	}); err != nil {
 		return
 	}
+	if err = rt.resettersDepsCycle(); err != nil {
+		log.Println("[ERR]", err)
+		return
+	}
 
 	log.Printf("[NFO] frozen envs: %d", len(rt.envRead))
 	_ = rt.forEachEnvRead(func(name string, value string) error {
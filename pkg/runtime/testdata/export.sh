@@ -0,0 +1,2 @@
+#!/bin/sh
+echo "FROM_FILE=$0" >>"$MONKEY_EXPORT"
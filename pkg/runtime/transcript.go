@@ -0,0 +1,191 @@
+package runtime
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/FuzzyMonkeyCo/monkey/pkg/as"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/cwid"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/internal/fm"
+	"github.com/FuzzyMonkeyCo/monkey/pkg/progresser"
+)
+
+// transcriptTail bounds how many lines of an invocation are kept in memory
+const transcriptTail = 32
+
+var _ progresser.Shower = (*transcript)(nil)
+
+// transcript records a resetter's interleaved output for the whole run,
+// one section per start/reset/stop invocation.
+type transcript struct {
+	path string
+
+	mu     sync.Mutex
+	f      *os.File
+	shower progresser.Shower
+	what   string
+	tail   []string // last lines of the latest invocation
+	cut    bool
+}
+
+func newTranscript(name string) (ts *transcript) {
+	ts = &transcript{path: cwid.Prefixed() + "resetter_" + name + ".log"}
+	var err error
+	if ts.f, err = os.OpenFile(ts.path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0644); err != nil {
+		// Resetters' output is still shown
+		log.Println("[ERR]", err)
+	}
+	return
+}
+
+// transcriptOf returns the named resetter's transcript, creating it on first use
+func (rt *Runtime) transcriptOf(name string) *transcript {
+	rt.transcriptsMu.Lock()
+	defer rt.transcriptsMu.Unlock()
+	if rt.transcripts == nil {
+		rt.transcripts = make(map[string]*transcript, len(rt.resetters))
+	}
+	ts, ok := rt.transcripts[name]
+	if !ok {
+		ts = newTranscript(name)
+		rt.transcripts[name] = ts
+	}
+	return ts
+}
+
+// record runs f as an invocation of a resetter, showing its output on shower
+func (rt *Runtime) record(name, what string, shower progresser.Shower, f func(progresser.Shower) error) (err error) {
+	ts := rt.transcriptOf(name)
+	ts.begin(what, shower)
+	start := time.Now()
+	if err = f(ts); err == nil {
+		err = rt.readExports(name)
+	}
+	ts.end(err, time.Since(start))
+	return
+}
+
+func (ts *transcript) begin(what string, shower progresser.Shower) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	ts.shower, ts.what, ts.tail, ts.cut = shower, what, nil, false
+	ts.write(fmt.Sprintf("### %s at %s", what, time.Now().Format(time.RFC3339Nano)))
+}
+
+func (ts *transcript) end(err error, elapsed time.Duration) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	if err != nil {
+		ts.write(fmt.Sprintf("### %s failed after %s: %v", ts.what, elapsed, err))
+	} else {
+		ts.write(fmt.Sprintf("### %s ended after %s", ts.what, elapsed))
+	}
+	if ts.f != nil {
+		if err := ts.f.Sync(); err != nil {
+			log.Println("[ERR]", err)
+		}
+	}
+}
+
+// Printf formats informational data
+func (ts *transcript) Printf(format string, s ...interface{}) {
+	ts.shower.Printf(format, s...)
+	ts.add("", fmt.Sprintf(format, s...))
+}
+
+// Errorf formats error messages
+func (ts *transcript) Errorf(format string, s ...interface{}) {
+	ts.shower.Errorf(format, s...)
+	ts.add("[stderr] ", fmt.Sprintf(format, s...))
+}
+
+func (ts *transcript) add(prefix, msg string) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	for _, line := range strings.Split(strings.TrimSuffix(msg, "\n"), "\n") {
+		line = prefix + line
+		ts.write(line)
+		if len(ts.tail) == transcriptTail {
+			ts.tail, ts.cut = ts.tail[1:], true
+		}
+		ts.tail = append(ts.tail, line)
+	}
+}
+
+func (ts *transcript) write(line string) {
+	if ts.f == nil {
+		return
+	}
+	if _, err := ts.f.WriteString(line + "\n"); err != nil {
+		log.Println("[ERR]", err)
+		_ = ts.f.Close()
+		ts.f = nil
+	}
+}
+
+// last describes the latest invocation along with its last lines of output
+func (ts *transcript) last() (what string, lines []string, cut bool) {
+	ts.mu.Lock()
+	defer ts.mu.Unlock()
+	return ts.what, append([]string(nil), ts.tail...), ts.cut
+}
+
+// printLastResets shows, as shell comments, what resetters printed right before a test
+func (rt *Runtime) printLastResets() {
+	rt.forEachTranscript(func(name string, ts *transcript) {
+		what, lines, cut := ts.last()
+		as.ColorOK.Printf("# Output of %s of resetter %s (%s):\n", what, name, ts.path)
+		if cut {
+			as.ColorOK.Println("#   ...")
+		}
+		for _, line := range lines {
+			as.ColorOK.Println("#  ", line)
+		}
+	})
+}
+
+// lastOutputs is sent along with reset progress so counterexamples can show
+// what resetters printed right before a test
+func (rt *Runtime) lastOutputs() (outputs []*fm.Clt_ResetProgress_Output) {
+	rt.forEachTranscript(func(name string, ts *transcript) {
+		_, lines, cut := ts.last()
+		outputs = append(outputs, &fm.Clt_ResetProgress_Output{
+			Resetter:   name,
+			Lines:      lines,
+			Truncated:  cut,
+			Transcript: ts.path,
+		})
+	})
+	return
+}
+
+func (rt *Runtime) forEachTranscript(f func(name string, ts *transcript)) {
+	rt.transcriptsMu.Lock()
+	defer rt.transcriptsMu.Unlock()
+	names := make([]string, 0, len(rt.transcripts))
+	for name := range rt.transcripts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f(name, rt.transcripts[name])
+	}
+}
+
+func (rt *Runtime) closeTranscripts() {
+	rt.forEachTranscript(func(name string, ts *transcript) {
+		ts.mu.Lock()
+		defer ts.mu.Unlock()
+		if ts.f != nil {
+			if err := ts.f.Close(); err != nil {
+				log.Println("[ERR]", err)
+			}
+			ts.f = nil
+		}
+	})
+}
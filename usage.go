@@ -14,13 +14,16 @@ import (
 
 type params struct {
 	Env, Fmt, Fuzz, Lint, Logs, Schema bool
-	Pastseed                           bool
+	Init, Pastseed                     bool
 	Update, Version                    bool
 	Exec, Start, Reset, Stop, Repl     bool
 	FmtW                               bool          `mapstructure:"-w"`
+	NDJSON                             bool          `mapstructure:"--ndjson"`
+	RefByFilename                      bool          `mapstructure:"--ref-by-filename"`
 	ShowSpec                           bool          `mapstructure:"--show-spec"`
 	Seed                               []byte        `mapstructure:"--seed"`
 	EnvVars                            []string      `mapstructure:"VAR"`
+	Payloads                           []string      `mapstructure:"PAYLOAD"`
 	Labels                             []string      `mapstructure:"--label"`
 	N                                  uint32        `mapstructure:"--intensity"`
 	Verbosity                          uint8         `mapstructure:"-v"`
@@ -28,6 +31,12 @@ type params struct {
 	File                               string        `mapstructure:"--file"`
 	Progress                           string        `mapstructure:"--progress"`
 	ValidateAgainst                    string        `mapstructure:"--validate-against"`
+	Format                             string        `mapstructure:"--format"`
+	DiffAgainst                        string        `mapstructure:"--diff"`
+	GenerateFrom                       string        `mapstructure:"--generate"`
+	Count                              uint32        `mapstructure:"--count"`
+	FromHAR                            string        `mapstructure:"--from-har"`
+	SpecFile                           string        `mapstructure:"--spec"`
 	Tags                               *string       `mapstructure:"--tags"`
 	TagsExcluded                       *string       `mapstructure:"--exclude-tags"`
 	OverallBudgetTime                  time.Duration `mapstructure:"--time-budget-overall"`
@@ -35,7 +44,6 @@ type params struct {
 
 func usage() (args *params, ret int) {
 	B := as.ColorNFO.Sprintf(binName)
-	// TODO: B [-vvv] init [--with-magic] Auto fill-in schemas from random API calls
 	// TODO: B [-vvv] login [--user=USER] Authenticate on fuzzymonkey.co as USER
 	// TODO: B [-vvv] exec (start | reset | stop) [RESETTER]
 	// TODO: B [-vvv] schema [--validate-against=REF] [MODELER]
@@ -45,17 +53,21 @@ func usage() (args *params, ret int) {
 
 Usage:
   ` + B + ` [-vvv]           env [VAR ...]
+  ` + B + ` [-vvv] [-f STAR] init --from-har=HAR [--spec=SPEC]
   ` + B + ` [-vvv] [-f STAR] fmt [-w]
   ` + B + ` [-vvv] [-f STAR] lint [--show-spec]
   ` + B + ` [-vvv] [-f STAR] exec (repl | start | reset | stop)
-  ` + B + ` [-vvv] [-f STAR] schema [--validate-against=REF]
+  ` + B + ` [-vvv] [-f STAR] schema [--validate-against=REF | --diff=OLD_SPEC]
+  ` + B + ` [-vvv] [-f STAR] schema (--validate-against=REF | --ref-by-filename)
+                               [--ndjson] [--format=FORMAT] [PAYLOAD ...]
+  ` + B + ` [-vvv] [-f STAR] schema --generate=REF [--count=N] [--seed=SEED]
   ` + B + ` [-vvv] [-f STAR] fuzz [--intensity=N] [--seed=SEED]
                                [--label=KV]...
                                [--tags=TAGS | --exclude-tags=TAGS]
                                [--no-shrinking]
                                [--progress=PROGRESS]
                                [--time-budget-overall=DURATION]
-                               [--only=REGEX]... [--except=REGEX]...
+                               [--only=SELECTOR]... [--except=SELECTOR]...
                                [--calls-with-input=SCHEMA]...  [--calls-without-input=SCHEMA]...
                                [--calls-with-output=SCHEMA]... [--calls-without-output=SCHEMA]...
   ` + B + `        [-f STAR] pastseed
@@ -84,20 +96,32 @@ Options:
   --tags=TAGS                     Only run checks whose tags match at least one of these (comma separated)
   --exclude-tags=TAGS             Skip running checks whose tags match at least one of these (comma separated)
   --progress=PROGRESS             dots, bar, ci (defaults: dots)
-  --only=REGEX                    Only test matching calls
-  --except=REGEX                  Do not test these calls
+  --only=SELECTOR                 Only test calls matching all of these (op:OPERATION_ID, tag:TAG, is:deprecated or REGEX)
+  --except=SELECTOR               Do not test these calls (same as --only)
   --calls-with-input=SCHEMA       Test calls which can take schema PTR as input
   --calls-without-output=SCHEMA   Test calls which never output schema PTR
-  --validate-against=REF          Validate STDIN payload against given schema $ref
+  --validate-against=REF          Validate STDIN payload (or PAYLOAD files & dirs) against given schema $ref
+  --ref-by-filename               Validate each PAYLOAD against the schema named after it (e.g. Pet.json)
+  --ndjson                        Payloads are newline-delimited JSON (default for .ndjson & .jsonl files)
+  --format=FORMAT                 text, json [default: text]
+  --diff=OLD_SPEC                 List (breaking) changes made since OLD_SPEC
+  --generate=REF                  Print JSON values that validate against given schema $ref
+  --count=N                       How many values to generate [default: 1]
   --previous=N                    Select logs from Nth previous run [default: 1]
+  --from-har=HAR                  Infer a starter spec from HTTP traffic recorded in HAR
+  --spec=SPEC                     Where to write the inferred OpenAPIv3 spec [default: openapi.yml]
 
 Try:
      export FUZZYMONKEY_API_KEY=fm_42
      export FUZZYMONKEY_SSL_NO_VERIFY=1
   ` + B + ` update
+  ` + B + ` init --from-har=recorded.har
   ` + B + ` -f fm.star exec reset
   ` + B + ` fuzz --only /pets --calls-without-input=NewPet --seed=$(monkey pastseed)
-  echo '"kitty"' | ` + B + ` schema --validate-against=#/components/schemas/PetKind`
+  echo '"kitty"' | ` + B + ` schema --validate-against=#/components/schemas/PetKind
+  ` + B + ` schema --ref-by-filename --format=json fixtures/
+  ` + B + ` schema --diff=previous_spec.yml
+  ` + B + ` schema --generate=#/components/schemas/Pet --count=10 --seed=42`
 
 	opts, err := docopt.ParseDoc(usage)
 	if err != nil {